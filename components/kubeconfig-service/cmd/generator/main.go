@@ -3,13 +3,16 @@ package main
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/authn"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/reload"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 
@@ -34,10 +37,22 @@ func main() {
 		log.Fatalf("Cannot create OIDC Authenticator, %v", err)
 	}
 
-	ec := endpoints.NewEndpointClient(env.Config.GraphqlURL)
+	rolesResolver, err := setupRolesConfigReloader(fileWatcherCtx, env.Config.RolesConfig)
+	if err != nil {
+		log.Fatalf("Cannot read roles config, %v", err)
+	}
+
+	auditLog, err := setupAuditLog()
+	if err != nil {
+		log.Fatalf("Cannot create audit log, %v", err)
+	}
+
+	ec := endpoints.NewEndpointClient(env.Config.GraphqlURL, rolesResolver, auditLog)
 	router := mux.NewRouter()
 	router.Use(authn.AuthMiddleware(oidcAuthenticator))
 	router.Methods("GET").Path("/kubeconfig/{tenantID}/{runtimeID}").HandlerFunc(ec.GetKubeConfig)
+	router.Methods("GET").Path("/token/{tenantID}/{runtimeID}").HandlerFunc(ec.GetToken)
+	router.Methods("GET").Path("/audit").HandlerFunc(ec.GetAudit)
 
 	healthRouter := mux.NewRouter()
 	healthRouter.Methods("GET").Path("/health/ready").HandlerFunc(ec.GetHealthStatus)
@@ -46,7 +61,7 @@ func main() {
 	signal.Notify(term, os.Interrupt, syscall.SIGTERM)
 
 	go func() {
-		err := runtime.SetupConfigMap()
+		err := runtime.SetupConfigMap(auditLog)
 		if err != nil {
 			log.Errorf("Error initializing ConfigMaps: %v", err)
 			term <- os.Interrupt
@@ -56,6 +71,12 @@ func main() {
 
 	log.Infof("ConfigMaps initialization started.")
 
+	janitor, err := runtime.NewJanitor(env.Config.Janitor.Interval, auditLog)
+	if err != nil {
+		log.Fatalf("Cannot create janitor, %v", err)
+	}
+	go janitor.Run(fileWatcherCtx)
+
 	go func() {
 		err := http.ListenAndServe(fmt.Sprintf(":%d", env.Config.Port.Service), router)
 		log.Errorf("Error serving HTTP: %v", err)
@@ -124,3 +145,46 @@ func setupOIDCAuthReloader(fileWatcherCtx context.Context, cfg *authn.OIDCConfig
 
 	return result, nil
 }
+
+func setupRolesConfigReloader(fileWatcherCtx context.Context, path string) (*roles.Resolver, error) {
+	const eventBatchDelaySeconds = 10
+
+	rolesConfigConstructor := func() (string, error) {
+		if path == "" {
+			return roles.DefaultConfig, nil
+		}
+		log.Infof("reading roles config from %s...", path)
+		raw, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		//Invalid config is not loaded, the previous one is kept
+		if _, err := roles.ParseConfig(string(raw)); err != nil {
+			return "", err
+		}
+		return string(raw), nil
+	}
+
+	//Create reloader
+	result, err := reload.NewStringValueReloader(rolesConfigConstructor)
+	if err != nil {
+		return nil, err
+	}
+
+	//Setup file watcher
+	if path != "" {
+		rolesConfigWatcher := reload.NewWatcher("roles-config", []string{path}, eventBatchDelaySeconds, result.Reload)
+		go rolesConfigWatcher.Run(fileWatcherCtx)
+	}
+
+	return roles.NewResolver(result), nil
+}
+
+func setupAuditLog() (*audit.Log, error) {
+	sink, err := audit.NewSink(env.Config.Audit.Sink, env.Config.Audit.Target)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Writing audit records to %s sink", env.Config.Audit.Sink)
+	return audit.NewLog(sink, env.Config.Audit.MaxRecords)
+}
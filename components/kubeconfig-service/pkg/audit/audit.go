@@ -0,0 +1,147 @@
+package audit
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Event is the type of the audit record
+type Event string
+
+const (
+	// EventIssued is recorded when a kubeconfig or a token is issued to a user
+	EventIssued Event = "issued"
+	// EventRevoked is recorded when the service account artefacts of a user are removed from the runtime
+	EventRevoked Event = "revoked"
+)
+
+// Credential is the kind of the issued credential
+type Credential string
+
+const (
+	// CredentialKubeconfig is a kubeconfig with the token of the service account
+	CredentialKubeconfig Credential = "kubeconfig"
+	// CredentialToken is a short-lived token requested by the credential helper
+	CredentialToken Credential = "token"
+)
+
+// DefaultQueryLimit is the number of records returned by a query without a limit
+const DefaultQueryLimit = 100
+
+// Record describes a credential issued to a user or removed from the runtime
+type Record struct {
+	Time           time.Time  `json:"time"`
+	Event          Event      `json:"event"`
+	Credential     Credential `json:"credential,omitempty"`
+	UserID         string     `json:"userID,omitempty"`
+	Role           string     `json:"role"`
+	Namespace      string     `json:"namespace,omitempty"`
+	TenantID       string     `json:"tenantID"`
+	RuntimeID      string     `json:"runtimeID"`
+	ServiceAccount string     `json:"serviceAccount"`
+	ExpiresAt      *time.Time `json:"expiresAt,omitempty"`
+}
+
+// Recorder records the audit events
+type Recorder interface {
+	Record(record Record) error
+}
+
+// Sink writes the audit records to the storage, for example stdout, a file or an HTTP endpoint
+type Sink interface {
+	Write(record Record) error
+}
+
+// Loader is implemented by the sinks which can read the previously written records
+type Loader interface {
+	Load() ([]Record, error)
+}
+
+// Filter selects the records returned by the query, empty fields match all records
+type Filter struct {
+	UserID    string
+	TenantID  string
+	RuntimeID string
+	Since     time.Time
+	Limit     int
+}
+
+// Log writes the records to the sink and keeps the latest records in memory so that they can be queried
+type Log struct {
+	mu         sync.RWMutex
+	sink       Sink
+	records    []Record
+	maxRecords int
+	now        func() time.Time
+}
+
+// NewLog returns a new instance of Log which keeps up to maxRecords records in memory.
+// If the sink implements Loader, the previously written records are loaded.
+func NewLog(sink Sink, maxRecords int) (*Log, error) {
+	l := &Log{sink: sink, maxRecords: maxRecords, now: time.Now}
+	if loader, ok := sink.(Loader); ok {
+		records, err := loader.Load()
+		if err != nil {
+			return nil, fmt.Errorf("while loading audit records: %w", err)
+		}
+		l.append(records...)
+	}
+	return l, nil
+}
+
+// Record writes the record to the sink, the time of the record is set if it is empty
+func (l *Log) Record(record Record) error {
+	if record.Time.IsZero() {
+		record.Time = l.now().UTC()
+	}
+	if err := l.sink.Write(record); err != nil {
+		return fmt.Errorf("while writing audit record: %w", err)
+	}
+	log.Infof("Audit: %s %s of role %s for runtime %s/%s service account %s", record.Event, record.Credential, record.Role, record.TenantID, record.RuntimeID, record.ServiceAccount)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.append(record)
+	return nil
+}
+
+// Query returns the latest records matching the filter, the newest record first
+func (l *Log) Query(filter Filter) []Record {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultQueryLimit
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	result := make([]Record, 0)
+	for i := len(l.records) - 1; i >= 0 && len(result) < limit; i-- {
+		if filter.matches(l.records[i]) {
+			result = append(result, l.records[i])
+		}
+	}
+	return result
+}
+
+func (l *Log) append(records ...Record) {
+	l.records = append(l.records, records...)
+	if overflow := len(l.records) - l.maxRecords; overflow > 0 {
+		l.records = append([]Record(nil), l.records[overflow:]...)
+	}
+}
+
+func (f Filter) matches(record Record) bool {
+	if f.UserID != "" && f.UserID != record.UserID {
+		return false
+	}
+	if f.TenantID != "" && f.TenantID != record.TenantID {
+		return false
+	}
+	if f.RuntimeID != "" && f.RuntimeID != record.RuntimeID {
+		return false
+	}
+	return f.Since.IsZero() || !record.Time.Before(f.Since)
+}
@@ -0,0 +1,155 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failingSink struct{}
+
+func (failingSink) Write(Record) error {
+	return errors.New("disk full")
+}
+
+func TestLog_Query(t *testing.T) {
+	now := time.Date(2022, 10, 17, 10, 0, 0, 0, time.UTC)
+	auditLog, err := NewLog(NewWriterSink(&bytes.Buffer{}), 3)
+	require.NoError(t, err)
+	auditLog.now = func() time.Time { return now }
+
+	for i, record := range []Record{
+		{UserID: "jdoe", TenantID: "tenant", RuntimeID: "runtime-1"},
+		{UserID: "jdoe", TenantID: "tenant", RuntimeID: "runtime-2"},
+		{UserID: "asmith", TenantID: "tenant", RuntimeID: "runtime-1"},
+		{UserID: "jdoe", TenantID: "other", RuntimeID: "runtime-3"},
+	} {
+		record.Time = now.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, auditLog.Record(record))
+	}
+
+	t.Run("should return the newest records first and keep only the latest records", func(t *testing.T) {
+		records := auditLog.Query(Filter{})
+
+		require.Len(t, records, 3)
+		assert.Equal(t, "runtime-3", records[0].RuntimeID)
+		assert.Equal(t, "runtime-2", records[2].RuntimeID)
+	})
+
+	t.Run("should filter the records", func(t *testing.T) {
+		records := auditLog.Query(Filter{UserID: "jdoe", TenantID: "tenant"})
+
+		require.Len(t, records, 1)
+		assert.Equal(t, "runtime-2", records[0].RuntimeID)
+	})
+
+	t.Run("should return the records since the given time", func(t *testing.T) {
+		records := auditLog.Query(Filter{Since: now.Add(2 * time.Minute), Limit: 1})
+
+		require.Len(t, records, 1)
+		assert.Equal(t, "runtime-3", records[0].RuntimeID)
+	})
+}
+
+func TestLog_Record(t *testing.T) {
+	t.Run("should set the time and write the record to the sink", func(t *testing.T) {
+		// given
+		now := time.Date(2022, 10, 17, 10, 0, 0, 0, time.UTC)
+		out := &bytes.Buffer{}
+		auditLog, err := NewLog(NewWriterSink(out), 10)
+		require.NoError(t, err)
+		auditLog.now = func() time.Time { return now }
+
+		// when
+		err = auditLog.Record(Record{Event: EventIssued, Credential: CredentialToken, UserID: "jdoe", ServiceAccount: "jdoe"})
+
+		// then
+		require.NoError(t, err)
+		var written Record
+		require.NoError(t, json.Unmarshal(out.Bytes(), &written))
+		assert.Equal(t, now, written.Time)
+		assert.Equal(t, EventIssued, written.Event)
+	})
+
+	t.Run("should return error when the sink fails", func(t *testing.T) {
+		// given
+		auditLog, err := NewLog(failingSink{}, 10)
+		require.NoError(t, err)
+
+		// when
+		err = auditLog.Record(Record{UserID: "jdoe"})
+
+		// then
+		assert.EqualError(t, err, "while writing audit record: disk full")
+		assert.Empty(t, auditLog.Query(Filter{}))
+	})
+}
+
+func TestFileSink(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	auditLog, err := NewLog(sink, 10)
+	require.NoError(t, err)
+	require.NoError(t, auditLog.Record(Record{UserID: "jdoe", RuntimeID: "runtime"}))
+
+	// when
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	reloaded, err := NewLog(sink, 10)
+
+	// then
+	require.NoError(t, err)
+	records := reloaded.Query(Filter{})
+	require.Len(t, records, 1)
+	assert.Equal(t, "jdoe", records[0].UserID)
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Run("should post the record", func(t *testing.T) {
+		// given
+		var received Record
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		// when
+		err := NewHTTPSink(server.URL, server.Client()).Write(Record{UserID: "jdoe"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "jdoe", received.UserID)
+	})
+
+	t.Run("should return error when the endpoint fails", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		// when
+		err := NewHTTPSink(server.URL, server.Client()).Write(Record{UserID: "jdoe"})
+
+		// then
+		assert.EqualError(t, err, "audit endpoint returned status 503")
+	})
+}
+
+func TestNewSink(t *testing.T) {
+	_, err := NewSink("syslog", "")
+
+	assert.EqualError(t, err, `unknown audit sink "syslog", must be one of: stdout, file, http`)
+}
@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+	SinkHTTP   = "http"
+)
+
+const httpSinkTimeout = 10 * time.Second
+
+// NewSink returns the sink of the given kind, the target is the path of the file or the URL of the HTTP endpoint
+func NewSink(kind, target string) (Sink, error) {
+	switch kind {
+	case SinkStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkFile:
+		return NewFileSink(target)
+	case SinkHTTP:
+		if target == "" {
+			return nil, fmt.Errorf("URL of the %s audit sink is not set", SinkHTTP)
+		}
+		return NewHTTPSink(target, &http.Client{Timeout: httpSinkTimeout}), nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q, must be one of: %s, %s, %s", kind, SinkStdout, SinkFile, SinkHTTP)
+	}
+}
+
+// WriterSink writes the records to the writer as JSON lines
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewWriterSink returns a new instance of WriterSink
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+// Write writes the record as a JSON line
+func (s *WriterSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// FileSink appends the records to the file as JSON lines, the records are loaded from the file on startup
+type FileSink struct {
+	*WriterSink
+	path string
+}
+
+// NewFileSink returns a new instance of FileSink, the file is created if it does not exist
+func NewFileSink(path string) (*FileSink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("path of the %s audit sink is not set", SinkFile)
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("while opening audit file: %w", err)
+	}
+	return &FileSink{WriterSink: NewWriterSink(file), path: path}, nil
+}
+
+// Load reads the records written to the file, the lines which cannot be parsed are skipped
+func (s *FileSink) Load() ([]Record, error) {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, scanner.Err()
+}
+
+// HTTPSink posts each record as JSON to the HTTP endpoint
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSink returns a new instance of HTTPSink
+func NewHTTPSink(url string, client *http.Client) *HTTPSink {
+	return &HTTPSink{url: url, client: client}
+}
+
+// Write posts the record to the HTTP endpoint
+func (s *HTTPSink) Write(record Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("audit endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
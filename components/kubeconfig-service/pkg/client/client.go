@@ -2,19 +2,43 @@ package client
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"html"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
 	"golang.org/x/oauth2"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
 )
 
 // Client is the interface to interact with the kubeconfig-service as an HTTP client using OIDC ID token in JWT format.
 type Client interface {
 	GetKubeConfig(tenantID, runtimeID string) (string, error)
+	GetScopedKubeConfig(tenantID, runtimeID string, scope Scope) (string, error)
+	GetExecKubeConfig(tenantID, runtimeID string, scope Scope) (string, error)
+	GetToken(tenantID, runtimeID string, scope Scope) (*clientauthenticationv1.ExecCredential, error)
+}
+
+// Scope narrows down the access of the kubeconfig to a role and a namespace allowed for the group of the user.
+// The role of the group of the user is used if the role is empty, the access is not limited to a namespace if the namespace is empty.
+type Scope struct {
+	Role      string
+	Namespace string
+}
+
+func (s Scope) query() url.Values {
+	query := url.Values{}
+	if s.Role != "" {
+		query.Set("role", s.Role)
+	}
+	if s.Namespace != "" {
+		query.Set("namespace", s.Namespace)
+	}
+	return query
 }
 
 type client struct {
@@ -34,13 +58,60 @@ func NewClient(ctx context.Context, url string, auth oauth2.TokenSource) Client
 	}
 }
 
-// GetKubeConfig
+// GetKubeConfig returns the kubeconfig with the token of the service account created for the user
 func (c *client) GetKubeConfig(tenantID, runtimeID string) (string, error) {
-	url := fmt.Sprintf("%s/kubeconfig/%s/%s", c.url, tenantID, runtimeID)
+	return c.GetScopedKubeConfig(tenantID, runtimeID, Scope{})
+}
+
+// GetScopedKubeConfig returns the kubeconfig with the token of the service account created for the user in the scope
+func (c *client) GetScopedKubeConfig(tenantID, runtimeID string, scope Scope) (string, error) {
+	body, err := c.get(c.endpoint("kubeconfig", tenantID, runtimeID, scope.query()))
+	if err != nil {
+		return "", err
+	}
+	return html.UnescapeString(string(body)), nil
+}
+
+// GetExecKubeConfig returns the kubeconfig which uses the kcp credential helper to get short-lived tokens
+func (c *client) GetExecKubeConfig(tenantID, runtimeID string, scope Scope) (string, error) {
+	query := scope.query()
+	query.Set("mode", "exec")
+	body, err := c.get(c.endpoint("kubeconfig", tenantID, runtimeID, query))
+	if err != nil {
+		return "", err
+	}
+	return html.UnescapeString(string(body)), nil
+}
+
+// GetToken returns a short-lived token of the service account created for the user in the ExecCredential format
+func (c *client) GetToken(tenantID, runtimeID string, scope Scope) (*clientauthenticationv1.ExecCredential, error) {
+	body, err := c.get(c.endpoint("token", tenantID, runtimeID, scope.query()))
+	if err != nil {
+		return nil, err
+	}
+	credential := &clientauthenticationv1.ExecCredential{}
+	err = json.Unmarshal(body, credential)
+	if err != nil {
+		return nil, errors.Wrap(err, "while decoding token")
+	}
+	if credential.Status == nil || credential.Status.Token == "" {
+		return nil, errors.New("token is missing in the response")
+	}
+	return credential, nil
+}
 
-	resp, err := c.httpClient.Get(url)
+func (c *client) endpoint(path, tenantID, runtimeID string, query url.Values) string {
+	endpoint := fmt.Sprintf("%s/%s/%s/%s", c.url, path, tenantID, runtimeID)
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", endpoint, query.Encode())
+	}
+	return endpoint
+}
+
+func (c *client) get(endpoint string) (body []byte, err error) {
+	resp, err := c.httpClient.Get(endpoint)
 	if err != nil {
-		return "", errors.Wrapf(err, "while calling %s", url)
+		return nil, errors.Wrapf(err, "while calling %s", endpoint)
 	}
 
 	// Drain response body and close, return error to context if there isn't any.
@@ -56,13 +127,13 @@ func (c *client) GetKubeConfig(tenantID, runtimeID string) (string, error) {
 	}()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("calling %s returned %s status", url, resp.Status)
+		return nil, fmt.Errorf("calling %s returned %s status", endpoint, resp.Status)
 	}
-	body, err := ioutil.ReadAll(resp.Body)
+	body, err = ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", errors.Wrap(err, "while reading response body")
+		return nil, errors.Wrap(err, "while reading response body")
 	}
-	return html.UnescapeString(string(body)), nil
+	return body, nil
 }
 
 func drainResponseBody(body io.Reader) error {
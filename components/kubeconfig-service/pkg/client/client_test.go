@@ -61,3 +61,92 @@ func TestClient_GetKubeConfig(t *testing.T) {
 	assert.True(t, called)
 	assert.Equal(t, testKubeConfig, kc)
 }
+
+func TestClient_GetExecKubeConfig(t *testing.T) {
+	// given
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/kubeconfig/%s/%s", testTenant, testRuntime), r.URL.Path)
+		assert.Equal(t, "exec", r.URL.Query().Get("mode"))
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(testKubeConfig))
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	client := NewClient(context.TODO(), ts.URL, fixToken)
+
+	// when
+	kc, err := client.GetExecKubeConfig(testTenant, testRuntime, Scope{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, testKubeConfig, kc)
+}
+
+func TestClient_GetToken(t *testing.T) {
+	t.Run("should return the token", func(t *testing.T) {
+		// given
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, fmt.Sprintf("/token/%s/%s", testTenant, testRuntime), r.URL.Path)
+			assert.Equal(t, r.Header.Get("Authorization"), fmt.Sprintf("Bearer %s", fixToken))
+
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"kind":"ExecCredential","apiVersion":"client.authentication.k8s.io/v1","spec":{"interactive":false},"status":{"expirationTimestamp":"2022-10-17T10:10:00Z","token":"short-lived"}}`))
+			require.NoError(t, err)
+		}))
+		defer ts.Close()
+
+		client := NewClient(context.TODO(), ts.URL, fixToken)
+
+		// when
+		credential, err := client.GetToken(testTenant, testRuntime, Scope{})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "short-lived", credential.Status.Token)
+		assert.Equal(t, time.Date(2022, 10, 17, 10, 10, 0, 0, time.UTC), credential.Status.ExpirationTimestamp.UTC())
+	})
+
+	t.Run("should return error when the token is missing", func(t *testing.T) {
+		// given
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`{"kind":"ExecCredential","apiVersion":"client.authentication.k8s.io/v1"}`))
+			require.NoError(t, err)
+		}))
+		defer ts.Close()
+
+		client := NewClient(context.TODO(), ts.URL, fixToken)
+
+		// when
+		_, err := client.GetToken(testTenant, testRuntime, Scope{})
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_GetScopedKubeConfig(t *testing.T) {
+	// given
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, fmt.Sprintf("/kubeconfig/%s/%s", testTenant, testRuntime), r.URL.Path)
+		assert.Equal(t, "viewer", r.URL.Query().Get("role"))
+		assert.Equal(t, "foo", r.URL.Query().Get("namespace"))
+
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(testKubeConfig))
+		require.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	client := NewClient(context.TODO(), ts.URL, fixToken)
+
+	// when
+	kc, err := client.GetScopedKubeConfig(testTenant, testRuntime, Scope{Role: "viewer", Namespace: "foo"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, testKubeConfig, kc)
+}
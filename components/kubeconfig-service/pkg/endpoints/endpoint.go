@@ -1,22 +1,34 @@
 package endpoints
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	authn "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/authn"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/caller"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/env"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
 	run "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/transformer"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
 )
 
 const (
 	mimeTypeYaml = "application/x-yaml"
 	mimeTypeText = "text/plain"
+	mimeTypeJSON = "application/json"
+
+	// modeExec returns the kubeconfig which uses the kcp credential helper instead of the static service account token
+	modeExec = "exec"
 )
 
 //mutex to avoid critical section in config map deployment
@@ -24,13 +36,17 @@ var mu sync.Mutex
 
 //EndpointClient Wrpper for Endpoints
 type EndpointClient struct {
-	gqlURL string
+	gqlURL        string
+	rolesResolver *roles.Resolver
+	auditLog      *audit.Log
 }
 
 //NewEndpointClient return new instance of EndpointClient
-func NewEndpointClient(gqlURL string) *EndpointClient {
+func NewEndpointClient(gqlURL string, rolesResolver *roles.Resolver, auditLog *audit.Log) *EndpointClient {
 	return &EndpointClient{
-		gqlURL: gqlURL,
+		gqlURL:        gqlURL,
+		rolesResolver: rolesResolver,
+		auditLog:      auditLog,
 	}
 }
 
@@ -39,26 +55,37 @@ func (ec EndpointClient) GetKubeConfig(w http.ResponseWriter, req *http.Request)
 	vars := mux.Vars(req)
 	tenant := vars["tenantID"]
 	runtime := vars["runtimeID"]
+	mode := req.URL.Query().Get("mode")
+
+	if mode != "" && mode != modeExec {
+		writeErrorResponse(w, http.StatusBadRequest, fmt.Errorf("mode %s is not supported, use %s or no mode", mode, modeExec))
+		return
+	}
 
-	var err error
-	var kubeConfig []byte
 	userInfo, ok := req.Context().Value("userInfo").(authn.UserInfo)
-	if ok {
-		log.Infof("Generating kubeconfig for %s/%s %s", tenant, runtime, userInfo)
-		kubeConfig, err = ec.generateKubeConfig(tenant, runtime, userInfo)
+	if !ok {
+		writeErrorResponse(w, http.StatusInternalServerError, errors.New("User info is null"))
+		return
+	}
+	scope, err := ec.resolveScope(req, userInfo)
+	if err != nil {
+		writeScopeErrorResponse(w, err)
+		return
+	}
+
+	var kubeConfig []byte
+	if mode == modeExec {
+		log.Infof("Generating exec kubeconfig for %s/%s %s with role %s", tenant, runtime, userInfo, scope.Name)
+		kubeConfig, err = ec.generateExecKubeConfig(tenant, runtime, userInfo, scope)
 	} else {
-		err = errors.New("User info is null")
+		log.Infof("Generating kubeconfig for %s/%s %s with role %s", tenant, runtime, userInfo, scope.Name)
+		kubeConfig, err = ec.generateKubeConfig(tenant, runtime, userInfo, scope)
 	}
 
 	if err != nil {
-		w.Header().Add("Content-Type", mimeTypeText)
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Header().Set("Content-Security-Policy", "default-src 'none';")
-		_, err2 := w.Write([]byte(err.Error()))
 		log.Errorf("Error while processing the kubeconfig file: %s", err)
-		if err2 != nil {
-			log.Errorf("Error while sending response: %s", err2)
-		}
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
 	}
 	w.Header().Add("Content-Type", mimeTypeYaml)
 	_, err = w.Write(kubeConfig)
@@ -67,6 +94,94 @@ func (ec EndpointClient) GetKubeConfig(w http.ResponseWriter, req *http.Request)
 	}
 }
 
+//GetToken REST Path for short-lived tokens used by the kcp credential helper, the token is returned as ExecCredential
+func (ec EndpointClient) GetToken(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	tenant := vars["tenantID"]
+	runtime := vars["runtimeID"]
+
+	userInfo, ok := req.Context().Value("userInfo").(authn.UserInfo)
+	if !ok {
+		writeErrorResponse(w, http.StatusInternalServerError, errors.New("User info is null"))
+		return
+	}
+	scope, err := ec.resolveScope(req, userInfo)
+	if err != nil {
+		writeScopeErrorResponse(w, err)
+		return
+	}
+
+	log.Infof("Generating token for %s/%s %s with role %s", tenant, runtime, userInfo, scope.Name)
+	credential, err := ec.generateToken(tenant, runtime, userInfo, scope)
+	if err != nil {
+		log.Errorf("Error while generating the token: %s", err)
+		writeErrorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.Header().Add("Content-Type", mimeTypeJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	err = json.NewEncoder(w).Encode(credential)
+	if err != nil {
+		log.Errorf("Error while sending response: %s", err)
+	}
+}
+
+//GetAudit REST Path for the audit records of the issued kubeconfigs and tokens, only the runtime admins can read them
+func (ec EndpointClient) GetAudit(w http.ResponseWriter, req *http.Request) {
+	userInfo, ok := req.Context().Value("userInfo").(authn.UserInfo)
+	if !ok {
+		writeErrorResponse(w, http.StatusInternalServerError, errors.New("User info is null"))
+		return
+	}
+	if userInfo.Role != run.RUNTIME_ADMIN {
+		writeErrorResponse(w, http.StatusForbidden, fmt.Errorf("only the members of the %s group can read the audit records", run.RUNTIME_ADMIN))
+		return
+	}
+
+	filter, err := auditFilter(req)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	w.Header().Add("Content-Type", mimeTypeJSON)
+	err = json.NewEncoder(w).Encode(ec.auditLog.Query(filter))
+	if err != nil {
+		log.Errorf("Error while sending response: %s", err)
+	}
+}
+
+// auditFilter reads the filter of the audit records from the user, tenant, runtime, since and limit query parameters
+func auditFilter(req *http.Request) (audit.Filter, error) {
+	query := req.URL.Query()
+	filter := audit.Filter{
+		UserID:    query.Get("user"),
+		TenantID:  query.Get("tenant"),
+		RuntimeID: query.Get("runtime"),
+	}
+	if since := query.Get("since"); since != "" {
+		t, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return audit.Filter{}, fmt.Errorf("since must be a RFC3339 timestamp: %w", err)
+		}
+		filter.Since = t
+	}
+	if limit := query.Get("limit"); limit != "" {
+		l, err := strconv.Atoi(limit)
+		if err != nil || l <= 0 {
+			return audit.Filter{}, fmt.Errorf("limit must be a positive number")
+		}
+		filter.Limit = l
+	}
+	return filter, nil
+}
+
+// resolveScope returns the role and the namespace requested with the role and namespace query parameters,
+// the role of the group of the user is used by default
+func (ec EndpointClient) resolveScope(req *http.Request, userInfo authn.UserInfo) (roles.Scope, error) {
+	query := req.URL.Query()
+	return ec.rolesResolver.Resolve(userInfo.Role, query.Get("role"), query.Get("namespace"))
+}
+
 //GetHealthStatus REST Path for health checks
 func (ec EndpointClient) GetHealthStatus(w http.ResponseWriter, req *http.Request) {
 	w.WriteHeader(http.StatusOK)
@@ -81,18 +196,18 @@ func (ec EndpointClient) callGQL(tenantID, runtimeID string) (string, error) {
 	return *status.RuntimeConfiguration.Kubeconfig, nil
 }
 
-func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo authn.UserInfo) ([]byte, error) {
+func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo authn.UserInfo, scope roles.Scope) ([]byte, error) {
 	rawConfig, err := ec.callGQL(tenant, runtime)
 	if err != nil || rawConfig == "" {
 		return nil, err
 	}
 
-	tc, err := transformer.NewClient(rawConfig, userInfo.ID)
+	tc, err := newTransformerClient(rawConfig, userInfo, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	runtimeClient, err := run.NewRuntimeClient([]byte(rawConfig), userInfo.ID, userInfo.Role, tenant)
+	runtimeClient, err := ec.newRuntimeClient(rawConfig, tenant, userInfo, scope)
 	if err != nil {
 		return nil, err
 	}
@@ -107,16 +222,163 @@ func (ec EndpointClient) generateKubeConfig(tenant, runtime string, userInfo aut
 		return nil, err
 	}
 
+	expiresAt, err := scheduleCleanup(runtimeClient, runtime, scope.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ec.recordIssued(audit.CredentialKubeconfig, runtimeClient, runtime, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return saKubeConfig, nil
+}
+
+func (ec EndpointClient) generateExecKubeConfig(tenant, runtime string, userInfo authn.UserInfo, scope roles.Scope) ([]byte, error) {
+	rawConfig, err := ec.callGQL(tenant, runtime)
+	if err != nil || rawConfig == "" {
+		return nil, err
+	}
+
+	tc, err := newTransformerClient(rawConfig, userInfo, scope)
+	if err != nil {
+		return nil, err
+	}
+	tc.TenantID = tenant
+	tc.RuntimeID = runtime
+	if scope.Name != userInfo.Role {
+		tc.Role = scope.Name
+	}
+
+	// the service account is created when the first token is requested by the credential helper
+	return tc.TransformKubeconfig(transformer.KubeconfigExecTemplate)
+}
+
+func (ec EndpointClient) generateToken(tenant, runtime string, userInfo authn.UserInfo, scope roles.Scope) (*clientauthenticationv1.ExecCredential, error) {
+	rawConfig, err := ec.callGQL(tenant, runtime)
+	if err != nil {
+		return nil, err
+	}
+	if rawConfig == "" {
+		return nil, fmt.Errorf("kubeconfig of runtime %s is empty", runtime)
+	}
+
+	runtimeClient, err := ec.newRuntimeClient(rawConfig, tenant, userInfo, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiration, err := runtimeClient.RunWithTokenRequest(env.Config.Token.Audiences, env.Config.Token.ExpirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = scheduleCleanup(runtimeClient, runtime, scope.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ec.recordIssued(audit.CredentialToken, runtimeClient, runtime, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return newExecCredential(token, expiration), nil
+}
+
+// recordIssued records the credential issued to the user, the credential is not returned if it cannot be recorded
+func (ec EndpointClient) recordIssued(credential audit.Credential, runtimeClient *run.RuntimeClient, runtime string, expiresAt time.Time) error {
+	if ec.auditLog == nil {
+		return nil
+	}
+	return ec.auditLog.Record(audit.Record{
+		Event:          audit.EventIssued,
+		Credential:     credential,
+		UserID:         runtimeClient.User.UserID,
+		Role:           runtimeClient.L2L3OperatiorRole,
+		Namespace:      runtimeClient.User.BindingNamespace,
+		TenantID:       runtimeClient.User.TenantID,
+		RuntimeID:      runtime,
+		ServiceAccount: runtimeClient.User.ServiceAccountName,
+		ExpiresAt:      &expiresAt,
+	})
+}
+
+func newTransformerClient(rawConfig string, userInfo authn.UserInfo, scope roles.Scope) (*transformer.Client, error) {
+	tc, err := transformer.NewClient(rawConfig, scope.Identity(userInfo.ID, userInfo.Role))
+	if err != nil {
+		return nil, err
+	}
+	tc.Namespace = scope.Namespace
+	return tc, nil
+}
+
+// newRuntimeClient returns the client which creates the service account and the RBAC resources of the scope,
+// each scope has its own service account so that the kubeconfigs of different scopes do not share the permissions
+func (ec EndpointClient) newRuntimeClient(rawConfig, tenant string, userInfo authn.UserInfo, scope roles.Scope) (*run.RuntimeClient, error) {
+	runtimeClient, err := run.NewRuntimeClient([]byte(rawConfig), scope.Identity(userInfo.ID, userInfo.Role), scope.Name, tenant)
+	if err != nil {
+		return nil, err
+	}
+	rules := scope.Rules
+	runtimeClient.RoleRules = &rules
+	runtimeClient.User.BindingNamespace = scope.Namespace
+	runtimeClient.User.UserID = userInfo.ID
+	if ec.auditLog != nil {
+		runtimeClient.Recorder = ec.auditLog
+	}
+	return runtimeClient, nil
+}
+
+func newExecCredential(token string, expiration time.Time) *clientauthenticationv1.ExecCredential {
+	expirationTimestamp := metav1.NewTime(expiration)
+	return &clientauthenticationv1.ExecCredential{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clientauthenticationv1.SchemeGroupVersion.String(),
+			Kind:       "ExecCredential",
+		},
+		Status: &clientauthenticationv1.ExecCredentialStatus{
+			Token:               token,
+			ExpirationTimestamp: &expirationTimestamp,
+		},
+	}
+}
+
+// scheduleCleanup stores the time of the access in the ConfigMap of the user, the service account is removed
+// when the runtime is not accessed by the user within the expiration time, the time of the removal is returned
+func scheduleCleanup(runtimeClient *run.RuntimeClient, runtime, role string) (time.Time, error) {
 	mu.Lock()
 	startTime := time.Now()
-	err = runtimeClient.DeployConfigMap(runtime, userInfo.Role, startTime)
+	err := runtimeClient.DeployConfigMap(runtime, role, startTime)
 	mu.Unlock()
 	if err != nil {
 		log.Errorf("Cannot generate config map, %s", err.Error())
-		return nil, err
+		return time.Time{}, err
 	}
 
 	go runtimeClient.SetupTimer(startTime, runtime)
+	return startTime.Add(run.ExpireTime).UTC(), nil
+}
 
-	return saKubeConfig, nil
+func writeScopeErrorResponse(w http.ResponseWriter, err error) {
+	code := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, roles.ErrNotAllowed):
+		code = http.StatusForbidden
+	case errors.Is(err, roles.ErrInvalidScope):
+		code = http.StatusBadRequest
+	}
+	log.Errorf("Error while resolving the scope: %s", err)
+	writeErrorResponse(w, code, err)
+}
+
+func writeErrorResponse(w http.ResponseWriter, code int, err error) {
+	w.Header().Add("Content-Type", mimeTypeText)
+	w.Header().Set("Content-Security-Policy", "default-src 'none';")
+	w.WriteHeader(code)
+	_, err = w.Write([]byte(err.Error()))
+	if err != nil {
+		log.Errorf("Error while sending response: %s", err)
+	}
 }
@@ -0,0 +1,99 @@
+package endpoints
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/authn"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/roles"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticRolesConfig string
+
+func (s staticRolesConfig) GetString() string {
+	return string(s)
+}
+
+func TestEndpointClient_ScopeNotAllowed(t *testing.T) {
+	ec := NewEndpointClient("http://127.0.0.1:3000/graphql", roles.NewResolver(staticRolesConfig(roles.DefaultConfig)), nil)
+	router := mux.NewRouter()
+	router.Methods("GET").Path("/kubeconfig/{tenantID}/{runtimeID}").HandlerFunc(ec.GetKubeConfig)
+	router.Methods("GET").Path("/token/{tenantID}/{runtimeID}").HandlerFunc(ec.GetToken)
+
+	for name, tc := range map[string]struct {
+		path         string
+		expectedCode int
+	}{
+		"kubeconfig with role not allowed for the group": {path: "/kubeconfig/tenant/runtime?role=runtimeAdmin", expectedCode: http.StatusForbidden},
+		"kubeconfig with namespace not allowed":          {path: "/kubeconfig/tenant/runtime?namespace=foo", expectedCode: http.StatusForbidden},
+		"kubeconfig with unknown role":                   {path: "/kubeconfig/tenant/runtime?role=viewer", expectedCode: http.StatusBadRequest},
+		"kubeconfig with unknown mode":                   {path: "/kubeconfig/tenant/runtime?mode=oidc", expectedCode: http.StatusBadRequest},
+		"token with role not allowed for the group":      {path: "/token/tenant/runtime?role=runtimeAdmin", expectedCode: http.StatusForbidden},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), "userInfo", authn.UserInfo{ID: "jdoe", Role: "runtimeOperator"}))
+			response := httptest.NewRecorder()
+
+			// when
+			router.ServeHTTP(response, req)
+
+			// then
+			assert.Equal(t, tc.expectedCode, response.Code)
+		})
+	}
+}
+
+func TestEndpointClient_GetAudit(t *testing.T) {
+	auditLog, err := audit.NewLog(audit.NewWriterSink(&bytes.Buffer{}), 10)
+	require.NoError(t, err)
+	require.NoError(t, auditLog.Record(audit.Record{Event: audit.EventIssued, UserID: "jdoe", TenantID: "tenant", RuntimeID: "runtime-1"}))
+	require.NoError(t, auditLog.Record(audit.Record{Event: audit.EventIssued, UserID: "asmith", TenantID: "tenant", RuntimeID: "runtime-2"}))
+	ec := NewEndpointClient("http://127.0.0.1:3000/graphql", roles.NewResolver(staticRolesConfig(roles.DefaultConfig)), auditLog)
+
+	for name, tc := range map[string]struct {
+		path            string
+		role            string
+		expectedCode    int
+		expectedRuntime []string
+	}{
+		"all records":             {path: "/audit", role: "runtimeAdmin", expectedCode: http.StatusOK, expectedRuntime: []string{"runtime-2", "runtime-1"}},
+		"records of the user":     {path: "/audit?user=jdoe", role: "runtimeAdmin", expectedCode: http.StatusOK, expectedRuntime: []string{"runtime-1"}},
+		"records of other tenant": {path: "/audit?tenant=other", role: "runtimeAdmin", expectedCode: http.StatusOK, expectedRuntime: []string{}},
+		"invalid limit":           {path: "/audit?limit=all", role: "runtimeAdmin", expectedCode: http.StatusBadRequest},
+		"invalid since":           {path: "/audit?since=yesterday", role: "runtimeAdmin", expectedCode: http.StatusBadRequest},
+		"runtime operator":        {path: "/audit", role: "runtimeOperator", expectedCode: http.StatusForbidden},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), "userInfo", authn.UserInfo{ID: "admin", Role: tc.role}))
+			response := httptest.NewRecorder()
+
+			// when
+			ec.GetAudit(response, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, response.Code)
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+			var records []audit.Record
+			require.NoError(t, json.NewDecoder(response.Body).Decode(&records))
+			runtimes := make([]string, 0)
+			for _, record := range records {
+				runtimes = append(runtimes, record.RuntimeID)
+			}
+			assert.Equal(t, tc.expectedRuntime, runtimes)
+		})
+	}
+}
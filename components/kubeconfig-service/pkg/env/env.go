@@ -1,6 +1,8 @@
 package env
 
 import (
+	"time"
+
 	"github.com/vrischmann/envconfig"
 )
 
@@ -32,6 +34,22 @@ type EnvConfig struct {
 		}
 		SupportedSigningAlgs []string `envconfig:"default=RS256"`
 	}
+	Token struct {
+		ExpirationSeconds int64    `envconfig:"default=600"`
+		Audiences         []string `envconfig:"optional"`
+	}
+	Exec struct {
+		Command string `envconfig:"default=kcp"`
+	}
+	RolesConfig string `envconfig:"optional"`
+	Audit       struct {
+		Sink       string `envconfig:"default=stdout"`
+		Target     string `envconfig:"optional"`
+		MaxRecords int    `envconfig:"default=1000"`
+	}
+	Janitor struct {
+		Interval time.Duration `envconfig:"default=1h"`
+	}
 	LogLevel string `envconfig:"default=info"`
 }
 
@@ -0,0 +1,164 @@
+package roles
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	run "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// ErrNotAllowed is returned when the group of the user does not allow the requested role or namespace
+var ErrNotAllowed = errors.New("not allowed")
+
+// ErrInvalidScope is returned when the requested role is not defined or the namespace is not valid
+var ErrInvalidScope = errors.New("invalid scope")
+
+// maxIdentityLength keeps the identity short enough to be used in the aggregation label of the cluster role
+const maxIdentityLength = 50
+
+var identityRegex = regexp.MustCompile("[^a-z0-9-]+")
+
+/*
+Config example:
+roles:
+  viewer:
+    clusterRoleSelectors:
+    - matchLabels:
+        rbac.authorization.k8s.io/aggregate-to-view: "true"
+groups:
+  runtimeOperator:
+    roles: [viewer]
+    namespaced: true
+*/
+
+// Config maps the roles which can be requested to the policy rules and defines which roles the groups can request.
+// The roles of the runtimeAdmin and runtimeOperator groups are defined by default, but can be overridden.
+type Config struct {
+	Roles  map[string]run.RoleRules `json:"roles"`
+	Groups map[string]Group         `json:"groups"`
+}
+
+// Group defines the roles which the members of the group can request in addition to the role of the group,
+// and whether they can request kubeconfigs scoped to a namespace
+type Group struct {
+	Roles      []string `json:"roles,omitempty"`
+	Namespaced bool     `json:"namespaced,omitempty"`
+}
+
+// Scope is the role and the namespace the kubeconfig is generated for
+type Scope struct {
+	Name      string
+	Rules     run.RoleRules
+	Namespace string
+}
+
+// StringSource provides the raw configuration, for example reload.StringValueReloader
+type StringSource interface {
+	GetString() string
+}
+
+// Resolver resolves the scope of the kubeconfig using the current configuration
+type Resolver struct {
+	source StringSource
+}
+
+// NewResolver returns a new instance of Resolver
+func NewResolver(source StringSource) *Resolver {
+	return &Resolver{source: source}
+}
+
+// DefaultConfig is used when no configuration file is provided, the runtime admins can request the runtime operator role
+const DefaultConfig = `
+groups:
+  runtimeAdmin:
+    roles: [runtimeOperator]
+`
+
+// ParseConfig parses and validates the configuration
+func ParseConfig(raw string) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict([]byte(raw), config); err != nil {
+		return nil, fmt.Errorf("while parsing roles config: %w", err)
+	}
+	for name, group := range config.Groups {
+		for _, role := range group.Roles {
+			if _, found := config.role(role); !found {
+				return nil, fmt.Errorf("role %s of group %s is not defined", role, name)
+			}
+		}
+	}
+	return config, nil
+}
+
+// Resolve returns the scope of the kubeconfig requested by a member of the group, the role of the group is used if no role is requested
+func (r *Resolver) Resolve(group, role, namespace string) (Scope, error) {
+	config, err := ParseConfig(r.source.GetString())
+	if err != nil {
+		return Scope{}, err
+	}
+
+	if role == "" {
+		role = group
+	}
+	rules, found := config.role(role)
+	if !found {
+		return Scope{}, fmt.Errorf("%w: role %s is not defined", ErrInvalidScope, role)
+	}
+	if namespace != "" {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return Scope{}, fmt.Errorf("%w: namespace %s is not valid: %s", ErrInvalidScope, namespace, strings.Join(errs, ", "))
+		}
+	}
+
+	groupConfig := config.Groups[group]
+	if role != group && !contains(groupConfig.Roles, role) {
+		return Scope{}, fmt.Errorf("%w: group %s cannot request role %s", ErrNotAllowed, group, role)
+	}
+	if namespace != "" && !groupConfig.Namespaced {
+		return Scope{}, fmt.Errorf("%w: group %s cannot request namespace-scoped kubeconfigs", ErrNotAllowed, group)
+	}
+
+	return Scope{Name: role, Rules: rules, Namespace: namespace}, nil
+}
+
+// Identity returns the name of the service account and the RBAC resources created for the user in the scope.
+// The kubeconfigs of the role of the group without a namespace use the user ID, so the existing service accounts are reused.
+func (s Scope) Identity(userID, group string) string {
+	if s.Name == group && s.Namespace == "" {
+		return userID
+	}
+	parts := []string{userID, strings.ToLower(s.Name)}
+	if s.Namespace != "" {
+		parts = append(parts, s.Namespace)
+	}
+	identity := identityRegex.ReplaceAllString(strings.Join(parts, "-"), "")
+	if len(identity) > maxIdentityLength {
+		hash := sha256.Sum256([]byte(identity))
+		identity = fmt.Sprintf("%s-%x", strings.TrimRight(identity[:maxIdentityLength-9], "-"), hash[:4])
+	}
+	return identity
+}
+
+func (c *Config) role(name string) (run.RoleRules, bool) {
+	if rules, found := c.Roles[name]; found {
+		return rules, true
+	}
+	if rules := run.DefaultRoleRules(name); rules != nil {
+		return *rules, true
+	}
+	return run.RoleRules{}, false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package roles
+
+import (
+	"errors"
+	"testing"
+
+	run "github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testConfig = `
+roles:
+  viewer:
+    clusterRoleSelectors:
+    - matchLabels:
+        rbac.authorization.k8s.io/aggregate-to-view: "true"
+groups:
+  runtimeAdmin:
+    roles: [runtimeOperator, viewer]
+    namespaced: true
+  runtimeOperator:
+    roles: [viewer]
+`
+
+type staticSource string
+
+func (s staticSource) GetString() string {
+	return string(s)
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	viewer := run.RoleRules{ClusterRoleSelectors: []metav1.LabelSelector{{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-view": "true"}}}}
+
+	for name, tc := range map[string]struct {
+		config    string
+		group     string
+		role      string
+		namespace string
+		expected  Scope
+		err       error
+	}{
+		"role of the group": {
+			config:   testConfig,
+			group:    "runtimeOperator",
+			expected: Scope{Name: "runtimeOperator", Rules: *run.DefaultRoleRules("runtimeOperator")},
+		},
+		"narrower role": {
+			config:   testConfig,
+			group:    "runtimeOperator",
+			role:     "viewer",
+			expected: Scope{Name: "viewer", Rules: viewer},
+		},
+		"narrower role in namespace": {
+			config:    testConfig,
+			group:     "runtimeAdmin",
+			role:      "viewer",
+			namespace: "foo",
+			expected:  Scope{Name: "viewer", Rules: viewer, Namespace: "foo"},
+		},
+		"role not allowed for the group": {
+			config: testConfig,
+			group:  "runtimeOperator",
+			role:   "runtimeAdmin",
+			err:    ErrNotAllowed,
+		},
+		"namespace not allowed for the group": {
+			config:    testConfig,
+			group:     "runtimeOperator",
+			role:      "viewer",
+			namespace: "foo",
+			err:       ErrNotAllowed,
+		},
+		"role not defined": {
+			config: testConfig,
+			group:  "runtimeAdmin",
+			role:   "clusterAdmin",
+			err:    ErrInvalidScope,
+		},
+		"invalid namespace": {
+			config:    testConfig,
+			group:     "runtimeAdmin",
+			namespace: "Foo_Bar",
+			err:       ErrInvalidScope,
+		},
+		"default config": {
+			config:   DefaultConfig,
+			group:    "runtimeAdmin",
+			role:     "runtimeOperator",
+			expected: Scope{Name: "runtimeOperator", Rules: *run.DefaultRoleRules("runtimeOperator")},
+		},
+		"namespace not allowed by default config": {
+			config:    DefaultConfig,
+			group:     "runtimeAdmin",
+			namespace: "foo",
+			err:       ErrNotAllowed,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			resolver := NewResolver(staticSource(tc.config))
+
+			// when
+			scope, err := resolver.Resolve(tc.group, tc.role, tc.namespace)
+
+			// then
+			if tc.err != nil {
+				assert.True(t, errors.Is(err, tc.err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, scope)
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	t.Run("should return error when the role of a group is not defined", func(t *testing.T) {
+		_, err := ParseConfig("groups:\n  runtimeOperator:\n    roles: [viewer]\n")
+
+		assert.EqualError(t, err, "role viewer of group runtimeOperator is not defined")
+	})
+
+	t.Run("should return error when the config contains unknown fields", func(t *testing.T) {
+		_, err := ParseConfig("roles:\n  viewer:\n    rule: []\n")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestScope_Identity(t *testing.T) {
+	for name, tc := range map[string]struct {
+		scope    Scope
+		expected string
+	}{
+		"role of the group":              {scope: Scope{Name: "runtimeAdmin"}, expected: "jdoe"},
+		"narrower role":                  {scope: Scope{Name: "viewer"}, expected: "jdoe-viewer"},
+		"role of the group in namespace": {scope: Scope{Name: "runtimeAdmin", Namespace: "foo"}, expected: "jdoe-runtimeadmin-foo"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.scope.Identity("jdoe", "runtimeAdmin"))
+		})
+	}
+
+	t.Run("long identity is shortened", func(t *testing.T) {
+		scope := Scope{Name: "viewer", Namespace: "a-very-long-namespace-name-of-the-application"}
+
+		identity := scope.Identity("jdoe", "runtimeAdmin")
+
+		assert.Len(t, identity, maxIdentityLength)
+		assert.Regexp(t, "^jdoe-viewer-a-very-long-namespace-name-of-[0-9a-f]{8}$", identity)
+		assert.NotEqual(t, identity, Scope{Name: "viewer", Namespace: "a-very-long-namespace-name-of-the-other-app"}.Identity("jdoe", "runtimeAdmin"))
+	})
+}
@@ -0,0 +1,110 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// janitorGracePeriod gives the timers the time to remove the artefacts first, so that the janitor only handles the lost timers
+const janitorGracePeriod = 10 * time.Minute
+
+// Janitor periodically removes the service account artefacts of the expired kubeconfigs from the runtimes.
+// The artefacts are usually removed by the timers, the janitor removes the artefacts whose timers were lost,
+// for example when the service was restarted while the ConfigMaps could not be read.
+type Janitor struct {
+	kcpK8s           kubernetes.Interface
+	interval         time.Duration
+	recorder         audit.Recorder
+	newRuntimeClient func(configMap v1.ConfigMap, runtimeID string) (*RuntimeClient, error)
+	now              func() time.Time
+}
+
+// NewJanitor returns a new instance of Janitor which checks the ConfigMaps of the users in the given interval
+func NewJanitor(interval time.Duration, recorder audit.Recorder) (*Janitor, error) {
+	coreClientset, err := GetK8sClient()
+	if err != nil {
+		return nil, err
+	}
+	return &Janitor{
+		kcpK8s:           coreClientset,
+		interval:         interval,
+		recorder:         recorder,
+		newRuntimeClient: runtimeClientForConfigMap,
+		now:              time.Now,
+	}, nil
+}
+
+// Run removes the expired artefacts until the context is cancelled
+func (j *Janitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := j.CleanExpired()
+			if err != nil {
+				log.Errorf("Janitor failed to list ConfigMaps, %s", err.Error())
+				continue
+			}
+			log.Infof("Janitor removed %d expired service accounts.", removed)
+		}
+	}
+}
+
+// CleanExpired removes the artefacts of the expired kubeconfigs and returns the number of the removed service accounts.
+// A failure of a single runtime is logged and does not stop the cleanup of the other runtimes.
+func (j *Janitor) CleanExpired() (int, error) {
+	configMapList, err := j.kcpK8s.CoreV1().ConfigMaps(KcpNamespace).List(context.Background(), metav1.ListOptions{LabelSelector: "service=kubeconfig"})
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, configMap := range configMapList.Items {
+		userID := configMap.ObjectMeta.Name
+		for runtimeID, startTimeString := range configMap.Data {
+			startTime, err := time.Parse(startTimeLayout, startTimeString)
+			if err != nil {
+				log.Errorf("Failed to convert start time of runtime %s user %s, %s", runtimeID, userID, err.Error())
+				continue
+			}
+			if j.now().Before(startTime.Add(ExpireTime + janitorGracePeriod)) {
+				continue
+			}
+
+			log.Infof("Janitor found expired service account for runtime %s user %s.", runtimeID, userID)
+			if err := j.clean(configMap, runtimeID); err != nil {
+				log.Errorf("Janitor failed to clean runtime %s for user %s, %s", runtimeID, userID, err.Error())
+				continue
+			}
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+func (j *Janitor) clean(configMap v1.ConfigMap, runtimeID string) error {
+	rtc, err := j.newRuntimeClient(configMap, runtimeID)
+	if err != nil {
+		return err
+	}
+	if rtc == nil {
+		//the artefacts were removed together with the shoot
+		if err := cleanConfigMap(j.kcpK8s, configMap.ObjectMeta.Name, runtimeID); err != nil {
+			return err
+		}
+		recordRevoked(j.recorder, revokedRecordForConfigMap(configMap, runtimeID))
+		return nil
+	}
+	rtc.KcpK8s = j.kcpK8s
+	rtc.Recorder = j.recorder
+	return rtc.cleanup(runtimeID)
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type recorderMock struct {
+	records []audit.Record
+}
+
+func (r *recorderMock) Record(record audit.Record) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+func TestJanitor_CleanExpired(t *testing.T) {
+	// given
+	now := time.Date(2022, 10, 17, 10, 0, 0, 0, time.UTC)
+	expired := strings.Split(now.Add(-ExpireTime-time.Hour).String(), " m=")[0]
+	valid := strings.Split(now.Add(-time.Hour).String(), " m=")[0]
+
+	kcpK8s := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:        "jdoe",
+			Namespace:   KcpNamespace,
+			Labels:      map[string]string{"service": "kubeconfig"},
+			Annotations: map[string]string{"role": RUNTIME_ADMIN, "tenant": "tenant", "user": "jdoe"},
+		},
+		Data: map[string]string{"expired": expired, "valid": valid, "deleted": expired, "unreachable": expired},
+	})
+
+	runtimeK8s := fake.NewSimpleClientset(&corev1.ServiceAccount{ObjectMeta: v1.ObjectMeta{Name: "jdoe", Namespace: Namespace}})
+	recorder := &recorderMock{}
+	janitor := &Janitor{
+		kcpK8s:   kcpK8s,
+		recorder: recorder,
+		now:      func() time.Time { return now },
+		newRuntimeClient: func(configMap corev1.ConfigMap, runtimeID string) (*RuntimeClient, error) {
+			switch runtimeID {
+			case "deleted":
+				return nil, nil
+			case "unreachable":
+				return nil, errors.New("connection refused")
+			}
+			rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), configMap.Name, configMap.Annotations["role"], configMap.Annotations["tenant"])
+			require.NoError(t, err)
+			rtc.User.Namespace = Namespace
+			rtc.User.UserID = configMap.Annotations["user"]
+			rtc.K8s = runtimeK8s
+			return rtc, nil
+		},
+	}
+
+	// when
+	removed, err := janitor.CleanExpired()
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	_, err = runtimeK8s.CoreV1().ServiceAccounts(Namespace).Get(context.TODO(), "jdoe", v1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	cm, err := kcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.TODO(), "jdoe", v1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"valid": valid, "unreachable": expired}, cm.Data)
+
+	require.Len(t, recorder.records, 2)
+	for _, record := range recorder.records {
+		assert.Equal(t, audit.EventRevoked, record.Event)
+		assert.Equal(t, "jdoe", record.UserID)
+		assert.Equal(t, "jdoe", record.ServiceAccount)
+		assert.Equal(t, RUNTIME_ADMIN, record.Role)
+		assert.Equal(t, "tenant", record.TenantID)
+	}
+}
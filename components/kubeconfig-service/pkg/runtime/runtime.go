@@ -8,9 +8,11 @@ import (
 	"time"
 
 	"github.com/avast/retry-go"
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 
@@ -30,11 +32,16 @@ type SAInfo struct {
 	Namespace              string
 	SecretName             string
 	TenantID               string
+	// BindingNamespace limits the access to the namespace with a RoleBinding instead of a ClusterRoleBinding
+	BindingNamespace string
+	// UserID is the ID of the user the service account is created for, it is recorded in the audit log
+	UserID string
 }
 
 const SA = "SA"
 const ClusterRole = "ClusterRole"
 const ClusterRoleBinding = "ClusterRoleBinding"
+const RoleBinding = "RoleBinding"
 const Namespace = "kyma-system"
 const RUNTIME_ADMIN = "runtimeAdmin"
 const RUNTIME_OPERATOR = "runtimeOperator"
@@ -69,6 +76,24 @@ var L2L3OperatorAggregationRule = map[string][]metav1.LabelSelector{
 	},
 }
 
+// RoleRules contains the policy rules of a role and the selectors of the cluster roles aggregated by the role
+type RoleRules struct {
+	Rules                []rbacv1.PolicyRule    `json:"rules,omitempty"`
+	ClusterRoleSelectors []metav1.LabelSelector `json:"clusterRoleSelectors,omitempty"`
+}
+
+// DefaultRoleRules returns the rules of the roles of the L2/L3 operator groups, nil if the role is not known
+func DefaultRoleRules(role string) *RoleRules {
+	rules, found := L2L3OperatorPolicyRule[role]
+	if !found {
+		return nil
+	}
+	return &RoleRules{
+		Rules:                rules,
+		ClusterRoleSelectors: L2L3OperatorAggregationRule[role],
+	}
+}
+
 type RollbackE struct {
 	Data []string
 }
@@ -78,6 +103,8 @@ type RuntimeClient struct {
 	User              SAInfo
 	L2L3OperatiorRole string
 	RollbackE         RollbackE
+	RoleRules         *RoleRules
+	Recorder          audit.Recorder
 }
 
 func NewRuntimeClient(kubeConfig []byte, userID string, L2L3OperatiorRole string, tenant string) (*RuntimeClient, error) {
@@ -104,12 +131,38 @@ func NewRuntimeClient(kubeConfig []byte, userID string, L2L3OperatiorRole string
 		Namespace:              Namespace,
 		TenantID:               tenant,
 	}
-	RollbackE := RollbackE{}
-	return &RuntimeClient{clientset, coreClientset, user, L2L3OperatiorRole, RollbackE}, nil
+	return &RuntimeClient{
+		K8s:               clientset,
+		KcpK8s:            coreClientset,
+		User:              user,
+		L2L3OperatiorRole: L2L3OperatiorRole,
+		RollbackE:         RollbackE{},
+		RoleRules:         DefaultRoleRules(L2L3OperatiorRole),
+	}, nil
 }
 
 // kubeconfig access runtime, create sa and clusterrole and clusterrolebinding according to userID and l2L3OperatiorRole
 func (rtc *RuntimeClient) Run() (string, error) {
+	saToken, err := rtc.run(rtc.getSecretToken)
+	return string(saToken), err
+}
+
+// RunWithTokenRequest creates the same resources as Run, but returns a short-lived token of the service account
+// bound to the given audiences instead of the token from the service account secret
+func (rtc *RuntimeClient) RunWithTokenRequest(audiences []string, expirationSeconds int64) (string, time.Time, error) {
+	var expiration time.Time
+	token, err := rtc.run(func() ([]byte, error) {
+		tokenRequest, err := rtc.requestToken(audiences, expirationSeconds)
+		if err != nil {
+			return nil, err
+		}
+		expiration = tokenRequest.Status.ExpirationTimestamp.Time
+		return []byte(tokenRequest.Status.Token), nil
+	})
+	return string(token), expiration, err
+}
+
+func (rtc *RuntimeClient) run(getToken func() ([]byte, error)) ([]byte, error) {
 	var resultE error
 	defer func() {
 		if err := rtc.Cleaner(); err != nil {
@@ -119,33 +172,33 @@ func (rtc *RuntimeClient) Run() (string, error) {
 
 	err := rtc.createServiceAccount()
 	if err != nil {
-		return "", errors.Wrapf(err, "while createServiceAccount %s in %s", rtc.User.ServiceAccountName, rtc.User.Namespace)
+		return nil, errors.Wrapf(err, "while createServiceAccount %s in %s", rtc.User.ServiceAccountName, rtc.User.Namespace)
 	}
 
 	err = rtc.createClusterRoleRules()
 	if err != nil {
 		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA)
-		return "", errors.Wrapf(err, "while createClusterRole %s", rtc.User.ClusterRoleName)
+		return nil, errors.Wrapf(err, "while createClusterRole %s", rtc.User.ClusterRoleName)
 	}
 
 	err = rtc.createClusterRole()
 	if err != nil {
 		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
-		return "", errors.Wrapf(err, "while createClusterRole %s", rtc.User.ClusterRoleName)
+		return nil, errors.Wrapf(err, "while createClusterRole %s", rtc.User.ClusterRoleName)
 	}
 
-	saToken, err := rtc.getSecretToken()
+	saToken, err := getToken()
 	if err != nil {
 		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
-		return "", errors.Wrapf(err, "while getSecretToken from %s", rtc.User.ServiceAccountName)
+		return nil, errors.Wrapf(err, "while getting token of %s", rtc.User.ServiceAccountName)
 	}
 
 	err = rtc.createClusterRoleBinding()
 	if err != nil {
 		rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA, ClusterRole)
-		return "", errors.Wrapf(err, "while createClusterRoleBinding %s", rtc.User.ClusterRoleBindingName)
+		return nil, errors.Wrapf(err, "while createClusterRoleBinding %s", rtc.User.ClusterRoleBindingName)
 	}
-	return string(saToken), resultE
+	return saToken, resultE
 }
 
 func (rtc *RuntimeClient) createServiceAccount() error {
@@ -163,11 +216,11 @@ func (rtc *RuntimeClient) createServiceAccount() error {
 }
 
 func (rtc *RuntimeClient) createClusterRoleRules() error {
-	if rtc.L2L3OperatiorRole != RUNTIME_ADMIN && rtc.L2L3OperatiorRole != RUNTIME_OPERATOR {
-		return fmt.Errorf("role %s not in [%s,%s]", rtc.L2L3OperatiorRole, RUNTIME_ADMIN, RUNTIME_OPERATOR)
+	if rtc.RoleRules == nil {
+		return fmt.Errorf("role %s is not defined", rtc.L2L3OperatiorRole)
 	}
 
-	crExist, err := rtc.verifyClusterRoleRules(rtc.L2L3OperatiorRole)
+	crExist, err := rtc.verifyClusterRoleRules()
 	if err != nil {
 		return errors.Wrapf(err, "in verifyClusterRoleRules")
 	}
@@ -175,14 +228,18 @@ func (rtc *RuntimeClient) createClusterRoleRules() error {
 		return nil
 	}
 
-	clusterrole := initClusterRoleRules(rtc.User.ClusterRoleRulesName, rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	clusterrole := initClusterRoleRules(rtc.User.ClusterRoleRulesName, rtc.RoleRules.Rules, rtc.User.ClusterRoleAggrLabel)
 	_, err = rtc.K8s.RbacV1().ClusterRoles().Create(context.TODO(), clusterrole, metav1.CreateOptions{})
 	return err
 }
 
 func (rtc *RuntimeClient) createClusterRole() error {
 
-	crExist, err := rtc.verifyClusterRole(rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	if rtc.RoleRules == nil {
+		return fmt.Errorf("role %s is not defined", rtc.L2L3OperatiorRole)
+	}
+
+	crExist, err := rtc.verifyClusterRole(rtc.User.ClusterRoleAggrLabel)
 	if err != nil {
 		return errors.Wrapf(err, "in verifyClusterRoleAggregation")
 	}
@@ -190,12 +247,15 @@ func (rtc *RuntimeClient) createClusterRole() error {
 		return nil
 	}
 
-	clusterrole := initClusterRole(rtc.User.ClusterRoleName, rtc.L2L3OperatiorRole, rtc.User.ClusterRoleAggrLabel)
+	clusterrole := initClusterRole(rtc.User.ClusterRoleName, rtc.RoleRules.ClusterRoleSelectors, rtc.User.ClusterRoleAggrLabel)
 	_, err = rtc.K8s.RbacV1().ClusterRoles().Create(context.TODO(), clusterrole, metav1.CreateOptions{})
 	return err
 }
 
 func (rtc *RuntimeClient) createClusterRoleBinding() error {
+	if rtc.User.BindingNamespace != "" {
+		return rtc.createRoleBinding()
+	}
 	objectMeta, roleRef, subjects := initCRBindingE(rtc.User)
 	existed, err := rtc.verifyCRBinding(roleRef, subjects)
 	if err != nil {
@@ -209,6 +269,26 @@ func (rtc *RuntimeClient) createClusterRoleBinding() error {
 	return err
 }
 
+// createRoleBinding binds the cluster role in the namespace the access is limited to
+func (rtc *RuntimeClient) createRoleBinding() error {
+	objectMeta, roleRef, subjects := initCRBindingE(rtc.User)
+	objectMeta.Namespace = rtc.User.BindingNamespace
+	existed, err := rtc.verifyRoleBinding(roleRef, subjects)
+	if err != nil {
+		return errors.Wrapf(err, "in verifyRoleBinding")
+	}
+	if existed {
+		return nil
+	}
+	rolebinding := &rbacv1.RoleBinding{
+		ObjectMeta: objectMeta,
+		RoleRef:    roleRef,
+		Subjects:   subjects,
+	}
+	_, err = rtc.K8s.RbacV1().RoleBindings(rtc.User.BindingNamespace).Create(context.TODO(), rolebinding, metav1.CreateOptions{})
+	return err
+}
+
 func (rtc *RuntimeClient) deleteServiceAccount() (bool, error) {
 	err := rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Delete(context.TODO(), rtc.User.ServiceAccountName, metav1.DeleteOptions{})
 	if err == nil || apierr.IsNotFound(err) {
@@ -245,10 +325,10 @@ func (rtc *RuntimeClient) verifyServiceAccount() (bool, error) {
 	return false, err
 }
 
-func (rtc *RuntimeClient) verifyClusterRoleRules(l2L3OperatiorRole string) (bool, error) {
+func (rtc *RuntimeClient) verifyClusterRoleRules() (bool, error) {
 	cr, err := rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleRulesName, metav1.GetOptions{})
 	if cr != nil && err == nil {
-		if reflect.DeepEqual(cr.Rules, L2L3OperatorPolicyRule[l2L3OperatiorRole]) {
+		if equalRules(cr.Rules, rtc.RoleRules.Rules) {
 			return true, nil
 		} else {
 			_, err = rtc.deleteClusterRole(rtc.User.ClusterRoleRulesName)
@@ -266,11 +346,11 @@ func (rtc *RuntimeClient) verifyClusterRoleRules(l2L3OperatiorRole string) (bool
 	return false, err
 }
 
-func (rtc *RuntimeClient) verifyClusterRole(l2L3OperatiorRole string, aggregationLabel string) (bool, error) {
+func (rtc *RuntimeClient) verifyClusterRole(aggregationLabel string) (bool, error) {
 	cr, err := rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), rtc.User.ClusterRoleName, metav1.GetOptions{})
 	if cr != nil && err == nil {
 		expectedSelectors := []metav1.LabelSelector{}
-		expectedSelectors = append(expectedSelectors, L2L3OperatorAggregationRule[l2L3OperatiorRole]...)
+		expectedSelectors = append(expectedSelectors, rtc.RoleRules.ClusterRoleSelectors...)
 		expectedSelectors = append(expectedSelectors, metav1.LabelSelector{
 			MatchLabels: map[string]string{
 				aggregationLabel: "true",
@@ -315,6 +395,33 @@ func (rtc *RuntimeClient) verifyCRBinding(roleRef rbacv1.RoleRef, subjects []rba
 	return false, err
 }
 
+func (rtc *RuntimeClient) verifyRoleBinding(roleRef rbacv1.RoleRef, subjects []rbacv1.Subject) (bool, error) {
+	rb, err := rtc.K8s.RbacV1().RoleBindings(rtc.User.BindingNamespace).Get(context.TODO(), rtc.User.ClusterRoleBindingName, metav1.GetOptions{})
+	if rb != nil && err == nil {
+		if reflect.DeepEqual(rb.Subjects, subjects) && reflect.DeepEqual(rb.RoleRef, roleRef) {
+			return true, nil
+		}
+		err = rtc.K8s.RbacV1().RoleBindings(rtc.User.BindingNamespace).Delete(context.TODO(), rtc.User.ClusterRoleBindingName, metav1.DeleteOptions{})
+		if err == nil || apierr.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "in deleteRoleBinding")
+	}
+
+	if apierr.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// equalRules compares the rules of the cluster role, the rules of a role without rules are stored as an empty list
+func equalRules(actual, expected []rbacv1.PolicyRule) bool {
+	if len(actual) == 0 && len(expected) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(actual, expected)
+}
+
 func (rtc *RuntimeClient) getSecretToken() ([]byte, error) {
 	var token []byte
 	// Wait for the TokenController to provision a ServiceAccount token
@@ -345,6 +452,16 @@ func (rtc *RuntimeClient) getSecretToken() ([]byte, error) {
 	return token, err
 }
 
+func (rtc *RuntimeClient) requestToken(audiences []string, expirationSeconds int64) (*authenticationv1.TokenRequest, error) {
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	return rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).CreateToken(context.TODO(), rtc.User.ServiceAccountName, tokenRequest, metav1.CreateOptions{})
+}
+
 func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
 	return &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
@@ -354,7 +471,7 @@ func initServiceAccount(user SAInfo) *corev1.ServiceAccount {
 	}
 }
 
-func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggregationLabel string) *rbacv1.ClusterRole {
+func initClusterRoleRules(clusterRoleName string, rules []rbacv1.PolicyRule, aggregationLabel string) *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: clusterRoleName,
@@ -362,11 +479,11 @@ func initClusterRoleRules(clusterRoleName string, l2L3OperatiorRole string, aggr
 				aggregationLabel: "true",
 			},
 		},
-		Rules: L2L3OperatorPolicyRule[l2L3OperatiorRole],
+		Rules: rules,
 	}
 }
 
-func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregationLabel string) *rbacv1.ClusterRole {
+func initClusterRole(clusterRoleName string, selectors []metav1.LabelSelector, aggregationLabel string) *rbacv1.ClusterRole {
 	clusterrole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: clusterRoleName,
@@ -375,7 +492,7 @@ func initClusterRole(clusterRoleName string, l2L3OperatiorRole string, aggregati
 			ClusterRoleSelectors: []metav1.LabelSelector{},
 		},
 	}
-	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, L2L3OperatorAggregationRule[l2L3OperatiorRole]...)
+	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, selectors...)
 	clusterrole.AggregationRule.ClusterRoleSelectors = append(clusterrole.AggregationRule.ClusterRoleSelectors, metav1.LabelSelector{
 		MatchLabels: map[string]string{
 			aggregationLabel: "true",
@@ -432,6 +549,8 @@ func (rtc *RuntimeClient) Cleaner() error {
 			go rtc.RetryDeleteClusterRoles(&wg, errorCh)
 		case ClusterRoleBinding:
 			go rtc.RetryDeleteClusterRoleBinding(&wg, errorCh)
+		case RoleBinding:
+			go rtc.RetryDeleteRoleBinding(&wg, errorCh)
 		default:
 			wg.Done()
 		}
@@ -527,3 +646,24 @@ func (rtc *RuntimeClient) RetryDeleteClusterRoleBinding(wg *sync.WaitGroup, erro
 	}
 	log.Infof(fmt.Sprintf("Cluster Role Binding \"%s\" is removed", rtc.User.ClusterRoleName))
 }
+
+func (rtc *RuntimeClient) RetryDeleteRoleBinding(wg *sync.WaitGroup, errorCh chan error) {
+	defer wg.Done()
+
+	err := retry.Do(func() error {
+		err := rtc.K8s.RbacV1().RoleBindings(rtc.User.BindingNamespace).Delete(context.TODO(), rtc.User.ClusterRoleBindingName, metav1.DeleteOptions{})
+
+		if err != nil && !apierr.IsNotFound(err) {
+			errorCh <- err
+		} else if apierr.IsNotFound(err) {
+			return nil
+		}
+
+		return errors.Wrapf(err, "Role Binding \"%s\" still exists in \"%s\" Namespace", rtc.User.ClusterRoleBindingName, rtc.User.BindingNamespace)
+	})
+	if err != nil {
+		errorCh <- err
+		return
+	}
+	log.Infof(fmt.Sprintf("Role Binding \"%s\" is removed from \"%s\" Namespace", rtc.User.ClusterRoleBindingName, rtc.User.BindingNamespace))
+}
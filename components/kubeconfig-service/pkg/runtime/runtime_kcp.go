@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/audit"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/caller"
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/env"
 	log "github.com/sirupsen/logrus"
@@ -29,6 +30,8 @@ Label:       "service=kubeconfig",
 Annotation:map[string]string
     "role=L2L3ROLE"
      "tenant=tenantID"
+     "namespace=bindingNamespace" (only for namespace-scoped kubeconfigs)
+     "user=userID" (the user the service account is created for)
 Data:map[string]string
     "runtimeid-a" : "starttime"
     "runtimeid-b" : "starttime"
@@ -38,13 +41,15 @@ const KcpNamespace string = "kcp-system"
 
 const ExpireTime time.Duration = 24 * time.Hour
 
+const startTimeLayout = "2006-01-02 15:04:05 +0000 UTC"
+
 type JsonPatchType struct {
 	Op    string `json:"op"`
 	Path  string `json:"path"`
 	Value string `json:"value"`
 }
 
-func SetupConfigMap() error {
+func SetupConfigMap(recorder audit.Recorder) error {
 	configMapList, err := getConfigMapList()
 	if configMapList == nil && err == nil {
 		log.Info("No Timer will be setup.")
@@ -56,13 +61,13 @@ func SetupConfigMap() error {
 
 	for _, configMap := range (*configMapList).Items {
 		userID := configMap.ObjectMeta.Name
-		role := configMap.ObjectMeta.Annotations["role"]
-		tenantID := configMap.ObjectMeta.Annotations["tenant"]
 		for runtimeID, startTimeString := range configMap.Data {
 			log.Infof("Found ConfigMap for runtime %s user %s.", runtimeID, userID)
-			c := caller.NewCaller(env.Config.GraphqlURL, tenantID)
-			status, err := c.RuntimeStatus(runtimeID)
-			if strings.Contains(fmt.Sprint(err), "not found") && strings.Contains(fmt.Sprint(err), "error getting Shoot") {
+			rtc, err := runtimeClientForConfigMap(configMap, runtimeID)
+			if err != nil {
+				return err
+			}
+			if rtc == nil {
 				//delete ConfigMap if shoot no longer exists
 				coreClientset, err := GetK8sClient()
 				if err != nil {
@@ -74,18 +79,11 @@ func SetupConfigMap() error {
 					log.Errorf("Failed to clean ConfigMap for user %s runtime %s, %s", userID, runtimeID, err.Error())
 					return err
 				}
+				recordRevoked(recorder, revokedRecordForConfigMap(configMap, runtimeID))
 				continue
-			} else if err != nil {
-				log.Errorf("Failed to fetch runtime status.")
-				return err
 			}
-			rawConfig := *status.RuntimeConfiguration.Kubeconfig
-			rtc, err := NewRuntimeClient([]byte(rawConfig), userID, role, tenantID)
-			if err != nil {
-				log.Errorf("Failed to create runtime client.")
-				return err
-			}
-			startTime, err := time.Parse("2006-01-02 15:04:05 +0000 UTC", startTimeString)
+			rtc.Recorder = recorder
+			startTime, err := time.Parse(startTimeLayout, startTimeString)
 			if err != nil {
 				log.Errorf("Failed to convert start time.")
 				return err
@@ -97,6 +95,33 @@ func SetupConfigMap() error {
 	return nil
 }
 
+// runtimeClientForConfigMap returns the runtime client of the user and the runtime stored in the ConfigMap,
+// nil is returned if the shoot of the runtime no longer exists
+func runtimeClientForConfigMap(configMap v1.ConfigMap, runtimeID string) (*RuntimeClient, error) {
+	userID := configMap.ObjectMeta.Name
+	role := configMap.ObjectMeta.Annotations["role"]
+	tenantID := configMap.ObjectMeta.Annotations["tenant"]
+
+	c := caller.NewCaller(env.Config.GraphqlURL, tenantID)
+	status, err := c.RuntimeStatus(runtimeID)
+	if strings.Contains(fmt.Sprint(err), "not found") && strings.Contains(fmt.Sprint(err), "error getting Shoot") {
+		log.Infof("Shoot of runtime %s no longer exists.", runtimeID)
+		return nil, nil
+	} else if err != nil {
+		log.Errorf("Failed to fetch runtime status.")
+		return nil, err
+	}
+	rawConfig := *status.RuntimeConfiguration.Kubeconfig
+	rtc, err := NewRuntimeClient([]byte(rawConfig), userID, role, tenantID)
+	if err != nil {
+		log.Errorf("Failed to create runtime client.")
+		return nil, err
+	}
+	rtc.User.BindingNamespace = configMap.ObjectMeta.Annotations["namespace"]
+	rtc.User.UserID = configMap.ObjectMeta.Annotations["user"]
+	return rtc, nil
+}
+
 func GetK8sConfig() (*restclient.Config, error) {
 	k8sConfig, err := restclient.InClusterConfig()
 	if err != nil {
@@ -149,21 +174,65 @@ func (rtc *RuntimeClient) SetupTimer(startTime time.Time, runtimeID string) {
 		return
 	}
 
+	//errors are logged by cleanup, the janitor retries the cleanup later
+	_ = rtc.cleanup(runtimeID)
+}
+
+// cleanup removes the service account artefacts of the user from the runtime and the runtime from the ConfigMap of the user
+func (rtc *RuntimeClient) cleanup(runtimeID string) error {
+	userID := rtc.User.ServiceAccountName
 	log.Infof("Start to clean everything for runtime %s for user %s.", runtimeID, userID)
 	rtc.RollbackE.Data = append(rtc.RollbackE.Data, SA)
 	rtc.RollbackE.Data = append(rtc.RollbackE.Data, ClusterRole)
-	rtc.RollbackE.Data = append(rtc.RollbackE.Data, ClusterRoleBinding)
-	err = rtc.Cleaner()
+	if rtc.User.BindingNamespace != "" {
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, RoleBinding)
+	} else {
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, ClusterRoleBinding)
+	}
+	err := rtc.Cleaner()
 	if err != nil {
 		log.Errorf("Failed to clean runtime %s for user %s.", runtimeID, userID)
-		return
+		return err
 	}
 
 	err = rtc.UpdateConfigMap(runtimeID)
 	if err != nil {
 		log.Errorf("Failed to clean ConfigMap for runtime %s user %s", runtimeID, userID)
+		return err
+	}
+
+	recordRevoked(rtc.Recorder, audit.Record{
+		Event:          audit.EventRevoked,
+		UserID:         rtc.User.UserID,
+		Role:           rtc.L2L3OperatiorRole,
+		Namespace:      rtc.User.BindingNamespace,
+		TenantID:       rtc.User.TenantID,
+		RuntimeID:      runtimeID,
+		ServiceAccount: userID,
+	})
+	return nil
+}
+
+func revokedRecordForConfigMap(configMap v1.ConfigMap, runtimeID string) audit.Record {
+	return audit.Record{
+		Event:          audit.EventRevoked,
+		UserID:         configMap.ObjectMeta.Annotations["user"],
+		Role:           configMap.ObjectMeta.Annotations["role"],
+		Namespace:      configMap.ObjectMeta.Annotations["namespace"],
+		TenantID:       configMap.ObjectMeta.Annotations["tenant"],
+		RuntimeID:      runtimeID,
+		ServiceAccount: configMap.ObjectMeta.Name,
+	}
+}
+
+// recordRevoked records the removal of the artefacts, the artefacts are already removed so the failure is only logged
+func recordRevoked(recorder audit.Recorder, record audit.Record) {
+	if recorder == nil {
 		return
 	}
+	if err := recorder.Record(record); err != nil {
+		log.Errorf("Failed to record removal of service account %s from runtime %s, %s", record.ServiceAccount, record.RuntimeID, err.Error())
+	}
 }
 
 func (rtc *RuntimeClient) UpdateConfigMap(runtimeID string) error {
@@ -199,12 +268,19 @@ func (rtc *RuntimeClient) DeployConfigMap(runtimeID string, L2L3OperatorRole str
 	cm, err := rtc.KcpK8s.CoreV1().ConfigMaps(KcpNamespace).Get(context.Background(), userID, metav1.GetOptions{})
 	if k8serrors.IsNotFound(err) {
 		log.Info("User doens't exist. Trying to create configmap.")
+		annotations := map[string]string{"role": L2L3OperatorRole, "tenant": tenantID}
+		if rtc.User.BindingNamespace != "" {
+			annotations["namespace"] = rtc.User.BindingNamespace
+		}
+		if rtc.User.UserID != "" {
+			annotations["user"] = rtc.User.UserID
+		}
 		configmap := &v1.ConfigMap{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:        userID,
 				Namespace:   KcpNamespace,
 				Labels:      map[string]string{"service": "kubeconfig"},
-				Annotations: map[string]string{"role": L2L3OperatorRole, "tenant": tenantID},
+				Annotations: annotations,
 			},
 			Data: map[string]string{runtimeID: startTimeString},
 		}
@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 var sa1 = corev1.ServiceAccount{
@@ -34,7 +37,7 @@ func NewRuntimeClientTest(kubeConfig []byte, userID string, L2L3OperatiorRole st
 		TenantID:               tenant,
 	}
 	rollbackE := RollbackE{}
-	return &RuntimeClient{clientset, coreClientset, user, L2L3OperatiorRole, rollbackE}, nil
+	return &RuntimeClient{clientset, coreClientset, user, L2L3OperatiorRole, rollbackE, DefaultRoleRules(L2L3OperatiorRole), nil}, nil
 }
 
 func TestCreateserviceaccount(t *testing.T) {
@@ -320,3 +323,91 @@ func TestCreateserviceaccount(t *testing.T) {
 	})
 
 }
+
+func TestRunWithTokenRequest(t *testing.T) {
+	t.Run("Short-lived token of the service account is returned", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		assert.NoError(t, err)
+		expiration := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+		var requested *authenticationv1.TokenRequest
+		rtc.K8s.(*fake.Clientset).PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			createAction := action.(k8stesting.CreateAction)
+			if createAction.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+			requested = createAction.GetObject().(*authenticationv1.TokenRequest)
+			response := requested.DeepCopy()
+			response.Status = authenticationv1.TokenRequestStatus{Token: "short-lived", ExpirationTimestamp: v1.NewTime(expiration)}
+			return true, response, nil
+		})
+
+		token, exp, err := rtc.RunWithTokenRequest([]string{"kubernetes"}, 600)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "short-lived", token)
+		assert.True(t, expiration.Equal(exp))
+		assert.Equal(t, []string{"kubernetes"}, requested.Spec.Audiences)
+		assert.Equal(t, int64(600), *requested.Spec.ExpirationSeconds)
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), "sa1", v1.GetOptions{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Service account is removed when the token cannot be requested", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1", "runtimeOperator", "tenantID")
+		assert.NoError(t, err)
+		rtc.K8s.(*fake.Clientset).PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if action.GetSubresource() != "token" {
+				return false, nil, nil
+			}
+			return true, nil, fmt.Errorf("token request not allowed")
+		})
+
+		_, _, err = rtc.RunWithTokenRequest(nil, 600)
+
+		assert.Error(t, err)
+		_, err = rtc.K8s.CoreV1().ServiceAccounts(rtc.User.Namespace).Get(context.TODO(), "sa1", v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+}
+
+func TestNamespaceScopedBinding(t *testing.T) {
+	t.Run("If binding namespace is set rolebinding is created instead of clusterrolebinding", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1-viewer-foo", "viewer", "tenantID")
+		assert.NoError(t, err)
+		rtc.RoleRules = &RoleRules{ClusterRoleSelectors: []v1.LabelSelector{{MatchLabels: map[string]string{"rbac.authorization.k8s.io/aggregate-to-view": "true"}}}}
+		rtc.User.BindingNamespace = "foo"
+
+		_, err = rtc.run(func() ([]byte, error) { return []byte("token"), nil })
+		assert.NoError(t, err)
+
+		rb, err := rtc.K8s.RbacV1().RoleBindings("foo").Get(context.TODO(), "sa1-viewer-foo", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "sa1-viewer-foo", rb.RoleRef.Name)
+		assert.Equal(t, "sa1-viewer-foo", rb.Subjects[0].Name)
+		_, err = rtc.K8s.RbacV1().ClusterRoleBindings().Get(context.TODO(), "sa1-viewer-foo", v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+		rules, err := rtc.K8s.RbacV1().ClusterRoles().Get(context.TODO(), "sa1-viewer-foo-rules", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Empty(t, rules.Rules)
+
+		rtc.RollbackE.Data = append(rtc.RollbackE.Data, RoleBinding)
+		err = rtc.Cleaner()
+		assert.NoError(t, err)
+		_, err = rtc.K8s.RbacV1().RoleBindings("foo").Get(context.TODO(), "sa1-viewer-foo", v1.GetOptions{})
+		assert.True(t, k8serrors.IsNotFound(err))
+	})
+
+	t.Run("If binding namespace is set it is stored in kcp config map", func(t *testing.T) {
+		rtc, err := NewRuntimeClientTest([]byte("kubeconfig"), "sa1-viewer-foo", "viewer", "tenantID")
+		assert.NoError(t, err)
+		rtc.User.BindingNamespace = "foo"
+
+		err = rtc.DeployConfigMap("runtime1", "viewer", time.Now())
+		assert.NoError(t, err)
+
+		cm, err := rtc.KcpK8s.CoreV1().ConfigMaps("kcp-system").Get(context.Background(), "sa1-viewer-foo", v1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", cm.Annotations["namespace"])
+		assert.Equal(t, "viewer", cm.Annotations["role"])
+	})
+}
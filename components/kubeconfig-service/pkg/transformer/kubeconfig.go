@@ -39,8 +39,55 @@ contexts:
   context:
     cluster: {{ .ContextName }}
     user: {{ .UserID }}
+{{- if .Namespace }}
+    namespace: {{ .Namespace }}
+{{- end }}
 users:
 - name: {{ .UserID }}
   user:
     token: {{ .SaToken }}
 `
+
+// KubeconfigExecTemplate uses the kcp credential helper to get short-lived tokens from the token endpoint
+const KubeconfigExecTemplate = `
+---
+apiVersion: v1
+kind: Config
+current-context: {{ .ContextName }}
+clusters:
+- name: {{ .ContextName }}
+  cluster:
+    certificate-authority-data: {{ .CAData }}
+    server: {{ .ServerURL }}
+contexts:
+- name: {{ .ContextName }}
+  context:
+    cluster: {{ .ContextName }}
+    user: {{ .UserID }}
+{{- if .Namespace }}
+    namespace: {{ .Namespace }}
+{{- end }}
+users:
+- name: {{ .UserID }}
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: {{ .ExecCommand }}
+      args:
+      - kubeconfig
+      - credential
+      - --account
+      - {{ .TenantID }}
+      - --runtime-id
+      - {{ .RuntimeID }}
+{{- if .Role }}
+      - --role
+      - {{ .Role }}
+{{- end }}
+{{- if .Namespace }}
+      - --namespace
+      - {{ .Namespace }}
+{{- end }}
+      interactiveMode: IfAvailable
+      provideClusterInfo: false
+`
@@ -18,6 +18,11 @@ type Client struct {
 	OIDCClientID  string
 	SaToken       string
 	UserID        string
+	TenantID      string
+	RuntimeID     string
+	ExecCommand   string
+	Role          string
+	Namespace     string
 }
 
 //NewClient Create new instance of TransformerClient
@@ -35,6 +40,7 @@ func NewClient(rawKubeCfg string, userID string) (*Client, error) {
 		OIDCIssuerURL: env.Config.OIDC.Kubeconfig.IssuerURL,
 		SaToken:       "",
 		UserID:        userID,
+		ExecCommand:   env.Config.Exec.Command,
 	}, nil
 }
 
@@ -42,6 +42,36 @@ func TestSpec(t *testing.T) {
 			So(err, ShouldBeNil)
 			So(string(res), ShouldEqual, expectedTransformedKubeconfig)
 		})
+
+		Convey("Should return kubeconfig with the exec credential helper", func() {
+			//given
+			env.Config.Exec.Command = "kcp"
+			c, err := transformer.NewClient(testInputRawKubeconfig, testUserID)
+			So(err, ShouldBeNil)
+			c.TenantID = testTenantID
+			c.RuntimeID = testRuntimeID
+			//when
+			res, err := c.TransformKubeconfig(transformer.KubeconfigExecTemplate)
+			//then
+			So(err, ShouldBeNil)
+			So(string(res), ShouldEqual, expectedExecKubeconfig)
+		})
+
+		Convey("Should return kubeconfig with the exec credential helper for the role and the namespace", func() {
+			//given
+			env.Config.Exec.Command = "kcp"
+			c, err := transformer.NewClient(testInputRawKubeconfig, testUserID)
+			So(err, ShouldBeNil)
+			c.TenantID = testTenantID
+			c.RuntimeID = testRuntimeID
+			c.Role = "viewer"
+			c.Namespace = "foo"
+			//when
+			res, err := c.TransformKubeconfig(transformer.KubeconfigExecTemplate)
+			//then
+			So(err, ShouldBeNil)
+			So(string(res), ShouldEqual, expectedScopedExecKubeconfig)
+		})
 	})
 }
 
@@ -50,6 +80,8 @@ const (
 	testClientSecret = "testClientSecret"
 	testIssuerURL    = "testIssuerURL"
 	testUserID       = "i123456"
+	testTenantID     = "3e64ebae-38b5-46a0-b1ed-9ccee153a0ae"
+	testRuntimeID    = "ec8b348f-d8c8-49cd-956d-a0c783bfe329"
 
 	testInputRawKubeconfig = `
 apiVersion: v1
@@ -91,4 +123,73 @@ users:
   user:
     token: abcdef
 `
+
+	expectedExecKubeconfig = `
+---
+apiVersion: v1
+kind: Config
+current-context: test--aa1234b
+clusters:
+- name: test--aa1234b
+  cluster:
+    certificate-authority-data: LS0FakeFakeQo=
+    server: https://api.kymatest.com
+contexts:
+- name: test--aa1234b
+  context:
+    cluster: test--aa1234b
+    user: i123456
+users:
+- name: i123456
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: kcp
+      args:
+      - kubeconfig
+      - credential
+      - --account
+      - 3e64ebae-38b5-46a0-b1ed-9ccee153a0ae
+      - --runtime-id
+      - ec8b348f-d8c8-49cd-956d-a0c783bfe329
+      interactiveMode: IfAvailable
+      provideClusterInfo: false
+`
+
+	expectedScopedExecKubeconfig = `
+---
+apiVersion: v1
+kind: Config
+current-context: test--aa1234b
+clusters:
+- name: test--aa1234b
+  cluster:
+    certificate-authority-data: LS0FakeFakeQo=
+    server: https://api.kymatest.com
+contexts:
+- name: test--aa1234b
+  context:
+    cluster: test--aa1234b
+    user: i123456
+    namespace: foo
+users:
+- name: i123456
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: kcp
+      args:
+      - kubeconfig
+      - credential
+      - --account
+      - 3e64ebae-38b5-46a0-b1ed-9ccee153a0ae
+      - --runtime-id
+      - ec8b348f-d8c8-49cd-956d-a0c783bfe329
+      - --role
+      - viewer
+      - --namespace
+      - foo
+      interactiveMode: IfAvailable
+      provideClusterInfo: false
+`
 )
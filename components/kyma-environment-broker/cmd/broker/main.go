@@ -13,6 +13,8 @@ import (
 	"runtime/pprof"
 	"sort"
 	"time"
+	// the images do not contain the time zone database required to validate the hibernation schedule locations
+	_ "time/tzdata"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/dlmiddlecote/sqlstats"
@@ -29,6 +31,7 @@ import (
 	kebConfig "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/edp"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/estimate"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
 	eventshandler "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events/handler"
@@ -53,6 +56,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provider"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reconciler"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reencryption"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime/components"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtimeoverrides"
@@ -61,6 +65,8 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/suspension"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/swagger"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/watch"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -69,6 +75,7 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -136,6 +143,7 @@ type Config struct {
 	EDP edp.Config
 
 	Notification notification.Config
+	Webhooks     webhook.Config
 
 	VersionConfig struct {
 		Namespace string
@@ -149,6 +157,16 @@ type Config struct {
 	TrialRegionMappingFilePath string
 	MaxPaginationPage          int `envconfig:"default=100"`
 
+	// WatchPollInterval defines how often the watch endpoints read the watched operation from the database
+	// and send a keep-alive comment to the client
+	WatchPollInterval time.Duration `envconfig:"default=10s"`
+
+	PlanCatalog kebConfig.PlanCatalogConfig
+
+	Estimate estimate.Config
+
+	ReEncryption reencryption.Config
+
 	LogLevel string `envconfig:"default=info"`
 
 	// FreemiumProviders is a list of providers for freemium
@@ -242,7 +260,8 @@ func main() {
 	fatalOnError(err)
 
 	// create storage
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
 	var db storage.BrokerStorage
 	if cfg.DbInMemory {
 		db = storage.NewMemoryStorage()
@@ -252,8 +271,24 @@ func main() {
 		db = store
 		dbStatsCollector := sqlstats.NewStatsCollector("broker", conn)
 		prometheus.MustRegister(dbStatsCollector)
+
+		if cfg.ReEncryption.Enabled {
+			reEncryptionJob := reencryption.NewJob(cfg.ReEncryption, db.Instances(), db.Operations(), db.Bindings(), logs.WithField("service", "reEncryption"))
+			go reEncryptionJob.Run(ctx)
+		}
 	}
 
+	// load the catalog of the service plans and reload it whenever the ConfigMap changes
+	planCatalogProvider := kebConfig.NewPlanCatalogProvider(
+		kebConfig.NewPlanCatalogConfigMapReader(ctx, cli, cfg.PlanCatalog.ConfigMapName, logs.WithField("service", "planCatalog")),
+		broker.NewPlanCatalogUsageValidator(kebConfig.NewPlanCatalogConsistencyValidator(), db.Instances()),
+		kebConfig.NewPlanCatalogYAMLConverter())
+	planCatalog, err := planCatalogProvider.Provide()
+	fatalOnError(err)
+	fatalOnError(broker.SetPlanCatalog(*planCatalog))
+	fatalOnError(cfg.Broker.EnablePlans.Validate())
+	go planCatalogProvider.Watch(ctx, cfg.PlanCatalog.ReloadInterval, broker.SetPlanCatalog, logs.WithField("service", "planCatalog"))
+
 	// Customer Notification
 	clientHTTPForNotification := httputil.NewClient(60, true)
 	notificationClient := notification.NewClient(clientHTTPForNotification, notification.ClientConfig{
@@ -329,6 +364,21 @@ func main() {
 	// metrics collectors
 	metrics.RegisterAll(eventBroker, db.Operations(), db.Instances())
 	metrics.StartOpsMetricService(ctx, db.Operations(), logs)
+
+	// operation progress streamed by the watch endpoints
+	events.SetPublisher(eventBroker)
+	watchHub := watch.NewHub(logs.WithField("service", "watch"))
+	watchHub.Subscribe(eventBroker)
+
+	// operation lifecycle notifications
+	if cfg.Webhooks.Enabled {
+		webhooks, err := webhook.ReadWebhooksFromFile(cfg.Webhooks.WebhooksFilePath)
+		fatalOnError(err)
+		notifier := webhook.NewNotifier(cfg.Webhooks, webhooks, db.WebhookDeliveries(), logs.WithField("service", "webhooks"))
+		notifier.Subscribe(eventBroker)
+		fatalOnError(notifier.ResumePending(ctx))
+		go notifier.RunCleanup(ctx)
+	}
 	//setup runtime overrides appender
 	runtimeOverrides := runtimeoverrides.NewRuntimeOverrides(ctx, cli)
 
@@ -343,10 +393,13 @@ func main() {
 		avsDel, internalEvalAssistant, externalEvalCreator, internalEvalUpdater, runtimeVerConfigurator,
 		runtimeOverrides, edpClient, accountProvider, reconcilerClient, k8sClientProvider, cli, logs)
 
+	kcBuilder := kubeconfig.NewBuilder(provisionerClient)
+	bindingManager := kubeconfig.NewBindingManager(provisionerClient, kcBuilder, k8sClientSetProvider)
+
 	deprovisionManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("deprovisioning", "manager"))
 	deprovisionQueue := NewDeprovisioningProcessingQueue(ctx, workersAmount, deprovisionManager, &cfg, db, eventBroker, provisionerClient,
 		avsDel, internalEvalAssistant, externalEvalAssistant, bundleBuilder, edpClient, accountProvider, reconcilerClient,
-		k8sClientProvider, cli, logs)
+		bindingManager, k8sClientProvider, cli, logs)
 
 	updateManager := process.NewStagedManager(db.Operations(), eventBroker, cfg.OperationTimeout, logs.WithField("update", "manager"))
 	updateQueue := NewUpdateProcessingQueue(ctx, updateManager, 20, db, inputFactory, provisionerClient, eventBroker,
@@ -359,13 +412,12 @@ func main() {
 	// create server
 	router := mux.NewRouter()
 
-	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, logger, logs, inputFactory.GetPlanDefaults)
+	createAPI(router, servicesConfig, inputFactory, &cfg, db, provisionQueue, deprovisionQueue, updateQueue, bindingManager, logger, logs, inputFactory.GetPlanDefaults)
 
 	// create metrics endpoint
 	router.Handle("/metrics", promhttp.Handler())
 
 	// create SKR kubeconfig endpoint
-	kcBuilder := kubeconfig.NewBuilder(provisionerClient)
 	kcHandler := kubeconfig.NewHandler(db, kcBuilder, cfg.Kubeconfig.AllowOrigins, logs.WithField("service", "kubeconfigHandle"))
 	kcHandler.AttachRoutes(router)
 
@@ -405,9 +457,20 @@ func main() {
 	orchestrationHandler.AttachRoutes(router)
 
 	// create list runtimes endpoint
-	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), cfg.MaxPaginationPage, cfg.DefaultRequestRegion)
+	runtimeHandler := runtime.NewHandler(db.Instances(), db.Operations(), db.RuntimeStates(), provisionerClient, cfg.MaxPaginationPage, cfg.DefaultRequestRegion, logs.WithField("service", "runtimes"))
 	runtimeHandler.AttachRoutes(router)
 
+	// create operation and runtime watch endpoints
+	watchHandler := watch.NewHandler(db.Operations(), db.Instances(), watchHub, cfg.WatchPollInterval, logs.WithField("service", "watch"))
+	watchHandler.AttachRoutes(router)
+
+	// create consumption estimation endpoint
+	if cfg.Estimate.Enabled {
+		estimateHandler, err := newEstimateHandler(cfg.Estimate, db.Instances(), inputFactory.GetPlanDefaults, logs.WithField("service", "estimate"))
+		fatalOnError(err)
+		estimateHandler.AttachRoutes(router)
+	}
+
 	router.StrictSlash(true).PathPrefix("/").Handler(http.StripPrefix("/", http.FileServer(http.Dir("/swagger"))))
 	svr := handlers.CustomLoggingHandler(os.Stdout, router, func(writer io.Writer, params handlers.LogFormatterParams) {
 		logs.Infof("Call handled: method=%s url=%s statusCode=%d size=%d", params.Request.Method, params.URL.Path, params.StatusCode, params.Size)
@@ -416,6 +479,28 @@ func main() {
 	fatalOnError(http.ListenAndServe(cfg.Host+":"+cfg.Port, svr))
 }
 
+func newEstimateHandler(cfg estimate.Config, instances storage.Instances, planDefaults broker.PlanDefaults, log logrus.FieldLogger) (*estimate.Handler, error) {
+	if cfg.MachineSpecsFilePath == "" {
+		return nil, errors.New("the machine specs file must be configured to estimate the consumption")
+	}
+	specs, err := estimate.ReadMachineSpecsFromFile(cfg.MachineSpecsFilePath)
+	if err != nil {
+		return nil, err
+	}
+	prices := estimate.PriceTable{}
+	if cfg.PricesFilePath != "" {
+		prices, err = estimate.ReadPriceTableFromFile(cfg.PricesFilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var kmcClient estimate.KMCClient
+	if cfg.KMCURL != "" {
+		kmcClient = estimate.NewKMCClient(cfg.KMCURL, &http.Client{Timeout: cfg.KMCTimeout})
+	}
+	return estimate.NewHandler(estimate.NewEstimator(specs, prices, planDefaults), instances, kmcClient, cfg.DefaultHours, log), nil
+}
+
 func k8sClientProvider(kcfg string) (client.Client, error) {
 	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kcfg))
 	if err != nil {
@@ -431,6 +516,15 @@ func k8sClientProvider(kcfg string) (client.Client, error) {
 	return k8sCli, err
 }
 
+func k8sClientSetProvider(kcfg string) (kubernetes.Interface, error) {
+	restCfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kcfg))
+	if err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewForConfig(restCfg)
+}
+
 func checkDefaultVersions(versions ...string) error {
 	for _, version := range versions {
 		if !isVersionFollowingSemanticVersioning(version) {
@@ -448,7 +542,7 @@ func isVersionFollowingSemanticVersioning(version string) bool {
 	return false
 }
 
-func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
+func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planValidator broker.PlanValidator, cfg *Config, db storage.BrokerStorage, provisionQueue, deprovisionQueue, updateQueue *process.Queue, bindingManager broker.BindingManager, logger lager.Logger, logs logrus.FieldLogger, planDefaults broker.PlanDefaults) {
 	suspensionCtxHandler := suspension.NewContextUpdateHandler(db.Operations(), provisionQueue, deprovisionQueue, logs)
 
 	defaultPlansConfig, err := servicesConfig.DefaultPlansConfig()
@@ -469,10 +563,10 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 		broker.NewUpdate(cfg.Broker, db.Instances(), db.RuntimeStates(), db.Operations(), suspensionCtxHandler, cfg.UpdateProcessingEnabled, cfg.UpdateSubAccountMovementEnabled, updateQueue, planDefaults, logs, cfg.KymaDashboardConfig),
 		broker.NewGetInstance(cfg.Broker, db.Instances(), db.Operations(), logs),
 		broker.NewLastOperation(db.Operations(), logs),
-		broker.NewBind(logs),
-		broker.NewUnbind(logs),
-		broker.NewGetBinding(logs),
-		broker.NewLastBindingOperation(logs),
+		broker.NewBind(cfg.Broker.Binding, db.Instances(), db.Bindings(), bindingManager, logs),
+		broker.NewUnbind(db.Instances(), db.Bindings(), bindingManager, logs),
+		broker.NewGetBinding(db.Bindings(), logs),
+		broker.NewLastBindingOperation(db.Bindings(), logs),
 	}
 
 	router.Use(middleware.AddRegionToContext(cfg.DefaultRequestRegion))
@@ -486,7 +580,7 @@ func createAPI(router *mux.Router, servicesConfig broker.ServicesConfig, planVal
 	}
 
 	respWriter := httputil.NewResponseWriter(logs, cfg.DevelopmentMode)
-	runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(db.Instances(), db.Operations(), defaultPlansConfig, cfg.DefaultRequestRegion, respWriter)
+	runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(db.Instances(), db.Operations(), cfg.DefaultRequestRegion, respWriter)
 	router.Handle("/info/runtimes", runtimesInfoHandler)
 	router.Handle("/events", eventshandler.NewHandler(db.Events(), db.Instances()))
 }
@@ -511,6 +605,9 @@ func reprocessOrchestrations(orchestrationType orchestrationExt.Type, orchestrat
 	if err := processOrchestration(orchestrationType, orchestrationExt.InProgress, orchestrationsStorage, queue, log); err != nil {
 		return errors.Wrapf(err, "while processing in progress %s orchestrations", orchestrationType)
 	}
+	if err := processOrchestration(orchestrationType, orchestrationExt.Paused, orchestrationsStorage, queue, log); err != nil {
+		return errors.Wrapf(err, "while processing paused %s orchestrations", orchestrationType)
+	}
 	if err := processOrchestration(orchestrationType, orchestrationExt.Pending, orchestrationsStorage, queue, log); err != nil {
 		return errors.Wrapf(err, "while processing pending %s orchestrations", orchestrationType)
 	}
@@ -814,7 +911,7 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 	provisionerClient provisioner.Client, avsDel *avs.Delegator, internalEvalAssistant *avs.InternalEvalAssistant,
 	externalEvalAssistant *avs.ExternalEvalAssistant, bundleBuilder ias.BundleBuilder,
 	edpClient deprovisioning.EDPClient, accountProvider hyperscaler.AccountProvider, reconcilerClient reconciler.Client,
-	k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client, logs logrus.FieldLogger) *process.Queue {
+	bindingManager broker.BindingManager, k8sClientProvider func(kcfg string) (client.Client, error), cli client.Client, logs logrus.FieldLogger) *process.Queue {
 
 	deprovisioningSteps := []struct {
 		disabled bool
@@ -826,6 +923,9 @@ func NewDeprovisioningProcessingQueue(ctx context.Context, workersAmount int, de
 		{
 			step: deprovisioning.NewBTPOperatorCleanupStep(db.Operations(), provisionerClient, k8sClientProvider),
 		},
+		{
+			step: deprovisioning.NewRemoveBindingsStep(db.Instances(), db.Bindings(), bindingManager),
+		},
 		{
 			step: deprovisioning.NewAvsEvaluationsRemovalStep(avsDel, db.Operations(), externalEvalAssistant, internalEvalAssistant),
 		},
@@ -985,7 +1085,7 @@ func NewClusterOrchestrationProcessingQueue(ctx context.Context, db storage.Brok
 		},
 		{
 			weight:    10,
-			step:      upgrade_cluster.NewUpgradeClusterStep(db.Operations(), db.RuntimeStates(), provisionerClient, icfg),
+			step:      upgrade_cluster.NewUpgradeClusterStep(db.Operations(), db.RuntimeStates(), db.Orchestrations(), provisionerClient, icfg),
 			condition: provisioning.SkipForOwnClusterPlan,
 		},
 	}
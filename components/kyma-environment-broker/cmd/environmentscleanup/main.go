@@ -55,7 +55,8 @@ func main() {
 	provisionerClient := provisioner.NewProvisionerClient(cfg.Provisioner.URL, cfg.Provisioner.QueryDumping)
 
 	// create storage
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
 	db, conn, err := storage.NewFromConfig(cfg.Database, events.Config{}, cipher, log.WithField("service", "storage"))
 	fatalOnError(err)
 	dbStatsCollector := sqlstats.NewStatsCollector("broker", conn)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
@@ -16,10 +17,6 @@ import (
 	"github.com/vrischmann/envconfig"
 )
 
-const (
-	trialPlanID = broker.TrialPlanID
-)
-
 type BrokerClient interface {
 	SendExpirationRequest(instance internal.Instance) (bool, error)
 }
@@ -29,6 +26,9 @@ type Config struct {
 	Broker           broker.ClientConfig
 	DryRun           bool          `envconfig:"default=true"`
 	ExpirationPeriod time.Duration `envconfig:"default=336h"`
+	// PlanCatalogFile is the catalog of the service plans mounted from the KEB ConfigMap, the custom plans based
+	// on the trial plan are cleaned up too
+	PlanCatalogFile string `envconfig:"default=/config/plan-catalog/catalog.yaml"`
 }
 
 type TrialCleanupService struct {
@@ -60,8 +60,16 @@ func main() {
 	ctx := context.Background()
 	brokerClient := broker.NewClient(ctx, cfg.Broker)
 
+	planCatalog, err := config.NewPlanCatalogProvider(
+		config.NewPlanCatalogFileReader(cfg.PlanCatalogFile),
+		config.NewPlanCatalogConsistencyValidator(),
+		config.NewPlanCatalogYAMLConverter()).Provide()
+	fatalOnError(err)
+	fatalOnError(broker.SetPlanCatalog(*planCatalog))
+
 	// create storage connection
-	cipher := storage.NewEncrypter(cfg.Database.SecretKey)
+	cipher, err := storage.NewEncrypterFromConfig(cfg.Database)
+	fatalOnError(err)
 	db, conn, err := storage.NewFromConfig(cfg.Database, events.Config{}, cipher, log.WithField("service", "storage"))
 	fatalOnError(err)
 	svc := newTrialCleanupService(cfg, brokerClient, db.Instances())
@@ -94,7 +102,7 @@ func newTrialCleanupService(cfg Config, brokerClient BrokerClient, instances sto
 
 func (s *TrialCleanupService) PerformCleanup() error {
 
-	nonExpiredTrialInstancesFilter := dbmodel.InstanceFilter{PlanIDs: []string{trialPlanID}, Expired: &[]bool{false}[0]}
+	nonExpiredTrialInstancesFilter := dbmodel.InstanceFilter{PlanIDs: trialPlanIDs(), Expired: &[]bool{false}[0]}
 	nonExpiredTrialInstances, nonExpiredTrialInstancesCount, err := s.getInstances(nonExpiredTrialInstancesFilter)
 
 	if err != nil {
@@ -119,6 +127,17 @@ func (s *TrialCleanupService) PerformCleanup() error {
 	return nil
 }
 
+// trialPlanIDs returns the IDs of the trial plan and the custom plans based on it
+func trialPlanIDs() []string {
+	planIDs := []string{broker.TrialPlanID}
+	for _, plan := range broker.PlanCatalog().Plans {
+		if plan.ID != broker.TrialPlanID && broker.IsTrialPlan(plan.ID) {
+			planIDs = append(planIDs, plan.ID)
+		}
+	}
+	return planIDs
+}
+
 func (s *TrialCleanupService) getInstances(filter dbmodel.InstanceFilter) ([]internal.Instance, int, error) {
 
 	instances, _, totalCount, err := s.instanceStorage.List(filter)
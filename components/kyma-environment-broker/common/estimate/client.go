@@ -0,0 +1,90 @@
+package estimate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Client is the interface to estimate the resource consumption of runtimes using the KEB /estimate API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	GetEstimate(params Parameters) (Estimate, error)
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB /estimate API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+func (c *client) GetEstimate(params Parameters) (Estimate, error) {
+	estimate := Estimate{}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/estimate", c.url), nil)
+	if err != nil {
+		return estimate, errors.Wrap(err, "while creating request")
+	}
+	setQuery(req.URL, params)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return estimate, errors.Wrapf(err, "while calling %s", req.URL.String())
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return estimate, fmt.Errorf("calling %s returned %d (%s) status: %s", req.URL.String(), resp.StatusCode, http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&estimate); err != nil {
+		return estimate, errors.Wrap(err, "while decoding response body")
+	}
+	return estimate, nil
+}
+
+func setQuery(url *url.URL, params Parameters) {
+	query := url.Query()
+	setParam(query, PlanParam, params.Plan)
+	setParam(query, RegionParam, params.Region)
+	setParam(query, MachineTypeParam, params.MachineType)
+	setParam(query, RuntimeIDParam, params.RuntimeID)
+	setIntParam(query, AutoScalerMinParam, params.AutoScalerMin)
+	setIntParam(query, AutoScalerMaxParam, params.AutoScalerMax)
+	setIntParam(query, VolumeSizeGbParam, params.VolumeSizeGb)
+	if params.Hours > 0 {
+		query.Set(HoursParam, strconv.Itoa(params.Hours))
+	}
+	url.RawQuery = query.Encode()
+}
+
+func setParam(query url.Values, key, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+func setIntParam(query url.Values, key string, value *int) {
+	if value != nil {
+		query.Set(key, strconv.Itoa(*value))
+	}
+}
@@ -0,0 +1,59 @@
+package estimate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetEstimate(t *testing.T) {
+	t.Run("should send the parameters and decode the estimate", func(t *testing.T) {
+		// given
+		min, max := 3, 10
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "/estimate", r.URL.Path)
+			query := r.URL.Query()
+			assert.Equal(t, "aws", query.Get(PlanParam))
+			assert.Equal(t, "m5.2xlarge", query.Get(MachineTypeParam))
+			assert.Equal(t, "3", query.Get(AutoScalerMinParam))
+			assert.Equal(t, "10", query.Get(AutoScalerMaxParam))
+			assert.Equal(t, "24", query.Get(HoursParam))
+			assert.NotContains(t, query, RegionParam)
+			assert.NotContains(t, query, VolumeSizeGbParam)
+
+			err := json.NewEncoder(w).Encode(Estimate{PlanName: "aws", Hours: 24, Min: Resources{Nodes: 3}, Max: Resources{Nodes: 10}})
+			require.NoError(t, err)
+		}))
+		defer ts.Close()
+		client := NewClient(ts.URL, http.DefaultClient)
+
+		// when
+		estimate, err := client.GetEstimate(Parameters{Plan: "aws", MachineType: "m5.2xlarge", AutoScalerMin: &min, AutoScalerMax: &max, Hours: 24})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 3, estimate.Min.Nodes)
+		assert.Equal(t, 10, estimate.Max.Nodes)
+	})
+
+	t.Run("should return the error message", func(t *testing.T) {
+		// given
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "plan foo does not exist", http.StatusBadRequest)
+		}))
+		defer ts.Close()
+		client := NewClient(ts.URL, http.DefaultClient)
+
+		// when
+		_, err := client.GetEstimate(Parameters{Plan: "foo"})
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "400 (Bad Request) status: plan foo does not exist")
+	})
+}
@@ -0,0 +1,61 @@
+package estimate
+
+const (
+	PlanParam          = "plan"
+	RegionParam        = "region"
+	MachineTypeParam   = "machine_type"
+	AutoScalerMinParam = "autoscaler_min"
+	AutoScalerMaxParam = "autoscaler_max"
+	VolumeSizeGbParam  = "volume_size_gb"
+	HoursParam         = "hours"
+	RuntimeIDParam     = "runtime_id"
+)
+
+// Parameters of the estimation, either the plan or the runtime ID must be set. The parameters not set are taken
+// from the plan defaults or, for the runtime, from the provisioning parameters of the runtime.
+type Parameters struct {
+	Plan          string
+	Region        string
+	MachineType   string
+	AutoScalerMin *int
+	AutoScalerMax *int
+	VolumeSizeGb  *int
+	// Hours is the period of the estimation, KEB uses the configured default (a month) if not set
+	Hours     int
+	RuntimeID string
+}
+
+// Estimate is the estimated resource consumption of the runtime in the period of the given hours
+type Estimate struct {
+	PlanID      string       `json:"planID"`
+	PlanName    string       `json:"planName"`
+	RuntimeID   string       `json:"runtimeID,omitempty"`
+	Provider    string       `json:"provider"`
+	Region      string       `json:"region"`
+	Hours       int          `json:"hours"`
+	Currency    string       `json:"currency,omitempty"`
+	WorkerPools []WorkerPool `json:"workerPools"`
+	// Min and Max are the resources at the minimal and the maximal scale of all worker pools
+	Min Resources `json:"min"`
+	Max Resources `json:"max"`
+	// Current are the resources of the live nodes reported by Kyma Metrics Collector, set only for existing runtimes
+	Current *Resources `json:"current,omitempty"`
+}
+
+type WorkerPool struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	VolumeSizeGb  int    `json:"volumeSizeGb"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
+type Resources struct {
+	Nodes     int     `json:"nodes"`
+	NodeHours int     `json:"nodeHours"`
+	VCPU      int     `json:"vCPU"`
+	MemoryGb  float64 `json:"memoryGb"`
+	StorageGb int     `json:"storageGb"`
+	// Cost is not set if the price table does not contain the prices of all machine types or of the storage
+	Cost *float64 `json:"cost,omitempty"`
+}
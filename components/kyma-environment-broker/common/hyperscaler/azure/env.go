@@ -17,7 +17,7 @@ func mapRegion(credentials hyperscaler.Credentials, parameters internal.Provisio
 		return provider.DefaultAzureRegion, nil
 	}
 	region := *(parameters.Parameters.Region)
-	switch parameters.PlanID {
+	switch broker.BasePlanID(parameters.PlanID) {
 	case broker.AzurePlanID, broker.AzureLitePlanID:
 		if !isInList(broker.AzureRegions(), region) {
 			return "", fmt.Errorf("supplied region \"%v\" is not a valid region for Azure", region)
@@ -8,10 +8,18 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/hyperscaler"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provider"
 )
 
 func Test_mapRegion(t *testing.T) {
+	catalog := config.DefaultPlanCatalog()
+	catalog.Plans = append(catalog.Plans, internal.CatalogPlan{ID: "azure-small", Name: "azure_small", BasePlan: broker.AzurePlanName})
+	require.NoError(t, broker.SetPlanCatalog(catalog))
+	t.Cleanup(func() {
+		require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+	})
+
 	type args struct {
 		hyperscalerType hyperscaler.Type
 		planID          string
@@ -83,6 +91,16 @@ func Test_mapRegion(t *testing.T) {
 			wantRegion: "westeurope",
 			wantErr:    false,
 		},
+		{
+			name: "valid region of the plan based on azure",
+			args: args{
+				hyperscalerType: hyperscaler.Azure,
+				planID:          "azure-small",
+				region:          "westeurope",
+			},
+			wantRegion: "westeurope",
+			wantErr:    false,
+		},
 		{
 			name: "valid azure lite region",
 			args: args{
@@ -350,6 +350,35 @@ func TestClient_CancelOrchestration(t *testing.T) {
 	})
 }
 
+func TestClient_PauseResumeOrchestration(t *testing.T) {
+	for action, call := range map[string]func(Client, string) error{
+		"pause":  Client.PauseOrchestration,
+		"resume": Client.ResumeOrchestration,
+	} {
+		t.Run(action, func(t *testing.T) {
+			// given
+			called := 0
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called++
+				assert.Equal(t, http.MethodPut, r.Method)
+				assert.Equal(t, fmt.Sprintf("/orchestrations/%s/%s", orch1.OrchestrationID, action), r.URL.Path)
+
+				err := respondStatus(w, orch1)
+				require.NoError(t, err)
+			}))
+			defer ts.Close()
+			client := NewClient(context.TODO(), ts.URL, fixToken)
+
+			// when
+			err := call(client, orch1.OrchestrationID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, 1, called)
+		})
+	}
+}
+
 func TestClient_RetryOrchestration(t *testing.T) {
 	t.Run("test_URL_NoError_path", func(t *testing.T) {
 		// given
@@ -44,6 +44,7 @@ const (
 	Pending    = "pending"
 	InProgress = "in progress"
 	Canceling  = "canceling"
+	Paused     = "paused"
 	Retrying   = "retrying" // to signal a retry sign before marking it to pending
 	Canceled   = "canceled"
 	Succeeded  = "succeeded"
@@ -92,6 +93,14 @@ type StrategyType string
 
 const (
 	ParallelStrategy StrategyType = "parallel"
+	CanaryStrategy   StrategyType = "canary"
+)
+
+type CanaryHaltAction string
+
+const (
+	CanaryHaltCancel CanaryHaltAction = "cancel"
+	CanaryHaltPause  CanaryHaltAction = "pause"
 )
 
 type ScheduleType string
@@ -107,6 +116,17 @@ type ParallelStrategySpec struct {
 	Workers int `json:"workers"`
 }
 
+// CanaryStrategySpec defines parameters for the canary orchestration strategy. Operations are executed in waves,
+// the first wave has FirstWaveSize operations, every next one WaveSize operations. When the ratio of failed operations
+// in a wave exceeds FailureThreshold, the remaining waves are not executed and the orchestration is halted.
+type CanaryStrategySpec struct {
+	Workers          int              `json:"workers"`
+	FirstWaveSize    int              `json:"firstWaveSize"`
+	WaveSize         int              `json:"waveSize"`
+	FailureThreshold float64          `json:"failureThreshold"`
+	OnFailure        CanaryHaltAction `json:"onFailure,omitempty"`
+}
+
 // StrategySpec is the strategy part common for all orchestration trigger/status API
 type StrategySpec struct {
 	Type              StrategyType `json:"type"`
@@ -114,6 +134,7 @@ type StrategySpec struct {
 	ScheduleTime      time.Time
 	MaintenanceWindow bool                 `json:"maintenanceWindow,omitempty"`
 	Parallel          ParallelStrategySpec `json:"parallel,omitempty"`
+	Canary            CanaryStrategySpec   `json:"canary,omitempty"`
 }
 
 // TargetSpec is the targets part common for all orchestration trigger/status API
@@ -147,6 +168,36 @@ type OperationResponse struct {
 	MaintenanceWindowEnd   time.Time `json:"maintenanceWindowEnd"`
 	State                  string    `json:"state"`
 	Description            string    `json:"description"`
+	// ClusterUpgradeDiff is set for the dry run of the upgradeCluster operation
+	ClusterUpgradeDiff *ClusterUpgradeDiff `json:"clusterUpgradeDiff,omitempty"`
+}
+
+// ClusterUpgradeDiff holds the changes of the cluster configuration which the upgradeCluster operation applies to the runtime.
+// Errors contain the reasons why the upgrade is not valid for the runtime.
+type ClusterUpgradeDiff struct {
+	KubernetesVersion   ValueDiff `json:"kubernetesVersion"`
+	MachineImage        ValueDiff `json:"machineImage"`
+	MachineImageVersion ValueDiff `json:"machineImageVersion"`
+	Errors              []string  `json:"errors,omitempty"`
+}
+
+// ValueDiff holds the current and the target value of an attribute of the cluster configuration
+type ValueDiff struct {
+	Current string `json:"current"`
+	Target  string `json:"target"`
+}
+
+func (d ValueDiff) Changed() bool {
+	return d.Current != d.Target
+}
+
+func (d ClusterUpgradeDiff) Valid() bool {
+	return len(d.Errors) == 0
+}
+
+// HasChanges returns true if the upgrade changes any attribute of the cluster configuration
+func (d ClusterUpgradeDiff) HasChanges() bool {
+	return d.KubernetesVersion.Changed() || d.MachineImage.Changed() || d.MachineImageVersion.Changed()
 }
 
 type OperationResponseList struct {
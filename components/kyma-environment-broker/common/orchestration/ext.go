@@ -41,6 +41,11 @@ type OperationExecutor interface {
 	Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error
 }
 
+// OperationStateProvider returns the current state of the operation corresponding to a Runtime.
+type OperationStateProvider interface {
+	OperationState(operationID string) (string, error)
+}
+
 // Strategy interface encapsulates the strategy how the orchestration is performed.
 //
 //go:generate mockery --name=Strategy --output=automock --outpkg=automock --case=underscore
@@ -52,6 +57,10 @@ type Strategy interface {
 	Wait(executionID string)
 	// Cancel shutdowns a given execution.
 	Cancel(executionID string)
+	// Pause stops starting new operations of a given execution until it is resumed, the operations in progress are not affected.
+	Pause(executionID string)
+	// Resume continues a paused execution.
+	Resume(executionID string)
 	// Insert operations into the delaying queue of a given execution ID
 	Insert(execID string, operations []RuntimeOperation, strategySpec StrategySpec) error
 	// SpeedUp makes the retries speedFactor times faster, used for unit testing
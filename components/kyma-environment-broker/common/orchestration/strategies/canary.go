@@ -0,0 +1,281 @@
+package strategies
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/sirupsen/logrus"
+)
+
+type canaryExecution struct {
+	waves    [][]orchestration.RuntimeOperation
+	waveSize int
+	current  string // execution ID of the wave processed by the parallel strategy
+	canceled bool
+	finished bool
+	resumed  chan struct{} // set while the execution is paused, closed when it is resumed or canceled
+	done     chan struct{}
+}
+
+type CanaryOrchestrationStrategy struct {
+	parallel   orchestration.Strategy
+	states     orchestration.OperationStateProvider
+	halt       func(reason string)
+	executions map[string]*canaryExecution
+	mux        sync.Mutex
+	log        logrus.FieldLogger
+}
+
+// NewCanaryOrchestrationStrategy returns a new canary orchestration strategy, which executes operations in waves.
+// Every wave is processed by the parallel strategy, the next wave is started only if the ratio of failed operations
+// in the previous one does not exceed the failure threshold. Otherwise, the halt function is called and the remaining
+// waves are dropped, unless the orchestration is only paused - then the waves are kept and the next wave is started
+// when the execution is resumed.
+func NewCanaryOrchestrationStrategy(executor orchestration.OperationExecutor, states orchestration.OperationStateProvider, halt func(reason string), log logrus.FieldLogger, rescheduleDelay time.Duration) orchestration.Strategy {
+	return &CanaryOrchestrationStrategy{
+		parallel:   NewParallelOrchestrationStrategy(executor, log, rescheduleDelay),
+		states:     states,
+		halt:       halt,
+		executions: map[string]*canaryExecution{},
+		log:        log,
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) SpeedUp(factor int) {
+	c.parallel.SpeedUp(factor)
+}
+
+// Execute splits the operations into waves and starts processing them one after another.
+func (c *CanaryOrchestrationStrategy) Execute(operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) (string, error) {
+	if len(operations) == 0 {
+		return "", nil
+	}
+
+	spec := strategySpec.Canary
+	firstWaveSize := spec.FirstWaveSize
+	if firstWaveSize <= 0 {
+		firstWaveSize = 1
+	}
+	waveSize := spec.WaveSize
+	if waveSize <= 0 {
+		waveSize = len(operations)
+	}
+
+	execID := uuid.New().String()
+	exec := &canaryExecution{
+		waveSize: waveSize,
+		done:     make(chan struct{}),
+	}
+	if firstWaveSize > len(operations) {
+		firstWaveSize = len(operations)
+	}
+	exec.waves = append(exec.waves, operations[:firstWaveSize])
+	exec.waves = append(exec.waves, splitIntoWaves(operations[firstWaveSize:], waveSize)...)
+
+	c.mux.Lock()
+	c.executions[execID] = exec
+	c.mux.Unlock()
+
+	go c.processWaves(execID, exec, strategySpec)
+
+	return execID, nil
+}
+
+// Insert appends the operations as new waves to the given execution
+func (c *CanaryOrchestrationStrategy) Insert(execID string, operations []orchestration.RuntimeOperation, strategySpec orchestration.StrategySpec) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	exec, exist := c.executions[execID]
+	if !exist {
+		return fmt.Errorf("no execution with ID: %s", execID)
+	}
+	if exec.finished || exec.canceled {
+		return fmt.Errorf("the execution ID %s is finished", execID)
+	}
+	exec.waves = append(exec.waves, splitIntoWaves(operations, exec.waveSize)...)
+
+	return nil
+}
+
+func (c *CanaryOrchestrationStrategy) processWaves(execID string, exec *canaryExecution, strategySpec orchestration.StrategySpec) {
+	defer close(exec.done)
+	log := c.log.WithField("executionID", execID)
+
+	waveSpec := strategySpec
+	waveSpec.Parallel.Workers = strategySpec.Canary.Workers
+	if waveSpec.Parallel.Workers <= 0 {
+		waveSpec.Parallel.Workers = 1
+	}
+
+	for wave := 1; ; wave++ {
+		c.waitIfPaused(exec)
+
+		c.mux.Lock()
+		if exec.canceled || len(exec.waves) == 0 {
+			exec.finished = true
+			c.mux.Unlock()
+			return
+		}
+		operations := exec.waves[0]
+		exec.waves = exec.waves[1:]
+		waveExecID, err := c.parallel.Execute(operations, waveSpec)
+		exec.current = waveExecID
+		c.mux.Unlock()
+
+		if err != nil {
+			c.finish(exec)
+			log.Errorf("while executing wave %d: %v", wave, err)
+			c.halt(fmt.Sprintf("canary wave %d could not be executed: %s", wave, err))
+			return
+		}
+
+		log.Infof("Executing canary wave %d with %d operations", wave, len(operations))
+		c.parallel.Wait(waveExecID)
+
+		c.mux.Lock()
+		canceled := exec.canceled
+		c.mux.Unlock()
+		if canceled {
+			c.finish(exec)
+			return
+		}
+
+		failed := c.countFailed(operations, log)
+		ratio := float64(failed) / float64(len(operations))
+		log.Infof("Canary wave %d finished, %d of %d operations failed", wave, failed, len(operations))
+		if ratio > strategySpec.Canary.FailureThreshold {
+			reason := fmt.Sprintf("canary wave %d exceeded failure threshold %.2f: %d of %d operations failed", wave, strategySpec.Canary.FailureThreshold, failed, len(operations))
+			if strategySpec.Canary.OnFailure == orchestration.CanaryHaltPause {
+				c.Pause(execID)
+				c.halt(reason)
+				continue
+			}
+			c.finish(exec)
+			c.halt(reason)
+			return
+		}
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) countFailed(operations []orchestration.RuntimeOperation, log logrus.FieldLogger) int {
+	failed := 0
+	for _, op := range operations {
+		state, err := c.states.OperationState(op.ID)
+		if err != nil {
+			// the result is unknown, treat it as a failure to not roll out further
+			log.Errorf("while getting state of operation %s: %v", op.ID, err)
+			failed++
+			continue
+		}
+		if state == orchestration.Failed {
+			failed++
+		}
+	}
+	return failed
+}
+
+func (c *CanaryOrchestrationStrategy) finish(exec *canaryExecution) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	exec.finished = true
+	exec.waves = nil
+}
+
+func (c *CanaryOrchestrationStrategy) Wait(executionID string) {
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	c.mux.Unlock()
+	if exec != nil {
+		<-exec.done
+	}
+}
+
+func (c *CanaryOrchestrationStrategy) Cancel(executionID string) {
+	if executionID == "" {
+		return
+	}
+	c.log.Infof("Cancelling strategy execution %s", executionID)
+
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil {
+		c.mux.Unlock()
+		return
+	}
+	exec.canceled = true
+	current := exec.current
+	resume(exec)
+	c.mux.Unlock()
+
+	c.parallel.Cancel(current)
+}
+
+// Pause stops starting new waves and new operations of the current wave until the execution is resumed
+func (c *CanaryOrchestrationStrategy) Pause(executionID string) {
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil || exec.finished || exec.canceled {
+		c.mux.Unlock()
+		return
+	}
+	if exec.resumed == nil {
+		c.log.Infof("Pausing strategy execution %s", executionID)
+		exec.resumed = make(chan struct{})
+	}
+	current := exec.current
+	c.mux.Unlock()
+
+	c.parallel.Pause(current)
+}
+
+// Resume continues the paused execution
+func (c *CanaryOrchestrationStrategy) Resume(executionID string) {
+	c.mux.Lock()
+	exec := c.executions[executionID]
+	if exec == nil {
+		c.mux.Unlock()
+		return
+	}
+	c.log.Infof("Resuming strategy execution %s", executionID)
+	resume(exec)
+	current := exec.current
+	c.mux.Unlock()
+
+	c.parallel.Resume(current)
+}
+
+// waitIfPaused blocks until the paused execution is resumed, there is nothing to wait for if no wave is left
+func (c *CanaryOrchestrationStrategy) waitIfPaused(exec *canaryExecution) {
+	c.mux.Lock()
+	resumed := exec.resumed
+	if len(exec.waves) == 0 {
+		resumed = nil
+	}
+	c.mux.Unlock()
+	if resumed != nil {
+		<-resumed
+	}
+}
+
+func resume(exec *canaryExecution) {
+	if exec.resumed != nil {
+		close(exec.resumed)
+		exec.resumed = nil
+	}
+}
+
+func splitIntoWaves(operations []orchestration.RuntimeOperation, size int) [][]orchestration.RuntimeOperation {
+	var waves [][]orchestration.RuntimeOperation
+	for len(operations) > 0 {
+		if size > len(operations) {
+			size = len(operations)
+		}
+		waves = append(waves, operations[:size])
+		operations = operations[size:]
+	}
+	return waves
+}
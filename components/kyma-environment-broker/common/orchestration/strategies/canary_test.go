@@ -0,0 +1,173 @@
+package strategies
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStateExecutor struct {
+	mux      sync.Mutex
+	failing  map[string]bool
+	executed []string
+}
+
+func (t *testStateExecutor) Execute(opID string) (time.Duration, error) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.executed = append(t.executed, opID)
+	return 0, nil
+}
+
+func (t *testStateExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
+
+func (t *testStateExecutor) OperationState(operationID string) (string, error) {
+	if t.failing[operationID] {
+		return orchestration.Failed, nil
+	}
+	return orchestration.Succeeded, nil
+}
+
+func (t *testStateExecutor) executedOperations() []string {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return append([]string{}, t.executed...)
+}
+
+func TestCanaryOrchestrationStrategy_AllWavesSucceeded(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{}}
+	halted := ""
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(reason string) { halted = reason }, logrus.New(), 0)
+
+	// when
+	id, err := s.Execute(fixCanaryOperations(7), fixCanarySpec(1, 3, 0.2))
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 7)
+	assert.Empty(t, halted)
+}
+
+func TestCanaryOrchestrationStrategy_HaltAfterFailedFirstWave(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{"op-0": true}}
+	halted := ""
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(reason string) { halted = reason }, logrus.New(), 0)
+
+	// when
+	id, err := s.Execute(fixCanaryOperations(7), fixCanarySpec(2, 3, 0.2))
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.ElementsMatch(t, []string{"op-0", "op-1"}, executor.executedOperations())
+	assert.Equal(t, "canary wave 1 exceeded failure threshold 0.20: 1 of 2 operations failed", halted)
+}
+
+func TestCanaryOrchestrationStrategy_PauseAfterFailedFirstWave(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{"op-0": true}}
+	halted := make(chan string, 1)
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(reason string) { halted <- reason }, logrus.New(), 0)
+	spec := fixCanarySpec(1, 3, 0.2)
+	spec.Canary.OnFailure = orchestration.CanaryHaltPause
+
+	// when
+	id, err := s.Execute(fixCanaryOperations(4), spec)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "canary wave 1 exceeded failure threshold 0.20: 1 of 1 operations failed", <-halted)
+	// the remaining waves are kept, but not started until the execution is resumed
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, []string{"op-0"}, executor.executedOperations())
+
+	// when
+	s.Resume(id)
+
+	// then
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 4)
+}
+
+func TestCanaryOrchestrationStrategy_CancelPaused(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{}}
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(string) {}, logrus.New(), 0)
+	spec := fixCanarySpec(1, 3, 0.2)
+	spec.ScheduleTime = time.Now().Add(100 * time.Millisecond)
+
+	// when
+	id, err := s.Execute(fixCanaryOperations(4), spec)
+	require.NoError(t, err)
+	s.Pause(id)
+	time.Sleep(200 * time.Millisecond)
+	s.Cancel(id)
+
+	// then
+	s.Wait(id)
+	assert.Empty(t, executor.executedOperations())
+}
+
+func TestCanaryOrchestrationStrategy_ContinueBelowThreshold(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{"op-1": true}}
+	halted := ""
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(reason string) { halted = reason }, logrus.New(), 0)
+
+	// when
+	id, err := s.Execute(fixCanaryOperations(6), fixCanarySpec(1, 5, 0.2))
+
+	// then
+	require.NoError(t, err)
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 6)
+	assert.Empty(t, halted)
+}
+
+func TestCanaryOrchestrationStrategy_Insert(t *testing.T) {
+	// given
+	executor := &testStateExecutor{failing: map[string]bool{}}
+	s := NewCanaryOrchestrationStrategy(executor, executor, func(string) {}, logrus.New(), 0)
+	id, err := s.Execute(fixCanaryOperations(1), fixCanarySpec(1, 1, 0))
+	require.NoError(t, err)
+	s.Wait(id)
+
+	// when
+	err = s.Insert(id, fixCanaryOperations(1), fixCanarySpec(1, 1, 0))
+
+	// then
+	assert.Error(t, err)
+}
+
+func fixCanaryOperations(n int) []orchestration.RuntimeOperation {
+	ops := make([]orchestration.RuntimeOperation, n)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{ID: fmt.Sprintf("op-%d", i)}
+	}
+	return ops
+}
+
+func fixCanarySpec(firstWave, wave int, threshold float64) orchestration.StrategySpec {
+	return orchestration.StrategySpec{
+		Type:     orchestration.CanaryStrategy,
+		Schedule: "immediate",
+		Canary: orchestration.CanaryStrategySpec{
+			Workers:          2,
+			FirstWaveSize:    firstWave,
+			WaveSize:         wave,
+			FailureThreshold: threshold,
+		},
+	}
+}
@@ -18,6 +18,7 @@ type ParallelOrchestrationStrategy struct {
 	dq              map[string]workqueue.DelayingInterface // scheduling queue, delaying queue for all pending & in progress ops
 	pq              map[string]workqueue.DelayingInterface // processing queue, delaying queue for the in progress ops
 	wg              map[string]*sync.WaitGroup
+	paused          map[string]chan struct{} // closed when the paused execution is resumed or canceled
 	mux             sync.RWMutex
 	log             logrus.FieldLogger
 	rescheduleDelay time.Duration
@@ -33,6 +34,7 @@ func NewParallelOrchestrationStrategy(executor orchestration.OperationExecutor,
 		dq:              map[string]workqueue.DelayingInterface{},
 		pq:              map[string]workqueue.DelayingInterface{},
 		wg:              map[string]*sync.WaitGroup{},
+		paused:          map[string]chan struct{}{},
 		log:             log,
 		rescheduleDelay: rescheduleDelay,
 		scheduleNum:     map[string]int{},
@@ -133,6 +135,13 @@ func (p *ParallelOrchestrationStrategy) scheduleOperationsLoop(execID string, st
 			break
 		}
 
+		// do not start new operations while the execution is paused
+		p.waitIfPaused(execID)
+		if dq.ShuttingDown() {
+			dq.Done(item)
+			break
+		}
+
 		op := item.(*orchestration.RuntimeOperation)
 
 		// check the window before process for the case if op Get is not in time
@@ -265,6 +274,50 @@ func (p *ParallelOrchestrationStrategy) Cancel(executionID string) {
 	if pq != nil {
 		pq.ShutDown()
 	}
+
+	p.resume(executionID)
+}
+
+// Pause stops starting new operations of the given execution, the operations which are already processed are not affected
+func (p *ParallelOrchestrationStrategy) Pause(executionID string) {
+	if executionID == "" {
+		return
+	}
+	p.log.Infof("Pausing strategy execution %s", executionID)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	if _, paused := p.paused[executionID]; !paused {
+		p.paused[executionID] = make(chan struct{})
+	}
+}
+
+// Resume continues starting operations of the paused execution
+func (p *ParallelOrchestrationStrategy) Resume(executionID string) {
+	if executionID == "" {
+		return
+	}
+	p.log.Infof("Resuming strategy execution %s", executionID)
+
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.resume(executionID)
+}
+
+func (p *ParallelOrchestrationStrategy) resume(executionID string) {
+	if resumed, paused := p.paused[executionID]; paused {
+		close(resumed)
+		delete(p.paused, executionID)
+	}
+}
+
+func (p *ParallelOrchestrationStrategy) waitIfPaused(executionID string) {
+	p.mux.RLock()
+	resumed := p.paused[executionID]
+	p.mux.RUnlock()
+	if resumed != nil {
+		<-resumed
+	}
 }
 
 func (p *ParallelOrchestrationStrategy) handleRescheduleErrorOperation(execID string, op *orchestration.RuntimeOperation) {
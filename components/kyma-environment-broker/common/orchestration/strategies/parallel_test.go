@@ -9,6 +9,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
@@ -106,3 +107,32 @@ func TestNewParallelOrchestrationStrategy_Reschedule(t *testing.T) {
 	assert.NoError(t, err)
 	s.Wait(id)
 }
+
+func TestNewParallelOrchestrationStrategy_Pause(t *testing.T) {
+	// given
+	executor := &testStateExecutor{}
+	s := NewParallelOrchestrationStrategy(executor, logrus.New(), 0)
+
+	ops := make([]orchestration.RuntimeOperation, 3)
+	for i := range ops {
+		ops[i] = orchestration.RuntimeOperation{
+			ID: rand.String(5),
+		}
+	}
+
+	// when
+	id, err := s.Execute(ops, orchestration.StrategySpec{ScheduleTime: time.Now().Add(100 * time.Millisecond), Parallel: orchestration.ParallelStrategySpec{Workers: 2}})
+	require.NoError(t, err)
+	s.Pause(id)
+
+	// then
+	time.Sleep(300 * time.Millisecond)
+	assert.Empty(t, executor.executedOperations())
+
+	// when
+	s.Resume(id)
+
+	// then
+	s.Wait(id)
+	assert.Len(t, executor.executedOperations(), 3)
+}
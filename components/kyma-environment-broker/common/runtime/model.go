@@ -49,6 +49,21 @@ type RuntimeDTO struct {
 	KymaVersion                 string                         `json:"kymaVersion,omitempty"`
 	KymaConfig                  *gqlschema.KymaConfigInput     `json:"kymaConfig,omitempty"`
 	ClusterConfig               *gqlschema.GardenerConfigInput `json:"clusterConfig,omitempty"`
+	Hibernation                 *HibernationStatus             `json:"hibernation,omitempty"`
+}
+
+// HibernationStatus describes the hibernation schedules of the runtime and the hibernation state reported by the provisioner,
+// the runtime is hibernated if its last provisioner operation is the succeeded hibernation, the state is not set
+// if the provisioner cannot provide it
+type HibernationStatus struct {
+	Schedules  []HibernationSchedule `json:"schedules"`
+	Hibernated *bool                 `json:"hibernated,omitempty"`
+}
+
+type HibernationSchedule struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
 }
 
 type RuntimeStatus struct {
@@ -0,0 +1,109 @@
+package watch
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const maxEventSize = 1024 * 1024
+
+// Handler is called for every event received from the stream, the stream is closed when the handler returns an error
+type Handler func(event Event) error
+
+// Client is the interface to follow the progress of operations using the KEB watch API as an HTTP client using OIDC ID token in JWT format.
+type Client interface {
+	// WatchOperation streams the events of the given operation until the operation is finished
+	WatchOperation(ctx context.Context, operationID string, handler Handler) error
+	// WatchRuntime streams the events of all operations of the given runtime until the context is canceled
+	WatchRuntime(ctx context.Context, runtimeID string, handler Handler) error
+}
+
+type client struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewClient constructs and returns new Client for KEB watch API
+// It takes the following arguments:
+//   - url        : base url of all KEB APIs, e.g. https://kyma-env-broker.kyma.local
+//   - httpClient : underlying HTTP client used for API call to KEB, must not have a timeout set
+func NewClient(url string, httpClient *http.Client) Client {
+	return &client{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+func (c *client) WatchOperation(ctx context.Context, operationID string, handler Handler) error {
+	return c.watch(ctx, fmt.Sprintf("%s/operations/%s/watch", c.url, operationID), handler)
+}
+
+func (c *client) WatchRuntime(ctx context.Context, runtimeID string, handler Handler) error {
+	return c.watch(ctx, fmt.Sprintf("%s/runtimes/%s/watch", c.url, runtimeID), handler)
+}
+
+func (c *client) watch(ctx context.Context, url string, handler Handler) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Wrap(err, "while creating request")
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "while calling %s", url)
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("calling %s returned %d (%s) status: %s", url, resp.StatusCode, http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+	}
+
+	err = ReadStream(resp.Body, handler)
+	if err != nil && ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+// ReadStream decodes the server-sent events from the given reader and passes them to the handler
+func ReadStream(r io.Reader, handler Handler) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxEventSize)
+
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) == 0 {
+				continue
+			}
+			var event Event
+			if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &event); err != nil {
+				return errors.Wrap(err, "while decoding event")
+			}
+			data = nil
+			if err := handler(event); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, ":"):
+			// comment, sent to keep the connection alive
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	return errors.Wrap(scanner.Err(), "while reading stream")
+}
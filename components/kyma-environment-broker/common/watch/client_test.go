@@ -0,0 +1,58 @@
+package watch
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStream(t *testing.T) {
+	t.Run("should decode events and skip comments", func(t *testing.T) {
+		// given
+		stream := strings.Join([]string{
+			"event: operation",
+			`data: {"type":"operation","operationID":"op-id","operation":{"type":"provision","state":"in progress","description":"Operation created"}}`,
+			"",
+			": keep-alive",
+			"",
+			"event: step",
+			`data: {"type":"step","operationID":"op-id",`,
+			`data: "step":{"name":"Create_Runtime","duration":1000000000}}`,
+			"",
+		}, "\n") + "\n"
+
+		// when
+		var received []Event
+		err := ReadStream(strings.NewReader(stream), func(e Event) error {
+			received = append(received, e)
+			return nil
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, received, 2)
+		assert.Equal(t, "in progress", received[0].Operation.State)
+		assert.False(t, received[0].IsFinished())
+		assert.Equal(t, StepEventType, received[1].Type)
+		assert.Equal(t, "Create_Runtime", received[1].Step.Name)
+	})
+
+	t.Run("should stop reading when handler returns error", func(t *testing.T) {
+		// given
+		stream := "data: {\"type\":\"step\"}\n\ndata: {\"type\":\"step\"}\n\n"
+		calls := 0
+
+		// when
+		err := ReadStream(strings.NewReader(stream), func(Event) error {
+			calls++
+			return errors.New("stop")
+		})
+
+		// then
+		assert.EqualError(t, err, "stop")
+		assert.Equal(t, 1, calls)
+	})
+}
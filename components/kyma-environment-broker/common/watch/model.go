@@ -0,0 +1,51 @@
+package watch
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+)
+
+type EventType string
+
+const (
+	// OperationEventType is sent when the state or the description of the operation changes
+	OperationEventType EventType = "operation"
+	// StepEventType is sent when a step of the operation is processed
+	StepEventType EventType = "step"
+	// TraceEventType is sent when a tracing event of the operation is written
+	TraceEventType EventType = "event"
+)
+
+// Event is a single entry of the /operations/{operation_id}/watch and /runtimes/{runtime_id}/watch streams
+type Event struct {
+	Type        EventType        `json:"type"`
+	Time        time.Time        `json:"time"`
+	OperationID string           `json:"operationID"`
+	InstanceID  string           `json:"instanceID"`
+	Operation   *OperationState  `json:"operation,omitempty"`
+	Step        *StepProgress    `json:"step,omitempty"`
+	Event       *events.EventDTO `json:"event,omitempty"`
+}
+
+type OperationState struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+}
+
+type StepProgress struct {
+	Name     string        `json:"name"`
+	Duration time.Duration `json:"duration"`
+	RetryIn  time.Duration `json:"retryIn,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// IsFinished returns true if the event reports the operation in the final state
+func (e Event) IsFinished() bool {
+	if e.Type != OperationEventType || e.Operation == nil {
+		return false
+	}
+	return e.Operation.State == string(domain.Succeeded) || e.Operation.State == string(domain.Failed)
+}
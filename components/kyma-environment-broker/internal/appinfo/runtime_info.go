@@ -33,16 +33,14 @@ type RuntimeInfoHandler struct {
 	instanceFinder          InstanceFinder
 	lastOperationFinder     LastOperationFinder
 	respWriter              ResponseWriter
-	plansConfig             broker.PlansConfig
 	defaultSubaccountRegion string
 }
 
-func NewRuntimeInfoHandler(instanceFinder InstanceFinder, lastOpFinder LastOperationFinder, plansConfig broker.PlansConfig, region string, respWriter ResponseWriter) *RuntimeInfoHandler {
+func NewRuntimeInfoHandler(instanceFinder InstanceFinder, lastOpFinder LastOperationFinder, region string, respWriter ResponseWriter) *RuntimeInfoHandler {
 	return &RuntimeInfoHandler{
 		instanceFinder:          instanceFinder,
 		lastOperationFinder:     lastOpFinder,
 		respWriter:              respWriter,
-		plansConfig:             plansConfig,
 		defaultSubaccountRegion: region,
 	}
 }
@@ -157,7 +155,7 @@ func (h *RuntimeInfoHandler) planNameOrDefault(inst internal.InstanceWithOperati
 	if inst.ServicePlanName != "" {
 		return inst.ServicePlanName
 	}
-	return broker.Plans(h.plansConfig, "", false)[inst.ServicePlanID].Name
+	return broker.PlanNameByID(inst.ServicePlanID)
 }
 
 func getIfNotZero(in time.Time) *time.Time {
@@ -97,7 +97,7 @@ func TestRuntimeInfoHandlerSuccess(t *testing.T) {
 				memStorage = newInMemoryStorage(t, tc.instances, tc.provisionOp, tc.deprovisionOp)
 			)
 
-			handler := appinfo.NewRuntimeInfoHandler(memStorage.Instances(), memStorage.Operations(), broker.PlansConfig{}, "default-region", writer)
+			handler := appinfo.NewRuntimeInfoHandler(memStorage.Instances(), memStorage.Operations(), "default-region", writer)
 
 			// when
 			handler.ServeHTTP(respSpy, fixReq)
@@ -128,7 +128,7 @@ func TestRuntimeInfoHandlerFailures(t *testing.T) {
 	storageMock := &automock.InstanceFinder{}
 	defer storageMock.AssertExpectations(t)
 	storageMock.On("FindAllJoinedWithOperations", mock.Anything).Return(nil, errors.New("ups.. internal info"))
-	handler := appinfo.NewRuntimeInfoHandler(storageMock, nil, broker.PlansConfig{}, "", writer)
+	handler := appinfo.NewRuntimeInfoHandler(storageMock, nil, "", writer)
 
 	// when
 	handler.ServeHTTP(respSpy, fixReq)
@@ -223,7 +223,7 @@ func TestRuntimeInfoHandlerOperationRecognition(t *testing.T) {
 		require.NoError(t, err)
 
 		responseWriter := httputil.NewResponseWriter(logger.NewLogDummy(), true)
-		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, broker.PlansConfig{}, "", responseWriter)
+		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, "", responseWriter)
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -332,7 +332,7 @@ func TestRuntimeInfoHandlerOperationRecognition(t *testing.T) {
 		require.NoError(t, err)
 
 		responseWriter := httputil.NewResponseWriter(logger.NewLogDummy(), true)
-		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, broker.PlansConfig{}, "", responseWriter)
+		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, "", responseWriter)
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -470,7 +470,7 @@ func TestRuntimeInfoHandlerOperationRecognition(t *testing.T) {
 		require.NoError(t, err)
 
 		responseWriter := httputil.NewResponseWriter(logger.NewLogDummy(), true)
-		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, broker.PlansConfig{}, "", responseWriter)
+		runtimesInfoHandler := appinfo.NewRuntimeInfoHandler(instances, operations, "", responseWriter)
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -130,7 +130,7 @@ func generateNameAndDescription(operation internal.Operation, beType string) (st
 }
 
 func providerCodeByPlan(planID string) string {
-	switch planID {
+	switch broker.BasePlanID(planID) {
 	case broker.AWSPlanID:
 		return "AWS"
 	case broker.GCPPlanID:
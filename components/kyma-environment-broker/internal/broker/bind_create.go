@@ -2,28 +2,168 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
+// BindingConfig represents configuration of service bindings
+type BindingConfig struct {
+	Enabled                  bool     `envconfig:"default=false"`
+	DefaultRole              string   `envconfig:"default=view"`
+	AllowedRoles             []string `envconfig:"default=cluster-admin,edit,view"`
+	DefaultExpirationSeconds int      `envconfig:"default=3600"`
+	MinExpirationSeconds     int      `envconfig:"default=600"`
+	MaxExpirationSeconds     int      `envconfig:"default=86400"`
+}
+
+//go:generate mockery --name=BindingManager --output=automock --outpkg=automock --case=underscore
+
+// BindingManager creates and revokes credentials for service bindings in the SKR
+type BindingManager interface {
+	Create(ctx context.Context, instance *internal.Instance, bindingID, role string, expiration time.Duration) (internal.Binding, error)
+	Revoke(ctx context.Context, instance *internal.Instance, bindingID string) error
+}
+
+// BindingParams holds the parameters accepted when creating a service binding
+type BindingParams struct {
+	Role              string `json:"role,omitempty"`
+	ExpirationSeconds int    `json:"expiration_seconds,omitempty"`
+}
+
+// BindingCredentials is returned as credentials of a service binding
+type BindingCredentials struct {
+	Kubeconfig string    `json:"kubeconfig"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
 type BindEndpoint struct {
+	cfg              BindingConfig
+	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
+	bindingManager   BindingManager
+
 	log logrus.FieldLogger
 }
 
-func NewBind(log logrus.FieldLogger) *BindEndpoint {
-	return &BindEndpoint{log: log.WithField("service", "BindEndpoint")}
+func NewBind(cfg BindingConfig, instancesStorage storage.Instances, bindingsStorage storage.Bindings, bindingManager BindingManager, log logrus.FieldLogger) *BindEndpoint {
+	return &BindEndpoint{
+		cfg:              cfg,
+		instancesStorage: instancesStorage,
+		bindingsStorage:  bindingsStorage,
+		bindingManager:   bindingManager,
+		log:              log.WithField("service", "BindEndpoint"),
+	}
 }
 
 // Bind creates a new service binding
 //
 //	PUT /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *BindEndpoint) Bind(ctx context.Context, instanceID, bindingID string, details domain.BindDetails, asyncAllowed bool) (domain.Binding, error) {
-	b.log.Infof("Bind instanceID:", instanceID)
-	b.log.Infof("Bind parameters: %s", string(details.RawParameters))
-	b.log.Infof("Bind context: %s", string(details.RawContext))
-	b.log.Infof("Bind asyncAllowed:", asyncAllowed)
+	logger := b.log.WithField("instanceID", instanceID).WithField("bindingID", bindingID)
+	logger.Infof("Bind parameters: %s", string(details.RawParameters))
+	logger.Infof("Bind asyncAllowed: %v", asyncAllowed)
+
+	if !b.cfg.Enabled {
+		return domain.Binding{}, apiresponses.NewFailureResponse(errors.New("bindings are not supported"), http.StatusUnprocessableEntity, "checking if bindings are enabled")
+	}
+
+	instance, err := b.instancesStorage.GetByID(instanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.Binding{}, apiresponses.ErrInstanceDoesNotExist
+	case err != nil:
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting instance from storage")
+	}
+	if IsOwnClusterPlan(instance.ServicePlanID) {
+		return domain.Binding{}, apiresponses.NewFailureResponse(errors.New("bindings are not supported for the own_cluster plan"), http.StatusUnprocessableEntity, "checking plan")
+	}
+	if instance.RuntimeID == "" {
+		return domain.Binding{}, apiresponses.NewFailureResponse(errors.New("instance has no runtime"), http.StatusUnprocessableEntity, "checking runtime")
+	}
+
+	params, err := b.bindingParams(details.RawParameters)
+	if err != nil {
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusBadRequest, "validating binding parameters")
+	}
+
+	existing, err := b.bindingsStorage.GetByID(instanceID, bindingID)
+	switch {
+	case err == nil:
+		if existing.Role != params.Role || existing.IsExpired() {
+			return domain.Binding{}, apiresponses.ErrBindingAlreadyExists
+		}
+		return domain.Binding{
+			AlreadyExists: true,
+			Credentials:   BindingCredentials{Kubeconfig: existing.Kubeconfig, ExpiresAt: existing.ExpiresAt},
+		}, nil
+	case !dberr.IsNotFound(err):
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting binding from storage")
+	}
+
+	expiration := time.Duration(params.ExpirationSeconds) * time.Second
+	binding, err := b.bindingManager.Create(ctx, instance, bindingID, params.Role, expiration)
+	if err != nil {
+		logger.Errorf("unable to create binding credentials: %s", err)
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "creating binding credentials")
+	}
+
+	binding.CreatedAt = time.Now()
+	binding.UpdatedAt = binding.CreatedAt
+	if err := b.bindingsStorage.Insert(binding); err != nil {
+		logger.Errorf("unable to store binding: %s", err)
+		if revokeErr := b.bindingManager.Revoke(ctx, instance, bindingID); revokeErr != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", revokeErr)
+		}
+		return domain.Binding{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "saving binding to storage")
+	}
+	logger.Infof("Binding created with role %s, expires at %s", binding.Role, binding.ExpiresAt)
+
+	return domain.Binding{
+		Credentials: BindingCredentials{Kubeconfig: binding.Kubeconfig, ExpiresAt: binding.ExpiresAt},
+	}, nil
+}
+
+func (b *BindEndpoint) bindingParams(raw json.RawMessage) (BindingParams, error) {
+	params := BindingParams{}
+	if len(raw) != 0 {
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return BindingParams{}, errors.Wrap(err, "while unmarshaling binding parameters")
+		}
+	}
+
+	if params.Role == "" {
+		params.Role = b.cfg.DefaultRole
+	}
+	if !isRoleAllowed(params.Role, b.cfg.AllowedRoles) {
+		return BindingParams{}, fmt.Errorf("role %s is not allowed, allowed roles: %v", params.Role, b.cfg.AllowedRoles)
+	}
+
+	if params.ExpirationSeconds == 0 {
+		params.ExpirationSeconds = b.cfg.DefaultExpirationSeconds
+	}
+	if params.ExpirationSeconds < b.cfg.MinExpirationSeconds || params.ExpirationSeconds > b.cfg.MaxExpirationSeconds {
+		return BindingParams{}, fmt.Errorf("expiration_seconds must be between %d and %d", b.cfg.MinExpirationSeconds, b.cfg.MaxExpirationSeconds)
+	}
+
+	return params, nil
+}
 
-	return domain.Binding{}, errors.New("not supported")
+func isRoleAllowed(role string, allowed []string) bool {
+	for _, r := range allowed {
+		if r == role {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,262 @@
+package broker_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const bindingID = "c4b6e1d5-0d4b-4ed6-9f5a-0c7b1b3e2d11"
+
+var bindingConfig = broker.BindingConfig{
+	Enabled:                  true,
+	DefaultRole:              "cluster-admin",
+	AllowedRoles:             []string{"cluster-admin", "view"},
+	DefaultExpirationSeconds: 3600,
+	MinExpirationSeconds:     600,
+	MaxExpirationSeconds:     7200,
+}
+
+func TestBind(t *testing.T) {
+	t.Run("should create binding with default parameters", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, st.Instances().Insert(instance))
+
+		manager := automock.NewBindingManager(t)
+		manager.On("Create", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID, "cluster-admin", time.Hour).
+			Return(fixBinding("cluster-admin"), nil).Once()
+
+		svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), manager, logrus.New())
+
+		// when
+		binding, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, binding.AlreadyExists)
+		credentials := binding.Credentials.(broker.BindingCredentials)
+		assert.Equal(t, "kubeconfig-content", credentials.Kubeconfig)
+
+		stored, err := st.Bindings().GetByID(instanceID, bindingID)
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-admin", stored.Role)
+		assert.Equal(t, "kubeconfig-content", stored.Kubeconfig)
+		assert.False(t, stored.CreatedAt.IsZero())
+	})
+
+	t.Run("should create binding with given role and expiration", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Instances().Insert(fixture.FixInstance(instanceID)))
+
+		manager := automock.NewBindingManager(t)
+		manager.On("Create", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID, "view", 20*time.Minute).
+			Return(fixBinding("view"), nil).Once()
+
+		svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), manager, logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"role": "view", "expiration_seconds": 1200}`),
+		}, false)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("should return existing binding", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Instances().Insert(fixture.FixInstance(instanceID)))
+		require.NoError(t, st.Bindings().Insert(fixBinding("cluster-admin")))
+
+		svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+		// when
+		binding, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, binding.AlreadyExists)
+	})
+
+	t.Run("should reject binding with different role", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Instances().Insert(fixture.FixInstance(instanceID)))
+		require.NoError(t, st.Bindings().Insert(fixBinding("cluster-admin")))
+
+		svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+			RawParameters: json.RawMessage(`{"role": "view"}`),
+		}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingAlreadyExists, err)
+	})
+
+	for name, params := range map[string]string{
+		"role not allowed":           `{"role": "edit"}`,
+		"expiration below minimum":   `{"expiration_seconds": 60}`,
+		"expiration above maximum":   `{"expiration_seconds": 86400}`,
+		"malformed parameters input": `{"role": 1}`,
+	} {
+		t.Run(fmt.Sprintf("should reject binding when %s", name), func(t *testing.T) {
+			// given
+			st := storage.NewMemoryStorage()
+			require.NoError(t, st.Instances().Insert(fixture.FixInstance(instanceID)))
+
+			svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+			// when
+			_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{
+				RawParameters: json.RawMessage(params),
+			}, false)
+
+			// then
+			require.IsType(t, &apiresponses.FailureResponse{}, err)
+			assert.Equal(t, http.StatusBadRequest, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+		})
+	}
+
+	t.Run("should return error when instance does not exist", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewBind(bindingConfig, st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrInstanceDoesNotExist, err)
+	})
+
+	t.Run("should return error when bindings are disabled", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewBind(broker.BindingConfig{}, st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+		// when
+		_, err := svc.Bind(context.Background(), instanceID, bindingID, domain.BindDetails{}, false)
+
+		// then
+		require.IsType(t, &apiresponses.FailureResponse{}, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, err.(*apiresponses.FailureResponse).ValidatedStatusCode(nil))
+	})
+}
+
+func TestUnbind(t *testing.T) {
+	t.Run("should revoke credentials and remove binding", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Instances().Insert(fixture.FixInstance(instanceID)))
+		require.NoError(t, st.Bindings().Insert(fixBinding("cluster-admin")))
+
+		manager := automock.NewBindingManager(t)
+		manager.On("Revoke", mock.Anything, mock.AnythingOfType("*internal.Instance"), bindingID).Return(nil).Once()
+
+		svc := broker.NewUnbind(st.Instances(), st.Bindings(), manager, logrus.New())
+
+		// when
+		_, err := svc.Unbind(context.Background(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		require.NoError(t, err)
+		_, err = st.Bindings().GetByID(instanceID, bindingID)
+		assert.Error(t, err)
+	})
+
+	t.Run("should return gone when binding does not exist", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		svc := broker.NewUnbind(st.Instances(), st.Bindings(), automock.NewBindingManager(t), logrus.New())
+
+		// when
+		_, err := svc.Unbind(context.Background(), instanceID, bindingID, domain.UnbindDetails{}, false)
+
+		// then
+		assert.Equal(t, apiresponses.ErrBindingDoesNotExist, err)
+	})
+}
+
+func TestGetBinding(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	require.NoError(t, st.Bindings().Insert(fixBinding("view")))
+	svc := broker.NewGetBinding(st.Bindings(), logrus.New())
+
+	// when
+	spec, err := svc.GetBinding(context.Background(), instanceID, bindingID, domain.FetchBindingDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig-content", spec.Credentials.(broker.BindingCredentials).Kubeconfig)
+	assert.Equal(t, "view", spec.Parameters.(broker.BindingParams).Role)
+
+	// when
+	_, err = svc.GetBinding(context.Background(), instanceID, "not-existing", domain.FetchBindingDetails{})
+
+	// then
+	assert.Equal(t, apiresponses.ErrBindingNotFound, err)
+}
+
+func TestGetBinding_Expired(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	binding := fixBinding("view")
+	binding.ExpiresAt = time.Now().Add(-time.Minute)
+	require.NoError(t, st.Bindings().Insert(binding))
+	svc := broker.NewGetBinding(st.Bindings(), logrus.New())
+
+	// when
+	spec, err := svc.GetBinding(context.Background(), instanceID, bindingID, domain.FetchBindingDetails{})
+
+	// then
+	assert.Equal(t, apiresponses.ErrBindingNotFound, err)
+	assert.Nil(t, spec.Credentials)
+}
+
+func TestLastBindingOperation(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	require.NoError(t, st.Bindings().Insert(fixBinding("view")))
+	svc := broker.NewLastBindingOperation(st.Bindings(), logrus.New())
+
+	// when
+	op, err := svc.LastBindingOperation(context.Background(), instanceID, bindingID, domain.PollDetails{})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, domain.Succeeded, op.State)
+}
+
+func fixBinding(role string) internal.Binding {
+	return internal.Binding{
+		ID:                 bindingID,
+		InstanceID:         instanceID,
+		ExpiresAt:          time.Now().Add(time.Hour),
+		Role:               role,
+		ServiceAccountName: "kyma-binding-" + bindingID,
+		Kubeconfig:         "kubeconfig-content",
+	}
+}
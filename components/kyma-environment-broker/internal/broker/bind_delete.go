@@ -2,27 +2,69 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type UnbindEndpoint struct {
+	instancesStorage storage.Instances
+	bindingsStorage  storage.Bindings
+	bindingManager   BindingManager
+
 	log logrus.FieldLogger
 }
 
-func NewUnbind(log logrus.FieldLogger) *UnbindEndpoint {
-	return &UnbindEndpoint{log: log.WithField("service", "UnbindEndpoint")}
+func NewUnbind(instancesStorage storage.Instances, bindingsStorage storage.Bindings, bindingManager BindingManager, log logrus.FieldLogger) *UnbindEndpoint {
+	return &UnbindEndpoint{
+		instancesStorage: instancesStorage,
+		bindingsStorage:  bindingsStorage,
+		bindingManager:   bindingManager,
+		log:              log.WithField("service", "UnbindEndpoint"),
+	}
 }
 
 // Unbind deletes an existing service binding
 //
 //	DELETE /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *UnbindEndpoint) Unbind(ctx context.Context, instanceID, bindingID string, details domain.UnbindDetails, asyncAllowed bool) (domain.UnbindSpec, error) {
-	b.log.Infof("Unbind instanceID: %s", instanceID)
-	b.log.Infof("Unbind details: %+v", details)
-	b.log.Infof("Unbind asyncAllowed: %v", asyncAllowed)
+	logger := b.log.WithField("instanceID", instanceID).WithField("bindingID", bindingID)
+	logger.Infof("Unbind details: %+v", details)
+	logger.Infof("Unbind asyncAllowed: %v", asyncAllowed)
+
+	_, err := b.bindingsStorage.GetByID(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.UnbindSpec{}, apiresponses.ErrBindingDoesNotExist
+	case err != nil:
+		logger.Errorf("unable to get binding from storage: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting binding from storage")
+	}
+
+	instance, err := b.instancesStorage.GetByID(instanceID)
+	switch {
+	case err == nil:
+		if err := b.bindingManager.Revoke(ctx, instance, bindingID); err != nil {
+			logger.Errorf("unable to revoke binding credentials: %s", err)
+			return domain.UnbindSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "revoking binding credentials")
+		}
+	case dberr.IsNotFound(err):
+		logger.Info("instance does not exist, skipping revocation of binding credentials")
+	default:
+		logger.Errorf("unable to get instance from storage: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting instance from storage")
+	}
+
+	if err := b.bindingsStorage.Delete(instanceID, bindingID); err != nil {
+		logger.Errorf("unable to remove binding from storage: %s", err)
+		return domain.UnbindSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "removing binding from storage")
+	}
+	logger.Info("Binding removed")
 
-	return domain.UnbindSpec{}, errors.New("not supported")
+	return domain.UnbindSpec{}, nil
 }
@@ -2,26 +2,50 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type GetBindingEndpoint struct {
+	bindingsStorage storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewGetBinding(log logrus.FieldLogger) *GetBindingEndpoint {
-	return &GetBindingEndpoint{log: log.WithField("service", "GetBindingEndpoint")}
+func NewGetBinding(bindingsStorage storage.Bindings, log logrus.FieldLogger) *GetBindingEndpoint {
+	return &GetBindingEndpoint{
+		bindingsStorage: bindingsStorage,
+		log:             log.WithField("service", "GetBindingEndpoint"),
+	}
 }
 
-// GetBinding fetches an existing service binding
+// GetBinding fetches an existing service binding, an expired binding is reported as not found
 //
 //	GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}
 func (b *GetBindingEndpoint) GetBinding(_ context.Context, instanceID, bindingID string, _ domain.FetchBindingDetails) (domain.GetBindingSpec, error) {
-	b.log.Infof("GetBinding instanceID: %s", instanceID)
-	b.log.Infof("GetBinding bindingID: %s", bindingID)
+	logger := b.log.WithField("instanceID", instanceID).WithField("bindingID", bindingID)
+
+	binding, err := b.bindingsStorage.GetByID(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	case err != nil:
+		logger.Errorf("unable to get binding from storage: %s", err)
+		return domain.GetBindingSpec{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting binding from storage")
+	}
+	if binding.IsExpired() {
+		logger.Info("binding expired")
+		return domain.GetBindingSpec{}, apiresponses.ErrBindingNotFound
+	}
 
-	return domain.GetBindingSpec{}, errors.New("not supported")
+	return domain.GetBindingSpec{
+		Credentials: BindingCredentials{Kubeconfig: binding.Kubeconfig, ExpiresAt: binding.ExpiresAt},
+		Parameters:  BindingParams{Role: binding.Role},
+	}, nil
 }
@@ -2,27 +2,47 @@ package broker
 
 import (
 	"context"
-	"errors"
+	"net/http"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 
 	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pivotal-cf/brokerapi/v8/domain/apiresponses"
 	"github.com/sirupsen/logrus"
 )
 
 type LastBindingOperationEndpoint struct {
+	bindingsStorage storage.Bindings
+
 	log logrus.FieldLogger
 }
 
-func NewLastBindingOperation(log logrus.FieldLogger) *LastBindingOperationEndpoint {
-	return &LastBindingOperationEndpoint{log: log.WithField("service", "LastBindingOperationEndpoint")}
+func NewLastBindingOperation(bindingsStorage storage.Bindings, log logrus.FieldLogger) *LastBindingOperationEndpoint {
+	return &LastBindingOperationEndpoint{
+		bindingsStorage: bindingsStorage,
+		log:             log.WithField("service", "LastBindingOperationEndpoint"),
+	}
 }
 
 // LastBindingOperation fetches last operation state for a service binding
+// Bindings are created synchronously, so an existing binding is always succeeded.
 //
 //	GET /v2/service_instances/{instance_id}/service_bindings/{binding_id}/last_operation
 func (b *LastBindingOperationEndpoint) LastBindingOperation(ctx context.Context, instanceID, bindingID string, details domain.PollDetails) (domain.LastOperation, error) {
-	b.log.Infof("LastBindingOperation instanceID: %s", instanceID)
-	b.log.Infof("LastBindingOperation bindingID: %s", bindingID)
-	b.log.Infof("LastBindingOperation details: %+v", details)
+	logger := b.log.WithField("instanceID", instanceID).WithField("bindingID", bindingID)
+
+	_, err := b.bindingsStorage.GetByID(instanceID, bindingID)
+	switch {
+	case dberr.IsNotFound(err):
+		return domain.LastOperation{}, apiresponses.ErrBindingDoesNotExist
+	case err != nil:
+		logger.Errorf("unable to get binding from storage: %s", err)
+		return domain.LastOperation{}, apiresponses.NewFailureResponse(err, http.StatusInternalServerError, "getting binding from storage")
+	}
 
-	return domain.LastOperation{}, errors.New("not supported")
+	return domain.LastOperation{
+		State:       domain.Succeeded,
+		Description: "binding created",
+	}, nil
 }
@@ -41,6 +41,8 @@ type Config struct {
 	ShowTrialExpirationInfo                 bool   `envconfig:"default=false"`
 	SubaccountsIdsToShowTrialExpirationInfo string `envconfig:"default="`
 	TrialDocsURL                            string `envconfig:"default="`
+
+	Binding BindingConfig
 }
 
 type ServicesConfig map[string]Service
@@ -98,14 +100,32 @@ type EnablePlans []string
 
 // Unmarshal provides custom parsing of enabled plans.
 // Implements envconfig.Unmarshal interface.
+// The plan names are checked against the plan catalog by Validate, because the catalog is loaded after the configuration.
 func (m *EnablePlans) Unmarshal(in string) error {
-	plans := strings.Split(in, ",")
-	for _, name := range plans {
-		if _, exists := PlanIDsMapping[name]; !exists {
+	*m = strings.Split(in, ",")
+	return nil
+}
+
+// Validate checks if all enabled plans exist in the plan catalog
+func (m EnablePlans) Validate() error {
+	for _, name := range m {
+		if _, exists := PlanIDByName(name); !exists {
 			return errors.Errorf("unrecognized %v plan name ", name)
 		}
 	}
-
-	*m = plans
 	return nil
 }
+
+// ContainsPlanID checks if the plan with the given ID is in the current plan catalog and is enabled
+func (m EnablePlans) ContainsPlanID(planID string) bool {
+	plan, found := PlanCatalog().PlanByID(planID)
+	if !found {
+		return false
+	}
+	for _, name := range m {
+		if name == plan.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package broker
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/gardener"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+// the domain must be a lower case DNS name with at least two labels, the shoot domain is limited to 64 characters
+var customDomainRegexp = regexp.MustCompile(`^([a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?\.)+[a-z]{2,63}$`)
+
+const customDomainMaxLength = 64
+
+// SupportsCustomDomain checks if the runtimes of the plan can use the domain of the customer, the trial
+// and the free runtimes always use the KEB domain, and the own cluster is not managed by Gardener
+func SupportsCustomDomain(planID string) bool {
+	switch BasePlanID(planID) {
+	case TrialPlanID, FreemiumPlanID, OwnClusterPlanID:
+		return false
+	default:
+		return true
+	}
+}
+
+// customDomainSecretName checks if the secret of the Gardener project belongs to the subaccount, the secrets
+// of the DNS providers and the certificate issuers are shared by all subaccounts in the Gardener project,
+// so the customer can use only the secrets named with the subaccount ID prefix
+func customDomainSecretName(subAccountID, name string) bool {
+	return subAccountID != "" && strings.HasPrefix(name, subAccountID+"-") && len(name) > len(subAccountID)+1
+}
+
+func validateCustomDomain(planID, subAccountID string, params *internal.CustomDomainDTO) error {
+	if params == nil {
+		return nil
+	}
+	if !SupportsCustomDomain(planID) {
+		return fmt.Errorf("custom domain is not supported by the plan %s", PlanNameByID(planID))
+	}
+	if len(params.Domain) > customDomainMaxLength || !customDomainRegexp.MatchString(params.Domain) {
+		return fmt.Errorf("custom domain %q is not a valid DNS name of up to %d characters", params.Domain, customDomainMaxLength)
+	}
+	if params.DNSProvider.Type == "" || params.DNSProvider.SecretName == "" {
+		return fmt.Errorf("type and secret name of the DNS provider of custom domain %s must be provided", params.Domain)
+	}
+	if !customDomainSecretName(subAccountID, params.DNSProvider.SecretName) {
+		return fmt.Errorf("secret name %q of the DNS provider must start with the subaccount ID followed by a dash", params.DNSProvider.SecretName)
+	}
+
+	issuer := params.CertificateIssuer
+	if _, err := mail.ParseAddress(issuer.Email); err != nil {
+		return fmt.Errorf("email %q of the certificate issuer is not valid", issuer.Email)
+	}
+	if issuer.Server != nil {
+		server, err := url.Parse(*issuer.Server)
+		if err != nil || server.Scheme != "https" || server.Host == "" {
+			return fmt.Errorf("server %q of the certificate issuer must be an HTTPS URL", *issuer.Server)
+		}
+	}
+	if issuer.PrivateKeySecretName != nil && !customDomainSecretName(subAccountID, *issuer.PrivateKeySecretName) {
+		return fmt.Errorf("private key secret name %q of the certificate issuer must start with the subaccount ID followed by a dash", *issuer.PrivateKeySecretName)
+	}
+
+	return nil
+}
+
+// customDomainDNSProviders creates the primary DNS provider of the shoot managing the records of the custom domain
+func customDomainDNSProviders(params internal.CustomDomainDTO) gardener.DNSProvidersData {
+	return gardener.DNSProvidersData{
+		Providers: []gardener.DNSProviderData{
+			{
+				DomainsInclude: []string{params.Domain},
+				Primary:        true,
+				SecretName:     params.DNSProvider.SecretName,
+				Type:           params.DNSProvider.Type,
+			},
+		},
+	}
+}
@@ -0,0 +1,127 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateCustomDomain(t *testing.T) {
+	const subAccountID = "subaccount-id"
+	fixCustomDomain := func(domain string) *internal.CustomDomainDTO {
+		return &internal.CustomDomainDTO{
+			Domain:            domain,
+			DNSProvider:       internal.DNSProviderDTO{Type: "aws-route53", SecretName: "subaccount-id-route53"},
+			CertificateIssuer: internal.CertificateIssuerDTO{Email: "admin@example.com"},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		planID       string
+		customDomain *internal.CustomDomainDTO
+		err          string
+	}{
+		"no custom domain": {
+			planID: TrialPlanID,
+		},
+		"valid custom domain": {
+			planID:       AWSPlanID,
+			customDomain: fixCustomDomain("kyma.example.com"),
+		},
+		"not supported plan": {
+			planID:       TrialPlanID,
+			customDomain: fixCustomDomain("kyma.example.com"),
+			err:          "custom domain is not supported by the plan trial",
+		},
+		"invalid domain": {
+			planID:       GCPPlanID,
+			customDomain: fixCustomDomain("Kyma_Example"),
+			err:          `custom domain "Kyma_Example" is not a valid DNS name of up to 64 characters`,
+		},
+		"missing DNS provider secret": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.DNSProvider.SecretName = ""
+				return d
+			}(),
+			err: "type and secret name of the DNS provider of custom domain kyma.example.com must be provided",
+		},
+		"DNS provider secret of another subaccount": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.DNSProvider.SecretName = "other-subaccount-id-route53"
+				return d
+			}(),
+			err: `secret name "other-subaccount-id-route53" of the DNS provider must start with the subaccount ID followed by a dash`,
+		},
+		"DNS provider secret with the subaccount ID only": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.DNSProvider.SecretName = "subaccount-id-"
+				return d
+			}(),
+			err: `secret name "subaccount-id-" of the DNS provider must start with the subaccount ID followed by a dash`,
+		},
+		"valid certificate issuer private key secret": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.CertificateIssuer.PrivateKeySecretName = ptr.String("subaccount-id-acme")
+				return d
+			}(),
+		},
+		"certificate issuer private key secret of another subaccount": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.CertificateIssuer.PrivateKeySecretName = ptr.String("acme-account")
+				return d
+			}(),
+			err: `private key secret name "acme-account" of the certificate issuer must start with the subaccount ID followed by a dash`,
+		},
+		"missing certificate issuer": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.CertificateIssuer = internal.CertificateIssuerDTO{}
+				return d
+			}(),
+			err: `email "" of the certificate issuer is not valid`,
+		},
+		"invalid issuer email": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.CertificateIssuer.Email = "admin"
+				return d
+			}(),
+			err: `email "admin" of the certificate issuer is not valid`,
+		},
+		"HTTP issuer server": {
+			planID: AzurePlanID,
+			customDomain: func() *internal.CustomDomainDTO {
+				d := fixCustomDomain("kyma.example.com")
+				d.CertificateIssuer.Server = ptr.String("http://acme.example.com/directory")
+				return d
+			}(),
+			err: `server "http://acme.example.com/directory" of the certificate issuer must be an HTTPS URL`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateCustomDomain(tc.planID, subAccountID, tc.customDomain)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package broker
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/hibernation"
+)
+
+// maxHibernationSchedules limits the number of the schedules of the runtime
+const maxHibernationSchedules = 10
+
+// SupportsHibernationSchedules checks if the runtimes of the plan can be hibernated on a schedule, the trial
+// and the free runtimes are suspended by KEB, and the own cluster is not managed by Gardener
+func SupportsHibernationSchedules(planID string) bool {
+	switch BasePlanID(planID) {
+	case TrialPlanID, FreemiumPlanID, OwnClusterPlanID:
+		return false
+	default:
+		return true
+	}
+}
+
+func validateHibernationSchedules(planID string, schedules []internal.HibernationScheduleDTO) error {
+	if len(schedules) == 0 {
+		return nil
+	}
+	if !SupportsHibernationSchedules(planID) {
+		return fmt.Errorf("hibernation schedules are not supported by the plan %s", PlanNameByID(planID))
+	}
+	if len(schedules) > maxHibernationSchedules {
+		return fmt.Errorf("the number of hibernation schedules must not be greater than %d", maxHibernationSchedules)
+	}
+	for i, schedule := range internal.HibernationSchedules(schedules) {
+		if err := hibernation.Validate(schedule); err != nil {
+			return fmt.Errorf("hibernation schedule %d: %s", i+1, err)
+		}
+	}
+	return nil
+}
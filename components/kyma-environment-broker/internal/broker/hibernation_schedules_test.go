@@ -0,0 +1,54 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHibernationSchedules(t *testing.T) {
+	workingDays := internal.HibernationScheduleDTO{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")}
+
+	for name, tc := range map[string]struct {
+		planID    string
+		schedules []internal.HibernationScheduleDTO
+		err       string
+	}{
+		"no schedules": {
+			planID: TrialPlanID,
+		},
+		"valid schedules": {
+			planID:    AzurePlanID,
+			schedules: []internal.HibernationScheduleDTO{workingDays, {Start: ptr.String("@daily")}},
+		},
+		"not supported plan": {
+			planID:    TrialPlanID,
+			schedules: []internal.HibernationScheduleDTO{workingDays},
+			err:       "hibernation schedules are not supported by the plan trial",
+		},
+		"too many schedules": {
+			planID:    GCPPlanID,
+			schedules: make([]internal.HibernationScheduleDTO, maxHibernationSchedules+1),
+			err:       "the number of hibernation schedules must not be greater than 10",
+		},
+		"invalid schedule": {
+			planID:    AWSPlanID,
+			schedules: []internal.HibernationScheduleDTO{workingDays, {Start: ptr.String("00 25 * * *")}},
+			err:       `hibernation schedule 2: cron expression "00 25 * * *" is not valid: end of range (25) above maximum (23): 25`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateHibernationSchedules(tc.planID, tc.schedules)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
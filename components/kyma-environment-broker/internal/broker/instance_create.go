@@ -44,7 +44,6 @@ type ProvisionEndpoint struct {
 	instanceStorage   storage.Instances
 	queue             Queue
 	builderFactory    PlanValidator
-	enabledPlanIDs    map[string]struct{}
 	plansConfig       PlansConfig
 	kymaVerOnDemand   bool
 	planDefaults      PlanDefaults
@@ -70,12 +69,6 @@ func NewProvision(cfg Config,
 	log logrus.FieldLogger,
 	dashboardConfig dashboard.Config,
 ) *ProvisionEndpoint {
-	enabledPlanIDs := map[string]struct{}{}
-	for _, planName := range cfg.EnablePlans {
-		id := PlanIDsMapping[planName]
-		enabledPlanIDs[id] = struct{}{}
-	}
-
 	return &ProvisionEndpoint{
 		config:            cfg,
 		operationsStorage: operationsStorage,
@@ -83,7 +76,6 @@ func NewProvision(cfg Config,
 		queue:             queue,
 		builderFactory:    builderFactory,
 		log:               log.WithField("service", "ProvisionEndpoint"),
-		enabledPlanIDs:    enabledPlanIDs,
 		plansConfig:       plansConfig,
 		kymaVerOnDemand:   kvod,
 		shootDomain:       gardenerConfig.ShootDomain,
@@ -164,6 +156,11 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		operation.ShootName = provisioningParameters.Parameters.ShootName
 		operation.ShootDomain = provisioningParameters.Parameters.ShootDomain
 	}
+	// for custom domain - the DNS records are managed by the DNS provider of the customer
+	if customDomain := provisioningParameters.Parameters.CustomDomain; customDomain != nil {
+		operation.ShootDomain = customDomain.Domain
+		operation.ShootDNSProviders = customDomainDNSProviders(*customDomain)
+	}
 	logger.Infof("Runtime ShootDomain: %s", operation.ShootDomain)
 
 	err = b.operationsStorage.InsertOperation(operation.Operation)
@@ -179,7 +176,7 @@ func (b *ProvisionEndpoint) Provision(ctx context.Context, instanceID string, de
 		ServiceID:       provisioningParameters.ServiceID,
 		ServiceName:     KymaServiceName,
 		ServicePlanID:   provisioningParameters.PlanID,
-		ServicePlanName: Plans(b.plansConfig, provisioningParameters.PlatformProvider, false)[provisioningParameters.PlanID].Name,
+		ServicePlanName: PlanNameByID(provisioningParameters.PlanID),
 		DashboardURL:    dashboardURL,
 		Parameters:      operation.ProvisioningParameters,
 	}
@@ -221,7 +218,7 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 	if details.ServiceID != KymaServiceID {
 		return ersContext, parameters, errors.New("service_id not recognized")
 	}
-	if _, exists := b.enabledPlanIDs[details.PlanID]; !exists {
+	if !b.config.EnablePlans.ContainsPlanID(details.PlanID) {
 		return ersContext, parameters, errors.Errorf("plan ID %q is not recognized", details.PlanID)
 	}
 
@@ -252,6 +249,18 @@ func (b *ProvisionEndpoint) validateAndExtract(details domain.ProvisionDetails,
 			return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 		}
 	}
+	if err := validateAdditionalWorkerNodePools(details.PlanID, parameters.AdditionalWorkerNodePools, true); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateNetworking(details.PlanID, parameters.Networking); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateCustomDomain(details.PlanID, ersContext.SubAccountID, parameters.CustomDomain); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateHibernationSchedules(details.PlanID, parameters.HibernationSchedules); err != nil {
+		return ersContext, parameters, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
 
 	planValidator, err := b.validator(&details, provider)
 	if err != nil {
@@ -381,7 +390,7 @@ func (b *ProvisionEndpoint) handleExistingOperation(operation *internal.Provisio
 }
 
 func (b *ProvisionEndpoint) determineLicenceType(planId string) *string {
-	if planId == AzureLitePlanID || IsTrialPlan(planId) {
+	if BasePlanID(planId) == AzureLitePlanID || IsTrialPlan(planId) {
 		return ptr.String(internal.LicenceTypeLite)
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/dashboard"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
@@ -120,6 +121,97 @@ func TestProvision_Provision(t *testing.T) {
 		assert.Equal(t, fixDNSProviders(), instance.InstanceDetails.ShootDNSProviders)
 	})
 
+	t.Run("new operation with custom domain will be created", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{
+				EnablePlans:              []string{"gcp", "azure"},
+				URL:                      brokerURL,
+				OnlySingleTrialPerGA:     true,
+				EnableKubeconfigURLLabel: true,
+			},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			logrus.StandardLogger(),
+			dashboardConfig,
+		)
+
+		// when
+		response, err := provisionEndpoint.Provision(fixRequestContext(t, "req-region"), instanceID, domain.ProvisionDetails{
+			ServiceID: serviceID,
+			PlanID:    planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "customDomain": {"domain": "kyma.customer.com",
+				"dnsProvider": {"type": "aws-route53", "secretName": "%s-route53"}, "certificateIssuer": {"email": "admin@customer.com"}}}`, clusterName, subAccountID)),
+			RawContext: json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+
+		operation, err := memoryStorage.Operations().GetProvisioningOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, "kyma.customer.com", operation.ShootDomain)
+		assert.Equal(t, gardener.DNSProvidersData{
+			Providers: []gardener.DNSProviderData{
+				{DomainsInclude: []string{"kyma.customer.com"}, Primary: true, SecretName: subAccountID + "-route53", Type: "aws-route53"},
+			},
+		}, operation.ShootDNSProviders)
+	})
+
+	t.Run("new operation with invalid custom domain will not be created", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", planID).Return(true)
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{EnablePlans: []string{"gcp", "azure"}, URL: brokerURL},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			&automock.Queue{},
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			logrus.StandardLogger(),
+			dashboardConfig,
+		)
+
+		// when
+		_, err := provisionEndpoint.Provision(fixRequestContext(t, "req-region"), instanceID, domain.ProvisionDetails{
+			ServiceID: serviceID,
+			PlanID:    planID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s", "customDomain": {"domain": "Kyma_Customer",
+				"dnsProvider": {"type": "aws-route53", "secretName": "route53-secret"}, "certificateIssuer": {"email": "admin@customer.com"}}}`, clusterName)),
+			RawContext: json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, globalAccountID, subAccountID, "Test@Test.pl")),
+		}, true)
+
+		// then
+		require.Error(t, err)
+	})
+
 	t.Run("new operation for own_cluster plan with kubeconfig will be created", func(t *testing.T) {
 		// given
 		// #setup memory storage
@@ -861,6 +953,59 @@ func TestProvision_Provision(t *testing.T) {
 		assert.Equal(t, ptr.String(internal.LicenceTypeLite), operation.ProvisioningParameters.Parameters.LicenceType)
 	})
 
+	t.Run("licence type lite should be saved in parameters for the plan based on Azure Lite Plan", func(t *testing.T) {
+		// given
+		customLitePlanID := "7d3c2b1a-9e8f-4a6b-8c5d-1e2f3a4b5c6d"
+		catalog := config.DefaultPlanCatalog()
+		catalog.Plans = append(catalog.Plans, internal.CatalogPlan{ID: customLitePlanID, Name: "azure_lite_small", BasePlan: broker.AzureLitePlanName})
+		require.NoError(t, broker.SetPlanCatalog(catalog))
+		defer func() {
+			require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+		}()
+		memoryStorage := storage.NewMemoryStorage()
+
+		factoryBuilder := &automock.PlanValidator{}
+		factoryBuilder.On("IsPlanSupport", customLitePlanID).Return(true)
+
+		queue := &automock.Queue{}
+		queue.On("Add", mock.AnythingOfType("string"))
+
+		planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+			return &gqlschema.ClusterConfigInput{}, nil
+		}
+		provisionEndpoint := broker.NewProvision(
+			broker.Config{EnablePlans: []string{"gcp", "azure", "azure_lite", "azure_lite_small"}, OnlySingleTrialPerGA: true},
+			gardener.Config{Project: "test", ShootDomain: "example.com", DNSProviders: fixDNSProviders()},
+			memoryStorage.Operations(),
+			memoryStorage.Instances(),
+			queue,
+			factoryBuilder,
+			broker.PlansConfig{},
+			false,
+			planDefaults,
+			logrus.StandardLogger(),
+			dashboardConfig,
+		)
+
+		// when
+		response, err := provisionEndpoint.Provision(fixRequestContext(t, "dummy"), instanceID, domain.ProvisionDetails{
+			ServiceID:     serviceID,
+			PlanID:        customLitePlanID,
+			RawParameters: json.RawMessage(fmt.Sprintf(`{"name": "%s"}`, clusterName)),
+			RawContext:    json.RawMessage(fmt.Sprintf(`{"globalaccount_id": "%s", "subaccount_id": "%s", "user_id": "%s"}`, "1cafb9c8-c8f8-478a-948a-9cb53bb76aa4", subAccountID, userID)),
+		}, true)
+		assert.NoError(t, err)
+
+		// then
+		operation, err := memoryStorage.Operations().GetProvisioningOperationByID(response.OperationData)
+		require.NoError(t, err)
+
+		assert.Equal(t, ptr.String(internal.LicenceTypeLite), operation.ProvisioningParameters.Parameters.LicenceType)
+		instance, err := memoryStorage.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, "azure_lite_small", instance.ServicePlanName)
+	})
+
 	t.Run("licence type lite should be saved in parameters for Trial Plan", func(t *testing.T) {
 		// given
 		memoryStorage := storage.NewMemoryStorage()
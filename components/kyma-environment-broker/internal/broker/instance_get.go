@@ -77,7 +77,7 @@ func (b *GetInstanceEndpoint) GetInstance(_ context.Context, instanceID string,
 	}
 
 	if b.config.ShowTrialExpirationInfo &&
-		instance.ServicePlanID == TrialPlanID &&
+		IsTrialPlan(instance.ServicePlanID) &&
 		(b.config.SubaccountsIdsToShowTrialExpirationInfo == allSubaccountsIDs ||
 			strings.Contains(b.config.SubaccountsIdsToShowTrialExpirationInfo, instance.SubAccountID)) {
 		spec.Metadata.Labels = ResponseLabelsWithExpirationInfo(*op, *instance, b.config.URL, b.config.TrialDocsURL, b.config.EnableKubeconfigURLLabel)
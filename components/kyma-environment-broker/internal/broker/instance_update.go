@@ -20,6 +20,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
 type ContextUpdateHandler interface {
@@ -90,7 +91,7 @@ func (b *UpdateEndpoint) Update(_ context.Context, instanceID string, details do
 		logger.Errorf("unable to get instance: %s", err.Error())
 		return domain.UpdateServiceSpec{}, errors.New("unable to get instance")
 	}
-	logger.Infof("Plan ID/Name: %s/%s", instance.ServicePlanID, PlanNamesMapping[instance.ServicePlanID])
+	logger.Infof("Plan ID/Name: %s/%s", instance.ServicePlanID, PlanNameByID(instance.ServicePlanID))
 
 	var ersContext internal.ERSContext
 	err = json.Unmarshal(details.RawContext, &ersContext)
@@ -222,6 +223,18 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 		logger.Errorf("invalid autoscaler parameters: %s", err.Error())
 		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
 	}
+	if err := validateAdditionalWorkerNodePools(planID, params.AdditionalWorkerNodePools, false); err != nil {
+		logger.Errorf("invalid additional worker node pools: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateHibernationSchedules(planID, params.HibernationSchedules); err != nil {
+		logger.Errorf("invalid hibernation schedules: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
+	if err := validateMachineUpdate(planID, params, currentVolumeSizeGb(instance, defaults)); err != nil {
+		logger.Errorf("invalid machine parameters: %s", err.Error())
+		return domain.UpdateServiceSpec{}, apiresponses.NewFailureResponse(err, http.StatusUnprocessableEntity, err.Error())
+	}
 	err = b.operationStorage.InsertOperation(operation)
 	if err != nil {
 		return domain.UpdateServiceSpec{}, err
@@ -243,6 +256,17 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	if params.UpdateAutoScaler(&instance.Parameters.Parameters) {
 		updateStorage = append(updateStorage, "Auto Scaler parameters")
 	}
+	if params.UpdateMachine(&instance.Parameters.Parameters) {
+		updateStorage = append(updateStorage, "Machine parameters")
+	}
+	if params.AdditionalWorkerNodePools != nil {
+		instance.Parameters.Parameters.AdditionalWorkerNodePools = params.AdditionalWorkerNodePools
+		updateStorage = append(updateStorage, "Additional Worker Node Pools")
+	}
+	if params.HibernationSchedules != nil {
+		instance.Parameters.Parameters.HibernationSchedules = params.HibernationSchedules
+		updateStorage = append(updateStorage, "Hibernation Schedules")
+	}
 	if len(updateStorage) > 0 {
 		if err := wait.Poll(500*time.Millisecond, 2*time.Second, func() (bool, error) {
 			instance, err = b.instanceStorage.Update(*instance)
@@ -270,6 +294,47 @@ func (b *UpdateEndpoint) processUpdateParameters(instance *internal.Instance, de
 	}, nil
 }
 
+// validateMachineUpdate checks the machine type against all machine types of the plan, the volume size cannot be decreased
+// because Gardener does not shrink the disks of the existing nodes
+func validateMachineUpdate(planID string, params internal.UpdatingParametersDTO, currentVolumeSizeGb *int) error {
+	if params.MachineType != nil {
+		supported := false
+		for _, name := range catalogPlan(planID).MachineTypeNames(false) {
+			if name == *params.MachineType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("machine type %q is not supported by the plan %s", *params.MachineType, PlanNameByID(planID))
+		}
+	}
+	if params.VolumeSizeGb != nil {
+		switch BasePlanID(planID) {
+		case OpenStackPlanID, TrialPlanID, FreemiumPlanID, OwnClusterPlanID:
+			return fmt.Errorf("volumeSizeGb is not supported by the plan %s", PlanNameByID(planID))
+		}
+		if *params.VolumeSizeGb < 1 {
+			return fmt.Errorf("invalid volumeSizeGb %d", *params.VolumeSizeGb)
+		}
+		if currentVolumeSizeGb != nil && *params.VolumeSizeGb < *currentVolumeSizeGb {
+			return fmt.Errorf("volumeSizeGb cannot be decreased from %d to %d", *currentVolumeSizeGb, *params.VolumeSizeGb)
+		}
+	}
+	return nil
+}
+
+// currentVolumeSizeGb returns the volume size set in the provisioning or in the previous update, or the plan default
+func currentVolumeSizeGb(instance *internal.Instance, defaults *gqlschema.ClusterConfigInput) *int {
+	if instance.Parameters.Parameters.VolumeSizeGb != nil {
+		return instance.Parameters.Parameters.VolumeSizeGb
+	}
+	if defaults != nil && defaults.GardenerConfig != nil {
+		return defaults.GardenerConfig.VolumeSizeGb
+	}
+	return nil
+}
+
 func (b *UpdateEndpoint) processContext(instance *internal.Instance, details domain.UpdateDetails, lastProvisioningOperation *internal.ProvisioningOperation, logger logrus.FieldLogger) (*internal.Instance, bool, error) {
 	var ersContext internal.ERSContext
 	err := json.Unmarshal(details.RawContext, &ersContext)
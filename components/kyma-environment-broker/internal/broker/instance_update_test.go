@@ -542,6 +542,73 @@ func TestUpdateEndpoint_UpdateParameters(t *testing.T) {
 	})
 }
 
+func TestUpdateEndpoint_UpdateMachineParameters(t *testing.T) {
+	// given
+	instance := fixture.FixInstance(instanceID)
+	instance.Parameters.Parameters.VolumeSizeGb = nil
+	st := storage.NewMemoryStorage()
+	st.Instances().Insert(instance)
+	st.Operations().InsertProvisioningOperation(fixProvisioningOperation("provisioning01"))
+
+	handler := &handler{}
+	q := &automock.Queue{}
+	q.On("Add", mock.AnythingOfType("string"))
+	planDefaults := func(planID string, platformProvider internal.CloudProvider, provider *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+		return &gqlschema.ClusterConfigInput{GardenerConfig: &gqlschema.GardenerConfigInput{VolumeSizeGb: ptr.Integer(50)}}, nil
+	}
+
+	svc := NewUpdate(Config{}, st.Instances(), st.RuntimeStates(), st.Operations(), handler, true, true, q, planDefaults, logrus.New(), dashboardConfig)
+
+	for name, tc := range map[string]struct {
+		params string
+		err    string
+	}{
+		"not supported machine type": {
+			params: `{"machineType":"m5.xlarge"}`,
+			err:    `machine type "m5.xlarge" is not supported by the plan azure`,
+		},
+		"decreased volume size": {
+			params: `{"volumeSizeGb":40}`,
+			err:    "volumeSizeGb cannot be decreased from 50 to 40",
+		},
+	} {
+		t.Run("Should fail on "+name, func(t *testing.T) {
+			// when
+			_, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+				PlanID:        AzurePlanID,
+				RawParameters: json.RawMessage(tc.params),
+				RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+			}, true)
+
+			// then
+			require.Error(t, err)
+			apierr, ok := err.(*apiresponses.FailureResponse)
+			require.True(t, ok)
+			assert.Equal(t, http.StatusUnprocessableEntity, apierr.ValidatedStatusCode(nil))
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+
+	t.Run("Should store the machine type and the volume size", func(t *testing.T) {
+		// when
+		response, err := svc.Update(context.Background(), instanceID, domain.UpdateDetails{
+			PlanID:        AzurePlanID,
+			RawParameters: json.RawMessage(`{"machineType":"Standard_D16_v3","volumeSizeGb":80}`),
+			RawContext:    json.RawMessage("{\"globalaccount_id\":\"globalaccount_id_1\", \"active\":true}"),
+		}, true)
+
+		// then
+		require.NoError(t, err)
+		op, err := st.Operations().GetOperationByID(response.OperationData)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.String("Standard_D16_v3"), op.ProvisioningParameters.Parameters.MachineType)
+		assert.Equal(t, ptr.Integer(80), op.ProvisioningParameters.Parameters.VolumeSizeGb)
+		inst, err := st.Instances().GetByID(instanceID)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.Integer(80), inst.Parameters.Parameters.VolumeSizeGb)
+	})
+}
+
 func TestUpdateEndpoint_UpdateWithEnabledDashboard(t *testing.T) {
 	// given
 	instance := internal.Instance{
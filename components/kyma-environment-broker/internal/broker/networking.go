@@ -0,0 +1,30 @@
+package broker
+
+import (
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
+)
+
+// SupportsNetworking checks if the networking of the runtimes of the plan can be customized, the zone subnets
+// are computed from the nodes CIDR only for the plans on AWS, Azure, and GCP
+func SupportsNetworking(planID string) bool {
+	switch BasePlanID(planID) {
+	case AWSPlanID, AzurePlanID, GCPPlanID, PreviewPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
+func validateNetworking(planID string, params *internal.NetworkingDTO) error {
+	if params == nil {
+		return nil
+	}
+	if !SupportsNetworking(planID) {
+		return fmt.Errorf("networking parameters are not supported by the plan %s", PlanNameByID(planID))
+	}
+
+	return networking.Validate(params.NodesCidr, params.PodsCidr, params.ServicesCidr)
+}
@@ -0,0 +1,47 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateNetworking(t *testing.T) {
+	for name, tc := range map[string]struct {
+		planID     string
+		networking *internal.NetworkingDTO
+		err        string
+	}{
+		"no networking": {
+			planID: TrialPlanID,
+		},
+		"valid networking": {
+			planID:     AzurePlanID,
+			networking: &internal.NetworkingDTO{NodesCidr: "10.180.0.0/20", PodsCidr: ptr.String("10.96.0.0/13")},
+		},
+		"not supported plan": {
+			planID:     OpenStackPlanID,
+			networking: &internal.NetworkingDTO{NodesCidr: "10.180.0.0/20"},
+			err:        "networking parameters are not supported by the plan openstack",
+		},
+		"overlapping networks": {
+			planID:     AWSPlanID,
+			networking: &internal.NetworkingDTO{NodesCidr: "100.64.0.0/16"},
+			err:        "pods CIDR 100.64.0.0/12 overlaps with nodes CIDR 100.64.0.0/16",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateNetworking(tc.planID, tc.networking)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
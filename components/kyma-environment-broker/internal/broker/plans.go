@@ -33,30 +33,6 @@ const (
 	PreviewPlanName    = "preview"
 )
 
-var PlanNamesMapping = map[string]string{
-	GCPPlanID:        GCPPlanName,
-	AWSPlanID:        AWSPlanName,
-	AzurePlanID:      AzurePlanName,
-	AzureLitePlanID:  AzureLitePlanName,
-	TrialPlanID:      TrialPlanName,
-	OpenStackPlanID:  OpenStackPlanName,
-	FreemiumPlanID:   FreemiumPlanName,
-	OwnClusterPlanID: OwnClusterPlanName,
-	PreviewPlanID:    PreviewPlanName,
-}
-
-var PlanIDsMapping = map[string]string{
-	AzurePlanName:      AzurePlanID,
-	AWSPlanName:        AWSPlanID,
-	AzureLitePlanName:  AzureLitePlanID,
-	GCPPlanName:        GCPPlanID,
-	TrialPlanName:      TrialPlanID,
-	OpenStackPlanName:  OpenStackPlanID,
-	FreemiumPlanName:   FreemiumPlanID,
-	OwnClusterPlanName: OwnClusterPlanID,
-	PreviewPlanName:    PreviewPlanID,
-}
-
 type TrialCloudRegion string
 
 const (
@@ -76,88 +52,89 @@ type JSONSchemaValidator interface {
 }
 
 func AzureRegions() []string {
-	return []string{
-		"eastus",
-		"centralus",
-		"westus2",
-		"uksouth",
-		"northeurope",
-		"westeurope",
-		"japaneast",
-		"southeastasia",
-	}
+	return catalogPlan(AzurePlanID).Regions
 }
 
 func GCPRegions() []string {
-	return []string{
-		"europe-west3",
-		"asia-south1",
-		"us-central1"}
+	return catalogPlan(GCPPlanID).Regions
 }
 
 func AWSRegions() []string {
-	// be aware of zones defined in internal/provider/aws_provider.go
-	return []string{"eu-central-1", "eu-west-2", "ca-central-1", "sa-east-1", "us-east-1", "us-west-1",
-		"ap-northeast-1", "ap-northeast-2", "ap-south-1", "ap-southeast-1", "ap-southeast-2"}
+	return catalogPlan(AWSPlanID).Regions
 }
 
 func OpenStackRegions() []string {
-	return []string{"eu-de-1", "ap-sa-1"}
+	return catalogPlan(OpenStackPlanID).Regions
 }
 
 func OpenStackSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, OpenStackRegions(), update)
-	properties.AutoScalerMax.Maximum = 40
-	if !update {
-		properties.AutoScalerMax.Default = 8
-	}
-
-	return createSchemaWithProperties(properties, additionalParams, update)
+	return machineTypesSchema(catalogPlan(OpenStackPlanID), machineTypesDisplay, machineTypes, additionalParams, update)
 }
 
 func GCPSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	return createSchema(machineTypesDisplay, machineTypes, GCPRegions(), additionalParams, update)
+	return machineTypesSchema(catalogPlan(GCPPlanID), machineTypesDisplay, machineTypes, additionalParams, update)
 }
 
 func AWSSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	return createSchema(machineTypesDisplay, machineTypes, AWSRegions(), additionalParams, update)
+	return machineTypesSchema(catalogPlan(AWSPlanID), machineTypesDisplay, machineTypes, additionalParams, update)
 }
 
 func AzureSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	return createSchema(machineTypesDisplay, machineTypes, AzureRegions(), additionalParams, update)
+	return machineTypesSchema(catalogPlan(AzurePlanID), machineTypesDisplay, machineTypes, additionalParams, update)
 }
 
 func AzureLiteSchema(machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, AzureRegions(), update)
-	properties.AutoScalerMax.Maximum = 40
+	return machineTypesSchema(catalogPlan(AzureLitePlanID), machineTypesDisplay, machineTypes, additionalParams, update)
+}
 
+// machineTypesSchema creates the schema of the plans provisioning clusters on hyperscalers, the regions and
+// the autoscaler defaults are taken from the catalog plan
+func machineTypesSchema(plan internal.CatalogPlan, machineTypesDisplay map[string]string, machineTypes []string, additionalParams, update bool) *map[string]interface{} {
+	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, plan.Regions, update)
+	if plan.AutoScaler.Maximum > 0 {
+		properties.AutoScalerMax.Maximum = plan.AutoScaler.Maximum
+	}
+	if update && BasePlanID(plan.ID) != OpenStackPlanID {
+		properties.VolumeSizeGb = VolumeSizeGbProperty()
+	}
 	if !update {
-		properties.AutoScalerMax.Default = 10
-		properties.AutoScalerMin.Default = 2
+		if plan.AutoScaler.Max > 0 {
+			properties.AutoScalerMax.Default = plan.AutoScaler.Max
+		}
+		if plan.AutoScaler.Min > 0 {
+			properties.AutoScalerMin.Default = plan.AutoScaler.Min
+		}
+	}
+	if additionalParams && !update && SupportsNetworking(plan.ID) {
+		properties.Networking = NewNetworkingSchema()
+	}
+	if additionalParams && !update && SupportsCustomDomain(plan.ID) {
+		properties.CustomDomain = NewCustomDomainSchema()
+	}
+	if additionalParams && SupportsHibernationSchedules(plan.ID) {
+		properties.HibernationSchedules = NewHibernationSchedulesSchema()
+	}
+	if additionalParams && SupportsAdditionalWorkerNodePools(plan.ID) {
+		properties.AdditionalWorkerNodePools = NewWorkerNodePoolsSchema(machineTypesDisplay, machineTypes, properties.AutoScalerMax.Maximum, BasePlanID(plan.ID) != OpenStackPlanID)
 	}
 
 	return createSchemaWithProperties(properties, additionalParams, update)
 }
 
 func FreemiumSchema(provider internal.CloudProvider, additionalParams, update bool) *map[string]interface{} {
+	return freemiumSchema(catalogPlan(FreemiumPlanID), provider, additionalParams, update)
+}
+
+func freemiumSchema(plan internal.CatalogPlan, provider internal.CloudProvider, additionalParams, update bool) *map[string]interface{} {
 	if update && !additionalParams {
 		return empty()
 	}
 
-	var regions []string
-	switch provider {
-	case internal.AWS:
-		regions = AWSRegions()
-	case internal.Azure:
-		regions = AzureRegions()
-	default:
-		regions = AWSRegions()
-	}
 	properties := ProvisioningProperties{
 		Name: NameProperty(),
 		Region: &Type{
 			Type: "string",
-			Enum: ToInterfaceSlice(regions),
+			Enum: ToInterfaceSlice(plan.RegionsFor(provider)),
 		},
 	}
 
@@ -198,11 +175,6 @@ func empty() *map[string]interface{} {
 	return &empty
 }
 
-func createSchema(machineTypesDisplay map[string]string, machineTypes, regions []string, additionalParams, update bool) *map[string]interface{} {
-	properties := NewProvisioningProperties(machineTypesDisplay, machineTypes, regions, update)
-	return createSchemaWithProperties(properties, additionalParams, update)
-}
-
 func createSchemaWithProperties(properties ProvisioningProperties, additionalParams, update bool) *map[string]interface{} {
 	if additionalParams {
 		properties.IncludeAdditional()
@@ -241,92 +213,34 @@ func unmarshalSchema(schema *RootSchema) *map[string]interface{} {
 	return unmarshaled
 }
 
-// Plans is designed to hold plan defaulting logic
+// Plans returns the service plans from the catalog with the schemas for the given platform provider
 // keep internal/hyperscaler/azure/config.go in sync with any changes to available zones
 func Plans(plans PlansConfig, provider internal.CloudProvider, includeAdditionalParamsInSchema bool) map[string]domain.ServicePlan {
-	awsMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge", "m6i.xlarge", "m6i.2xlarge", "m6i.4xlarge", "m6i.8xlarge", "m6i.12xlarge"}
-	awsMachinesDisplay := map[string]string{
-		// source: https://aws.amazon.com/ec2/instance-types/m5/
-		"m5.xlarge":   "m5.xlarge (4vCPU, 16GB RAM)",
-		"m5.2xlarge":  "m5.2xlarge (8vCPU, 32GB RAM)",
-		"m5.4xlarge":  "m5.4xlarge (16vCPU, 64GB RAM)",
-		"m5.8xlarge":  "m5.8xlarge (32vCPU, 128GB RAM)",
-		"m5.12xlarge": "m5.12xlarge (48vCPU, 192GB RAM)",
-		// source: https://aws.amazon.com/ec2/instance-types/m6i/
-		"m6i.xlarge":   "m6i.xlarge (4vCPU, 16GB RAM)",
-		"m6i.2xlarge":  "m6i.2xlarge (8vCPU, 32GB RAM)",
-		"m6i.4xlarge":  "m6i.4xlarge (16vCPU, 64GB RAM)",
-		"m6i.8xlarge":  "m6i.8xlarge (32vCPU, 128GB RAM)",
-		"m6i.12xlarge": "m6i.12xlarge (48vCPU, 192GB RAM)",
+	outputPlans := map[string]domain.ServicePlan{}
+	for _, plan := range PlanCatalog().Plans {
+		createSchema, updateSchema := planSchemas(plan, provider, includeAdditionalParamsInSchema)
+		outputPlans[plan.ID] = defaultServicePlan(plan.ID, plan.Name, plans, createSchema, updateSchema)
 	}
 
-	// awsHASchema := AWSHASchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, false)
-
-	// source: https://cloud.google.com/compute/docs/general-purpose-machines#e2_limitations
-	gcpMachines := []string{"n2-standard-4", "n2-standard-8", "n2-standard-16", "n2-standard-32", "n2-standard-48"}
-	gcpMachinesDisplay := map[string]string{
-		"n2-standard-4":  "n2-standard-4 (4vCPU, 16GB RAM)",
-		"n2-standard-8":  "n2-standard-8 (8vCPU, 32GB RAM)",
-		"n2-standard-16": "n2-standard-16 (16vCPU, 64GB RAM)",
-		"n2-standard-32": "n2-standard-32 (32vCPU, 128GB RAM)",
-		"n2-standard-48": "n2-standard-48 (48vCPU, 192B RAM)",
-	}
-	gcpSchema := GCPSchema(gcpMachinesDisplay, gcpMachines, includeAdditionalParamsInSchema, false)
-
-	openStackMachines := []string{"g_c4_m16", "g_c8_m32"}
-	openStackMachinesDisplay := map[string]string{
-		"g_c4_m16": "g_c4_m16 (4vCPU, 16GB RAM)",
-		"g_c8_m32": "g_c8_m32 (8vCPU, 32GB RAM)",
-	}
-	openstackSchema := OpenStackSchema(openStackMachinesDisplay, openStackMachines, includeAdditionalParamsInSchema, false)
-
-	// source: https://docs.microsoft.com/en-us/azure/cloud-services/cloud-services-sizes-specs#dv3-series
-	azureMachines := []string{"Standard_D4_v3", "Standard_D8_v3", "Standard_D16_v3", "Standard_D32_v3", "Standard_D48_v3", "Standard_D64_v3"}
-	azureMachinesDisplay := map[string]string{
-		"Standard_D4_v3":  "Standard_D4_v3 (4vCPU, 16GB RAM)",
-		"Standard_D8_v3":  "Standard_D8_v3 (8vCPU, 32GB RAM)",
-		"Standard_D16_v3": "Standard_D16_v3 (16vCPU, 64GB RAM)",
-		"Standard_D32_v3": "Standard_D32_v3 (32vCPU, 128GB RAM)",
-		"Standard_D48_v3": "Standard_D48_v3 (48vCPU, 192GB RAM)",
-		"Standard_D64_v3": "Standard_D64_v3 (64vCPU, 256GB RAM)",
-	}
-	azureSchema := AzureSchema(azureMachinesDisplay, azureMachines, includeAdditionalParamsInSchema, false)
+	return outputPlans
+}
 
-	azureLiteMachines := []string{"Standard_D4_v3"}
-	azureLiteMachinesDisplay := map[string]string{
-		"Standard_D4_v3": azureMachinesDisplay["Standard_D4_v3"],
-	}
-	azureLiteSchema := AzureLiteSchema(azureLiteMachinesDisplay, azureLiteMachines, includeAdditionalParamsInSchema, false)
-	freemiumSchema := FreemiumSchema(provider, includeAdditionalParamsInSchema, false)
-	trialSchema := TrialSchema(includeAdditionalParamsInSchema, false)
-	ownClusterSchema := OwnClusterSchema(false)
-
-	// Schemas exposed on v2/catalog endpoint - different than provisioningRawSchema to allow backwards compatibility
-	// when a machine type switch is introduced
-	// switch to m6 if m6 is available in all regions
-	awsCatalogMachines := []string{"m5.xlarge", "m5.2xlarge", "m5.4xlarge", "m5.8xlarge", "m5.12xlarge"}
-	awsCatalogMachinesDisplay := map[string]string{
-		"m5.xlarge":   awsMachinesDisplay["m5.xlarge"],
-		"m5.2xlarge":  awsMachinesDisplay["m5.2xlarge"],
-		"m5.4xlarge":  awsMachinesDisplay["m5.4xlarge"],
-		"m5.8xlarge":  awsMachinesDisplay["m5.8xlarge"],
-		"m5.12xlarge": awsMachinesDisplay["m5.12xlarge"],
-	}
-	awsCatalogSchema := AWSSchema(awsCatalogMachinesDisplay, awsCatalogMachines, includeAdditionalParamsInSchema, false)
-
-	outputPlans := map[string]domain.ServicePlan{
-		AWSPlanID:        defaultServicePlan(AWSPlanID, AWSPlanName, plans, awsCatalogSchema, AWSSchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, true)),
-		GCPPlanID:        defaultServicePlan(GCPPlanID, GCPPlanName, plans, gcpSchema, GCPSchema(gcpMachinesDisplay, gcpMachines, includeAdditionalParamsInSchema, true)),
-		OpenStackPlanID:  defaultServicePlan(OpenStackPlanID, OpenStackPlanName, plans, openstackSchema, OpenStackSchema(openStackMachinesDisplay, openStackMachines, includeAdditionalParamsInSchema, true)),
-		AzurePlanID:      defaultServicePlan(AzurePlanID, AzurePlanName, plans, azureSchema, AzureSchema(azureMachinesDisplay, azureMachines, includeAdditionalParamsInSchema, true)),
-		AzureLitePlanID:  defaultServicePlan(AzureLitePlanID, AzureLitePlanName, plans, azureLiteSchema, AzureLiteSchema(azureLiteMachinesDisplay, azureLiteMachines, includeAdditionalParamsInSchema, true)),
-		FreemiumPlanID:   defaultServicePlan(FreemiumPlanID, FreemiumPlanName, plans, freemiumSchema, FreemiumSchema(provider, includeAdditionalParamsInSchema, true)),
-		TrialPlanID:      defaultServicePlan(TrialPlanID, TrialPlanName, plans, trialSchema, TrialSchema(includeAdditionalParamsInSchema, true)),
-		OwnClusterPlanID: defaultServicePlan(OwnClusterPlanID, OwnClusterPlanName, plans, ownClusterSchema, OwnClusterSchema(true)),
-		PreviewPlanID:    defaultServicePlan(PreviewPlanID, PreviewPlanName, plans, awsCatalogSchema, AWSSchema(awsMachinesDisplay, awsMachines, includeAdditionalParamsInSchema, true)),
+// planSchemas returns the provisioning and the update schema of the plan, the kind of the schema depends on the base plan
+func planSchemas(plan internal.CatalogPlan, provider internal.CloudProvider, includeAdditionalParamsInSchema bool) (*map[string]interface{}, *map[string]interface{}) {
+	switch BasePlanID(plan.ID) {
+	case TrialPlanID:
+		return TrialSchema(includeAdditionalParamsInSchema, false), TrialSchema(includeAdditionalParamsInSchema, true)
+	case FreemiumPlanID:
+		return freemiumSchema(plan, provider, includeAdditionalParamsInSchema, false), freemiumSchema(plan, provider, includeAdditionalParamsInSchema, true)
+	case OwnClusterPlanID:
+		return OwnClusterSchema(false), OwnClusterSchema(true)
+	default:
+		// the schema exposed on v2/catalog endpoint can offer less machine types than the update schema
+		// to allow backwards compatibility when a machine type switch is introduced
+		createSchema := machineTypesSchema(plan, plan.MachineTypesDisplay(true), plan.MachineTypeNames(true), includeAdditionalParamsInSchema, false)
+		updateSchema := machineTypesSchema(plan, plan.MachineTypesDisplay(false), plan.MachineTypeNames(false), includeAdditionalParamsInSchema, true)
+		return createSchema, updateSchema
 	}
-
-	return outputPlans
 }
 
 func defaultServicePlan(id, name string, plans PlansConfig, createParams, updateParams *map[string]interface{}) domain.ServicePlan {
@@ -371,7 +285,7 @@ func defaultMetadata(planName string, plans PlansConfig) *domain.ServicePlanMeta
 }
 
 func IsTrialPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case TrialPlanID:
 		return true
 	default:
@@ -380,7 +294,7 @@ func IsTrialPlan(planID string) bool {
 }
 
 func IsPreviewPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case PreviewPlanID:
 		return true
 	default:
@@ -389,7 +303,7 @@ func IsPreviewPlan(planID string) bool {
 }
 
 func IsAzurePlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case AzurePlanID, AzureLitePlanID:
 		return true
 	default:
@@ -398,7 +312,7 @@ func IsAzurePlan(planID string) bool {
 }
 
 func IsFreemiumPlan(planID string) bool {
-	switch planID {
+	switch BasePlanID(planID) {
 	case FreemiumPlanID:
 		return true
 	default:
@@ -407,7 +321,7 @@ func IsFreemiumPlan(planID string) bool {
 }
 
 func IsOwnClusterPlan(planID string) bool {
-	return planID == OwnClusterPlanID
+	return BasePlanID(planID) == OwnClusterPlanID
 }
 
 func filter(items *[]interface{}, included map[string]interface{}) interface{} {
@@ -0,0 +1,115 @@
+package broker
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+)
+
+var (
+	builtInPlanCatalog = config.DefaultPlanCatalog()
+	planCatalog        = builtInPlanCatalog
+	planCatalogMux     sync.RWMutex
+)
+
+// SetPlanCatalog replaces the catalog of the service plans, the catalog is used by all requests started afterwards
+func SetPlanCatalog(catalog internal.PlanCatalog) error {
+	if len(catalog.Plans) == 0 {
+		return fmt.Errorf("plan catalog does not contain any plan")
+	}
+	planCatalogMux.Lock()
+	defer planCatalogMux.Unlock()
+	planCatalog = catalog
+	return nil
+}
+
+// PlanCatalog returns the current catalog of the service plans
+func PlanCatalog() internal.PlanCatalog {
+	planCatalogMux.RLock()
+	defer planCatalogMux.RUnlock()
+	return planCatalog
+}
+
+// PlanIDByName returns the ID of the plan with the given name
+func PlanIDByName(name string) (string, bool) {
+	plan, found := PlanCatalog().PlanByName(name)
+	return plan.ID, found
+}
+
+// PlanNameByID returns the name of the plan with the given ID or an empty string if the plan does not exist.
+// The names of the built-in plans are returned also when they are removed from the catalog.
+func PlanNameByID(id string) string {
+	if plan, found := PlanCatalog().PlanByID(id); found {
+		return plan.Name
+	}
+	plan, _ := builtInPlanCatalog.PlanByID(id)
+	return plan.Name
+}
+
+// BasePlanID returns the ID of the built-in plan which defines how the runtimes of the given plan are provisioned.
+// For the built-in plans and the unknown ones, the given ID is returned.
+func BasePlanID(id string) string {
+	plan, found := PlanCatalog().PlanByID(id)
+	if !found || plan.BasePlan == "" {
+		return id
+	}
+	base, found := builtInPlanCatalog.PlanByName(plan.BasePlan)
+	if !found {
+		return id
+	}
+	return base.ID
+}
+
+// catalogPlan returns the plan from the catalog, the built-in plans removed from the catalog are returned with the built-in values
+func catalogPlan(id string) internal.CatalogPlan {
+	if plan, found := PlanCatalog().PlanByID(id); found {
+		return plan
+	}
+	plan, _ := builtInPlanCatalog.PlanByID(id)
+	return plan
+}
+
+// PlanCatalogUsageValidator rejects the catalog which removes the plans of the existing instances or changes their
+// base plans, the base plan decides how such instances are updated, upgraded, and deprovisioned
+type PlanCatalogUsageValidator struct {
+	validator config.PlanCatalogValidator
+	instances storage.Instances
+}
+
+func NewPlanCatalogUsageValidator(validator config.PlanCatalogValidator, instances storage.Instances) *PlanCatalogUsageValidator {
+	return &PlanCatalogUsageValidator{validator: validator, instances: instances}
+}
+
+func (v *PlanCatalogUsageValidator) Validate(catalog internal.PlanCatalog) error {
+	if err := v.validator.Validate(catalog); err != nil {
+		return err
+	}
+
+	planIDs, err := v.instances.GetPlanIDs()
+	if err != nil {
+		return fmt.Errorf("while getting the plans of the existing instances: %w", err)
+	}
+	current := PlanCatalog()
+	var problems []string
+	for _, id := range planIDs {
+		if _, builtIn := builtInPlanCatalog.PlanByID(id); builtIn {
+			continue
+		}
+		plan, found := catalog.PlanByID(id)
+		if !found {
+			problems = append(problems, fmt.Sprintf("plan %s (%s) used by the existing instances cannot be removed", id, PlanNameByID(id)))
+			continue
+		}
+		if currentPlan, found := current.PlanByID(id); found && currentPlan.BasePlan != plan.BasePlan {
+			problems = append(problems, fmt.Sprintf("base plan of the plan %s (%s) used by the existing instances cannot be changed", id, plan.Name))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid plan catalog: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
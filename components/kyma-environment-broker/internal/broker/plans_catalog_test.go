@@ -0,0 +1,135 @@
+package broker_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const customAzurePlanID = "6a0c4ff8-43e2-4d4f-9c36-3c6c0d2f4d21"
+
+func TestPlanCatalog(t *testing.T) {
+	// given
+	catalog := config.DefaultPlanCatalog()
+	catalog.Plans = append(catalog.Plans, internal.CatalogPlan{
+		ID:           customAzurePlanID,
+		Name:         "azure_small",
+		BasePlan:     broker.AzurePlanName,
+		Regions:      []string{"westeurope"},
+		MachineTypes: []internal.CatalogMachineType{{Name: "Standard_D2_v3", DisplayName: "Standard_D2_v3 (2vCPU, 8GB RAM)"}},
+		AutoScaler:   internal.CatalogAutoScaler{Max: 3, Maximum: 5},
+	})
+	require.NoError(t, broker.SetPlanCatalog(catalog))
+	t.Cleanup(func() {
+		require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+	})
+
+	t.Run("should resolve the plan by name and ID", func(t *testing.T) {
+		id, found := broker.PlanIDByName("azure_small")
+		assert.True(t, found)
+		assert.Equal(t, customAzurePlanID, id)
+		assert.Equal(t, "azure_small", broker.PlanNameByID(customAzurePlanID))
+		assert.Equal(t, broker.AzurePlanID, broker.BasePlanID(customAzurePlanID))
+		assert.Equal(t, broker.AWSPlanID, broker.BasePlanID(broker.AWSPlanID))
+		assert.True(t, broker.IsAzurePlan(customAzurePlanID))
+		assert.False(t, broker.IsTrialPlan(customAzurePlanID))
+	})
+
+	t.Run("should offer the enabled plan from the catalog", func(t *testing.T) {
+		// given
+		cfg := broker.Config{EnablePlans: []string{"azure", "azure_small"}}
+		require.NoError(t, cfg.EnablePlans.Validate())
+		servicesEndpoint := broker.NewServices(cfg, map[string]broker.Service{broker.KymaServiceName: {}}, logrus.StandardLogger())
+
+		// when
+		services, err := servicesEndpoint.Services(context.TODO())
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, services, 1)
+		require.Len(t, services[0].Plans, 2)
+		for _, plan := range services[0].Plans {
+			if plan.ID != customAzurePlanID {
+				continue
+			}
+			properties := plan.Schemas.Instance.Create.Parameters["properties"].(map[string]interface{})
+			assert.Equal(t, []interface{}{"westeurope"}, properties["region"].(map[string]interface{})["enum"])
+			assert.Equal(t, []interface{}{"Standard_D2_v3"}, properties["machineType"].(map[string]interface{})["enum"])
+			assert.EqualValues(t, 3, properties["autoScalerMax"].(map[string]interface{})["default"])
+			assert.EqualValues(t, 5, properties["autoScalerMax"].(map[string]interface{})["maximum"])
+		}
+	})
+
+	t.Run("should stop offering the plan removed from the catalog", func(t *testing.T) {
+		// given
+		cfg := broker.Config{EnablePlans: []string{"azure", "azure_small"}}
+		require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+
+		// then
+		assert.Error(t, cfg.EnablePlans.Validate())
+		assert.False(t, cfg.EnablePlans.ContainsPlanID(customAzurePlanID))
+		assert.True(t, cfg.EnablePlans.ContainsPlanID(broker.AzurePlanID))
+	})
+}
+
+func TestPlanCatalogUsageValidator(t *testing.T) {
+	// given
+	customPlan := internal.CatalogPlan{ID: customAzurePlanID, Name: "azure_small", BasePlan: broker.AzurePlanName}
+	catalog := config.DefaultPlanCatalog()
+	catalog.Plans = append(catalog.Plans, customPlan)
+	require.NoError(t, broker.SetPlanCatalog(catalog))
+	t.Cleanup(func() {
+		require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+	})
+
+	instances := memory.NewInstance(memory.NewOperation())
+	require.NoError(t, instances.Insert(internal.Instance{InstanceID: "instance-1", ServicePlanID: customAzurePlanID}))
+	require.NoError(t, instances.Insert(internal.Instance{InstanceID: "instance-2", ServicePlanID: broker.TrialPlanID}))
+	validator := broker.NewPlanCatalogUsageValidator(acceptingPlanCatalogValidator{}, instances)
+
+	t.Run("should accept the catalog with the plans of the existing instances", func(t *testing.T) {
+		assert.NoError(t, validator.Validate(catalog))
+	})
+
+	t.Run("should accept the catalog without the built-in plan of the existing instances", func(t *testing.T) {
+		// given
+		withoutTrial := internal.PlanCatalog{Version: catalog.Version}
+		for _, plan := range catalog.Plans {
+			if plan.ID != broker.TrialPlanID {
+				withoutTrial.Plans = append(withoutTrial.Plans, plan)
+			}
+		}
+
+		// then
+		assert.NoError(t, validator.Validate(withoutTrial))
+	})
+
+	t.Run("should reject the catalog without the custom plan of the existing instances", func(t *testing.T) {
+		// then
+		assert.ErrorContains(t, validator.Validate(config.DefaultPlanCatalog()), "used by the existing instances cannot be removed")
+	})
+
+	t.Run("should reject the catalog changing the base plan of the existing instances", func(t *testing.T) {
+		// given
+		rebased := config.DefaultPlanCatalog()
+		rebasedPlan := customPlan
+		rebasedPlan.BasePlan = broker.AzureLitePlanName
+		rebased.Plans = append(rebased.Plans, rebasedPlan)
+
+		// then
+		assert.ErrorContains(t, validator.Validate(rebased), "used by the existing instances cannot be changed")
+	})
+}
+
+type acceptingPlanCatalogValidator struct{}
+
+func (acceptingPlanCatalogValidator) Validate(internal.PlanCatalog) error {
+	return nil
+}
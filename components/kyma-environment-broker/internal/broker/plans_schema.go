@@ -1,6 +1,12 @@
 package broker
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
+)
 
 type RootSchema struct {
 	Schema string `json:"$schema"`
@@ -17,19 +23,24 @@ type RootSchema struct {
 type ProvisioningProperties struct {
 	UpdateProperties
 
-	Name        NameType `json:"name"`
-	ShootName   *Type    `json:"shootName,omitempty"`
-	ShootDomain *Type    `json:"shootDomain,omitempty"`
-	Region      *Type    `json:"region,omitempty"`
-	MachineType *Type    `json:"machineType,omitempty"`
+	Name         NameType          `json:"name"`
+	ShootName    *Type             `json:"shootName,omitempty"`
+	ShootDomain  *Type             `json:"shootDomain,omitempty"`
+	Region       *Type             `json:"region,omitempty"`
+	Networking   *NetworkingType   `json:"networking,omitempty"`
+	CustomDomain *CustomDomainType `json:"customDomain,omitempty"`
 }
 
 type UpdateProperties struct {
-	Kubeconfig     *Type     `json:"kubeconfig,omitempty"`
-	AutoScalerMin  *Type     `json:"autoScalerMin,omitempty"`
-	AutoScalerMax  *Type     `json:"autoScalerMax,omitempty"`
-	OIDC           *OIDCType `json:"oidc,omitempty"`
-	Administrators *Type     `json:"administrators,omitempty"`
+	Kubeconfig                *Type                     `json:"kubeconfig,omitempty"`
+	MachineType               *Type                     `json:"machineType,omitempty"`
+	VolumeSizeGb              *Type                     `json:"volumeSizeGb,omitempty"`
+	AutoScalerMin             *Type                     `json:"autoScalerMin,omitempty"`
+	AutoScalerMax             *Type                     `json:"autoScalerMax,omitempty"`
+	OIDC                      *OIDCType                 `json:"oidc,omitempty"`
+	Administrators            *Type                     `json:"administrators,omitempty"`
+	AdditionalWorkerNodePools *WorkerNodePoolsType      `json:"additionalWorkerNodePools,omitempty"`
+	HibernationSchedules      *HibernationSchedulesType `json:"hibernationSchedules,omitempty"`
 }
 
 func (up *UpdateProperties) IncludeAdditional() {
@@ -52,6 +63,88 @@ type OIDCType struct {
 	Required   []string       `json:"required"`
 }
 
+type NetworkingProperties struct {
+	Nodes    Type `json:"nodes"`
+	Pods     Type `json:"pods"`
+	Services Type `json:"services"`
+}
+
+type NetworkingType struct {
+	Type
+	Properties NetworkingProperties `json:"properties"`
+	Required   []string             `json:"required"`
+}
+
+type CustomDomainProperties struct {
+	Domain            Type                  `json:"domain"`
+	DNSProvider       DNSProviderType       `json:"dnsProvider"`
+	CertificateIssuer CertificateIssuerType `json:"certificateIssuer"`
+}
+
+type CustomDomainType struct {
+	Type
+	Properties CustomDomainProperties `json:"properties"`
+	Required   []string               `json:"required"`
+}
+
+type DNSProviderProperties struct {
+	Type       Type `json:"type"`
+	SecretName Type `json:"secretName"`
+}
+
+type DNSProviderType struct {
+	Type
+	Properties DNSProviderProperties `json:"properties"`
+	Required   []string              `json:"required"`
+}
+
+type CertificateIssuerProperties struct {
+	Email                Type `json:"email"`
+	Server               Type `json:"server"`
+	PrivateKeySecretName Type `json:"privateKeySecretName"`
+}
+
+type CertificateIssuerType struct {
+	Type
+	Properties CertificateIssuerProperties `json:"properties"`
+	Required   []string                    `json:"required"`
+}
+
+type HibernationScheduleProperties struct {
+	Start    Type `json:"start"`
+	End      Type `json:"end"`
+	Location Type `json:"location"`
+}
+
+type HibernationScheduleType struct {
+	Type
+	Properties HibernationScheduleProperties `json:"properties"`
+}
+
+type HibernationSchedulesType struct {
+	Type
+	Items HibernationScheduleType `json:"items"`
+}
+
+type WorkerNodePoolProperties struct {
+	Name          Type  `json:"name"`
+	MachineType   Type  `json:"machineType"`
+	VolumeSizeGb  *Type `json:"volumeSizeGb,omitempty"`
+	AutoScalerMin Type  `json:"autoScalerMin"`
+	AutoScalerMax Type  `json:"autoScalerMax"`
+}
+
+type WorkerNodePoolType struct {
+	Type
+	Properties WorkerNodePoolProperties `json:"properties"`
+	Required   []string                 `json:"required"`
+}
+
+type WorkerNodePoolsType struct {
+	Type
+	Items WorkerNodePoolType `json:"items"`
+}
+
 type Type struct {
 	Type        string `json:"type"`
 	Title       string `json:"title,omitempty"`
@@ -60,6 +153,7 @@ type Type struct {
 	Maximum     int    `json:"maximum,omitempty"`
 	MinLength   int    `json:"minLength,omitempty"`
 	MaxLength   int    `json:"maxLength,omitempty"`
+	MaxItems    int    `json:"maxItems,omitempty"`
 
 	// Regex pattern to match against string type of fields.
 	// If not specified for strings user can pass empty string with whitespaces only.
@@ -98,6 +192,14 @@ func NameProperty() NameType {
 	}
 }
 
+func VolumeSizeGbProperty() *Type {
+	return &Type{
+		Type:        "integer",
+		Minimum:     1,
+		Description: "Specifies the size of the worker node disk in GB. The size cannot be decreased",
+	}
+}
+
 func KubeconfigProperty() *Type {
 	return &Type{
 		Type:  "string",
@@ -129,6 +231,11 @@ func NewProvisioningProperties(machineTypesDisplay map[string]string, machineTyp
 
 	properties := ProvisioningProperties{
 		UpdateProperties: UpdateProperties{
+			MachineType: &Type{
+				Type:            "string",
+				Enum:            ToInterfaceSlice(machineTypes),
+				EnumDisplayName: machineTypesDisplay,
+			},
 			AutoScalerMin: &Type{
 				Type:        "integer",
 				Minimum:     2,
@@ -148,11 +255,6 @@ func NewProvisioningProperties(machineTypesDisplay map[string]string, machineTyp
 			Type: "string",
 			Enum: ToInterfaceSlice(regions),
 		},
-		MachineType: &Type{
-			Type:            "string",
-			Enum:            ToInterfaceSlice(machineTypes),
-			EnumDisplayName: machineTypesDisplay,
-		},
 	}
 
 	if update {
@@ -184,6 +286,165 @@ func NewOIDCSchema() *OIDCType {
 	}
 }
 
+// NewNetworkingSchema creates the schema of the networking of the cluster, the pods and the services CIDRs
+// are defaulted by Gardener
+func NewNetworkingSchema() *NetworkingType {
+	return &NetworkingType{
+		Type: Type{Type: "object", Description: "Networking configuration. These values are immutable and cannot be updated later."},
+		Properties: NetworkingProperties{
+			Nodes: Type{
+				Type:        "string",
+				Title:       "Node network's CIDR",
+				Description: fmt.Sprintf("Node network's CIDR, must not overlap with the pods and the services CIDRs, the prefix length must not be greater than %d", networking.MaxNodesPrefixLength),
+				Default:     networking.DefaultNodesCIDR,
+			},
+			Pods: Type{
+				Type:        "string",
+				Title:       "Pod network's CIDR",
+				Description: fmt.Sprintf("Pod network's CIDR, the prefix length must not be greater than %d", networking.MaxPodsPrefixLength),
+				Default:     networking.DefaultPodsCIDR,
+			},
+			Services: Type{
+				Type:        "string",
+				Title:       "Service network's CIDR",
+				Description: fmt.Sprintf("Service network's CIDR, the prefix length must not be greater than %d", networking.MaxServicesPrefixLength),
+				Default:     networking.DefaultServicesCIDR,
+			},
+		},
+		Required: []string{"nodes"},
+	}
+}
+
+// NewCustomDomainSchema creates the schema of the domain of the customer, the DNS provider and the ACME account
+// secrets must be created in the Gardener project before the provisioning
+func NewCustomDomainSchema() *CustomDomainType {
+	return &CustomDomainType{
+		Type: Type{Type: "object", Description: "Custom domain of the cluster. These values are immutable and cannot be updated later."},
+		Properties: CustomDomainProperties{
+			Domain: Type{
+				Type:        "string",
+				Title:       "Domain",
+				Description: "Domain of the cluster owned by the customer, for example kyma.example.com",
+				Pattern:     customDomainRegexp.String(),
+				MaxLength:   customDomainMaxLength,
+			},
+			DNSProvider: DNSProviderType{
+				Type: Type{Type: "object", Description: "DNS provider managing the records of the domain"},
+				Properties: DNSProviderProperties{
+					Type: Type{
+						Type:        "string",
+						Description: "Type of the DNS provider, for example aws-route53",
+					},
+					SecretName: Type{
+						Type:        "string",
+						Description: "Name of the secret with the credentials of the DNS provider in the Gardener project. The name must start with the subaccount ID followed by a dash",
+					},
+				},
+				Required: []string{"type", "secretName"},
+			},
+			CertificateIssuer: CertificateIssuerType{
+				Type: Type{Type: "object", Description: "ACME account issuing the certificates of the domain"},
+				Properties: CertificateIssuerProperties{
+					Email: Type{
+						Type:        "string",
+						Description: "Email of the ACME account",
+					},
+					Server: Type{
+						Type:        "string",
+						Description: "URL of the ACME server",
+						Default:     internal.DefaultCertificateIssuerServer,
+					},
+					PrivateKeySecretName: Type{
+						Type:        "string",
+						Description: "Name of the secret with the private key of the existing ACME account in the Gardener project. The name must start with the subaccount ID followed by a dash. If not provided, a new account is registered",
+					},
+				},
+				Required: []string{"email"},
+			},
+		},
+		Required: []string{"domain", "dnsProvider", "certificateIssuer"},
+	}
+}
+
+// NewHibernationSchedulesSchema creates the schema of the windows in which the cluster is hibernated
+func NewHibernationSchedulesSchema() *HibernationSchedulesType {
+	return &HibernationSchedulesType{
+		Type: Type{
+			Type:        "array",
+			Title:       "Hibernation schedules",
+			Description: "Specifies when the cluster is hibernated and woken up",
+			MaxItems:    maxHibernationSchedules,
+		},
+		Items: HibernationScheduleType{
+			Type: Type{Type: "object"},
+			Properties: HibernationScheduleProperties{
+				Start: Type{
+					Type:        "string",
+					Title:       "Start",
+					Description: "Cron expression of the hibernation start, for example 00 20 * * 1,2,3,4,5",
+				},
+				End: Type{
+					Type:        "string",
+					Title:       "End",
+					Description: "Cron expression of the wake up, for example 00 08 * * 1,2,3,4,5",
+				},
+				Location: Type{
+					Type:        "string",
+					Title:       "Location",
+					Description: "Time zone of the cron expressions, for example Europe/Berlin",
+					Default:     "UTC",
+				},
+			},
+		},
+	}
+}
+
+// NewWorkerNodePoolsSchema creates the schema of the worker node pools created next to the main worker node pool
+func NewWorkerNodePoolsSchema(machineTypesDisplay map[string]string, machineTypes []string, autoScalerMaximum int, volumeSize bool) *WorkerNodePoolsType {
+	schema := &WorkerNodePoolsType{
+		Type: Type{
+			Type:        "array",
+			Title:       "Additional worker node pools",
+			Description: "Specifies the worker node pools created next to the main worker node pool",
+		},
+		Items: WorkerNodePoolType{
+			Type: Type{Type: "object"},
+			Properties: WorkerNodePoolProperties{
+				Name: Type{
+					Type:        "string",
+					Description: "The name of the worker node pool, unique in the cluster",
+					Pattern:     workerNodePoolNamePattern,
+				},
+				MachineType: Type{
+					Type:            "string",
+					Enum:            ToInterfaceSlice(machineTypes),
+					EnumDisplayName: machineTypesDisplay,
+				},
+				AutoScalerMin: Type{
+					Type:        "integer",
+					Description: "Specifies the minimum number of virtual machines to create",
+				},
+				AutoScalerMax: Type{
+					Type:        "integer",
+					Minimum:     1,
+					Maximum:     autoScalerMaximum,
+					Description: "Specifies the maximum number of virtual machines to create",
+				},
+			},
+			Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+		},
+	}
+	if volumeSize {
+		schema.Items.Properties.VolumeSizeGb = &Type{
+			Type:        "integer",
+			Minimum:     1,
+			Description: "Specifies the size of the disk in GB. If not provided, the size of the main worker node pool disk is used",
+		}
+	}
+
+	return schema
+}
+
 func NewSchemaWithOnlyNameRequired(properties interface{}, update bool) *RootSchema {
 	return NewSchemaForOwnCluster(properties, update, []string{"name"})
 }
@@ -218,7 +479,7 @@ func unmarshalOrPanic(from, to interface{}) interface{} {
 }
 
 func DefaultControlsOrder() []string {
-	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "autoScalerMin", "autoScalerMax", "zonesCount", "oidc", "administrators"}
+	return []string{"name", "kubeconfig", "shootName", "shootDomain", "region", "machineType", "volumeSizeGb", "autoScalerMin", "autoScalerMax", "zonesCount", "networking", "customDomain", "oidc", "administrators", "additionalWorkerNodePools", "hibernationSchedules"}
 }
 
 func ToInterfaceSlice(input []string) []interface{} {
@@ -20,22 +20,13 @@ type ServicesEndpoint struct {
 	log            logrus.FieldLogger
 	cfg            Config
 	servicesConfig ServicesConfig
-
-	enabledPlanIDs map[string]struct{}
 }
 
 func NewServices(cfg Config, servicesConfig ServicesConfig, log logrus.FieldLogger) *ServicesEndpoint {
-	enabledPlanIDs := map[string]struct{}{}
-	for _, planName := range cfg.EnablePlans {
-		id := PlanIDsMapping[planName]
-		enabledPlanIDs[id] = struct{}{}
-	}
-
 	return &ServicesEndpoint{
 		log:            log.WithField("service", "ServicesEndpoint"),
 		cfg:            cfg,
 		servicesConfig: servicesConfig,
-		enabledPlanIDs: enabledPlanIDs,
 	}
 }
 
@@ -53,7 +44,7 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 	provider, ok := middleware.ProviderFromContext(ctx)
 	for _, plan := range Plans(class.Plans, provider, b.cfg.IncludeAdditionalParamsInSchema) {
 		// filter out not enabled plans
-		if _, exists := b.enabledPlanIDs[plan.ID]; !exists {
+		if !b.cfg.EnablePlans.ContainsPlanID(plan.ID) {
 			continue
 		}
 		// p := plan.PlanDefinition
@@ -66,7 +57,7 @@ func (b *ServicesEndpoint) Services(ctx context.Context) ([]domain.Service, erro
 			ID:                   KymaServiceID,
 			Name:                 KymaServiceName,
 			Description:          class.Description,
-			Bindable:             false,
+			Bindable:             b.cfg.Binding.Enabled,
 			InstancesRetrievable: true,
 			Tags: []string{
 				"SAP",
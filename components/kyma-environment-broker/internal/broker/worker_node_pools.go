@@ -0,0 +1,71 @@
+package broker
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+const (
+	// mainWorkerNodePoolName is the name of the worker node pool created by the provisioner from the machine type
+	// and the autoscaler parameters
+	mainWorkerNodePoolName = "cpu-worker-0"
+
+	// Gardener limits the worker pool name to 15 characters
+	workerNodePoolNamePattern = "^[a-z0-9]([-a-z0-9]{0,13}[a-z0-9])?$"
+)
+
+var workerNodePoolNameRegexp = regexp.MustCompile(workerNodePoolNamePattern)
+
+// SupportsAdditionalWorkerNodePools checks if the runtimes of the plan can have additional worker node pools
+func SupportsAdditionalWorkerNodePools(planID string) bool {
+	switch BasePlanID(planID) {
+	case AWSPlanID, AzurePlanID, GCPPlanID, OpenStackPlanID, PreviewPlanID:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateAdditionalWorkerNodePools checks the worker node pools against the machine types and the autoscaler limits
+// of the plan, the machine types offered in the provisioning can be limited by the catalog
+func validateAdditionalWorkerNodePools(planID string, pools []internal.WorkerNodePoolDTO, provisioning bool) error {
+	if len(pools) == 0 {
+		return nil
+	}
+	if !SupportsAdditionalWorkerNodePools(planID) {
+		return fmt.Errorf("additional worker node pools are not supported by the plan %s", PlanNameByID(planID))
+	}
+
+	plan := catalogPlan(planID)
+	machineTypes := make(map[string]struct{})
+	for _, name := range plan.MachineTypeNames(provisioning) {
+		machineTypes[name] = struct{}{}
+	}
+
+	names := map[string]struct{}{mainWorkerNodePoolName: {}}
+	for _, pool := range pools {
+		if !workerNodePoolNameRegexp.MatchString(pool.Name) {
+			return fmt.Errorf("invalid worker node pool name %q, the name must consist of up to 15 lower case alphanumeric characters or '-'", pool.Name)
+		}
+		if _, found := names[pool.Name]; found {
+			return fmt.Errorf("worker node pool name %q is not unique", pool.Name)
+		}
+		names[pool.Name] = struct{}{}
+		if _, found := machineTypes[pool.MachineType]; !found {
+			return fmt.Errorf("machine type %q of the worker node pool %q is not supported by the plan", pool.MachineType, pool.Name)
+		}
+		if pool.AutoScalerMin < 0 || pool.AutoScalerMax < 1 || pool.AutoScalerMin > pool.AutoScalerMax {
+			return fmt.Errorf("invalid autoscaler range %d-%d of the worker node pool %q", pool.AutoScalerMin, pool.AutoScalerMax, pool.Name)
+		}
+		if plan.AutoScaler.Maximum > 0 && pool.AutoScalerMax > plan.AutoScaler.Maximum {
+			return fmt.Errorf("autoScalerMax %d of the worker node pool %q exceeds the plan maximum %d", pool.AutoScalerMax, pool.Name, plan.AutoScaler.Maximum)
+		}
+		if pool.VolumeSizeGb != nil && BasePlanID(planID) == OpenStackPlanID {
+			return fmt.Errorf("volumeSizeGb of the worker node pool %q is not supported by the plan", pool.Name)
+		}
+	}
+
+	return nil
+}
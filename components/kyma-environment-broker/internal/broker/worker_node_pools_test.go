@@ -0,0 +1,87 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAdditionalWorkerNodePools(t *testing.T) {
+	for name, tc := range map[string]struct {
+		planID       string
+		pools        []internal.WorkerNodePoolDTO
+		provisioning bool
+		err          string
+	}{
+		"valid pools": {
+			planID: AWSPlanID,
+			pools: []internal.WorkerNodePoolDTO{
+				{Name: "worker-1", MachineType: "m5.2xlarge", VolumeSizeGb: ptr.Integer(80), AutoScalerMin: 1, AutoScalerMax: 3},
+				{Name: "worker-2", MachineType: "m5.xlarge", AutoScalerMin: 0, AutoScalerMax: 1},
+			},
+			provisioning: true,
+		},
+		"machine type offered only in the update": {
+			planID: AWSPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "m6i.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+		},
+		"not supported plan": {
+			planID: TrialPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			err:    "not supported by the plan trial",
+		},
+		"invalid name": {
+			planID: AWSPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "Worker_1", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			err:    `invalid worker node pool name "Worker_1"`,
+		},
+		"main pool name": {
+			planID: AWSPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "cpu-worker-0", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			err:    `worker node pool name "cpu-worker-0" is not unique`,
+		},
+		"duplicated name": {
+			planID: AWSPlanID,
+			pools: []internal.WorkerNodePoolDTO{
+				{Name: "worker-1", MachineType: "m5.xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+				{Name: "worker-1", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+			},
+			err: `worker node pool name "worker-1" is not unique`,
+		},
+		"machine type not offered in the provisioning": {
+			planID:       AWSPlanID,
+			pools:        []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "m6i.xlarge", AutoScalerMin: 1, AutoScalerMax: 3}},
+			provisioning: true,
+			err:          `machine type "m6i.xlarge" of the worker node pool "worker-1" is not supported`,
+		},
+		"invalid autoscaler range": {
+			planID: AWSPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "m5.xlarge", AutoScalerMin: 4, AutoScalerMax: 3}},
+			err:    "invalid autoscaler range 4-3",
+		},
+		"autoscaler maximum exceeded": {
+			planID: OpenStackPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "g_c4_m16", AutoScalerMin: 1, AutoScalerMax: 41}},
+			err:    "exceeds the plan maximum 40",
+		},
+		"volume size on openstack": {
+			planID: OpenStackPlanID,
+			pools:  []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "g_c4_m16", VolumeSizeGb: ptr.Integer(80), AutoScalerMin: 1, AutoScalerMax: 3}},
+			err:    `volumeSizeGb of the worker node pool "worker-1" is not supported`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := validateAdditionalWorkerNodePools(tc.planID, tc.pools, tc.provisioning)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package config
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	coreV1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	planCatalogKey              = "catalog.yaml"
+	supportedPlanCatalogVersion = 1
+)
+
+//go:embed plan_catalog_default.yaml
+var defaultPlanCatalog string
+
+type PlanCatalogConfig struct {
+	ConfigMapName  string        `envconfig:"default=keb-plan-catalog"`
+	ReloadInterval time.Duration `envconfig:"default=1m"`
+}
+
+type (
+	PlanCatalogReader interface {
+		Read() (string, error)
+	}
+
+	PlanCatalogValidator interface {
+		Validate(catalog internal.PlanCatalog) error
+	}
+
+	PlanCatalogConverter interface {
+		ConvertToStruct(cfgString string) (internal.PlanCatalog, error)
+	}
+)
+
+// DefaultPlanCatalog returns the built-in catalog of the service plans
+func DefaultPlanCatalog() internal.PlanCatalog {
+	catalog, err := NewPlanCatalogYAMLConverter().ConvertToStruct(defaultPlanCatalog)
+	if err != nil {
+		panic(fmt.Sprintf("while converting built-in plan catalog: %s", err))
+	}
+	return catalog
+}
+
+type PlanCatalogProvider struct {
+	Reader    PlanCatalogReader
+	Validator PlanCatalogValidator
+	Converter PlanCatalogConverter
+}
+
+func NewPlanCatalogProvider(reader PlanCatalogReader, validator PlanCatalogValidator, converter PlanCatalogConverter) *PlanCatalogProvider {
+	return &PlanCatalogProvider{Reader: reader, Validator: validator, Converter: converter}
+}
+
+func (p *PlanCatalogProvider) Provide() (*internal.PlanCatalog, error) {
+	cfgString, err := p.Reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	return p.convertAndValidate(cfgString)
+}
+
+// Watch reads the catalog every interval and calls onChange with the catalog whenever its content changes.
+// An invalid catalog is reported and skipped, so the previously provided catalog stays in use.
+func (p *PlanCatalogProvider) Watch(ctx context.Context, interval time.Duration, onChange func(internal.PlanCatalog) error, log logrus.FieldLogger) {
+	last, err := p.Reader.Read()
+	if err != nil {
+		log.Errorf("while reading plan catalog: %s", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cfgString, err := p.Reader.Read()
+		if err != nil {
+			log.Errorf("while reading plan catalog: %s", err)
+			continue
+		}
+		if cfgString == last {
+			continue
+		}
+		last = cfgString
+
+		catalog, err := p.convertAndValidate(cfgString)
+		if err != nil {
+			log.Errorf("the changed plan catalog is invalid, keeping the previous one: %s", err)
+			continue
+		}
+		if err := onChange(*catalog); err != nil {
+			log.Errorf("while applying the changed plan catalog: %s", err)
+			continue
+		}
+		log.Infof("plan catalog version %d with %d plans reloaded", catalog.Version, len(catalog.Plans))
+	}
+}
+
+func (p *PlanCatalogProvider) convertAndValidate(cfgString string) (*internal.PlanCatalog, error) {
+	catalog, err := p.Converter.ConvertToStruct(cfgString)
+	if err != nil {
+		return nil, fmt.Errorf("while converting plan catalog: %w", err)
+	}
+	if err = p.Validator.Validate(catalog); err != nil {
+		return nil, fmt.Errorf("while validating plan catalog: %w", err)
+	}
+	return &catalog, nil
+}
+
+// PlanCatalogConfigMapReader reads the plan catalog from the ConfigMap, the built-in catalog is returned
+// if the ConfigMap does not exist
+type PlanCatalogConfigMapReader struct {
+	ctx       context.Context
+	k8sClient client.Client
+	name      string
+	logger    logrus.FieldLogger
+}
+
+func NewPlanCatalogConfigMapReader(ctx context.Context, k8sClient client.Client, name string, logger logrus.FieldLogger) *PlanCatalogConfigMapReader {
+	return &PlanCatalogConfigMapReader{
+		ctx:       ctx,
+		k8sClient: k8sClient,
+		name:      name,
+		logger:    logger,
+	}
+}
+
+func (r *PlanCatalogConfigMapReader) Read() (string, error) {
+	cfgMap := &coreV1.ConfigMap{}
+	err := r.k8sClient.Get(r.ctx, client.ObjectKey{Namespace: namespace, Name: r.name}, cfgMap)
+	switch {
+	case apierrors.IsNotFound(err):
+		r.logger.Debugf("plan catalog configmap %s does not exist. Using the built-in catalog", r.name)
+		return defaultPlanCatalog, nil
+	case err != nil:
+		return "", fmt.Errorf("while fetching plan catalog configmap %s: %w", r.name, err)
+	}
+
+	cfgString, exists := cfgMap.Data[planCatalogKey]
+	if !exists {
+		return "", fmt.Errorf("plan catalog configmap %s does not contain the %s key", r.name, planCatalogKey)
+	}
+	return cfgString, nil
+}
+
+// PlanCatalogFileReader reads the plan catalog from the file, for example the ConfigMap mounted in the job,
+// the built-in catalog is returned if the file does not exist
+type PlanCatalogFileReader struct {
+	path string
+}
+
+func NewPlanCatalogFileReader(path string) *PlanCatalogFileReader {
+	return &PlanCatalogFileReader{path: path}
+}
+
+func (r *PlanCatalogFileReader) Read() (string, error) {
+	content, err := os.ReadFile(r.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return defaultPlanCatalog, nil
+	case err != nil:
+		return "", fmt.Errorf("while reading plan catalog file %s: %w", r.path, err)
+	}
+	return string(content), nil
+}
+
+type PlanCatalogYAMLConverter struct{}
+
+func NewPlanCatalogYAMLConverter() *PlanCatalogYAMLConverter {
+	return &PlanCatalogYAMLConverter{}
+}
+
+func (c *PlanCatalogYAMLConverter) ConvertToStruct(cfgString string) (internal.PlanCatalog, error) {
+	var catalog internal.PlanCatalog
+	if err := yaml.Unmarshal([]byte(cfgString), &catalog); err != nil {
+		return internal.PlanCatalog{}, err
+	}
+	return catalog, nil
+}
+
+// PlanCatalogConsistencyValidator checks if the catalog is complete and consistent. The plans without the base plan must be
+// the built-in ones, their IDs and names cannot be changed, because the provisioning logic depends on them.
+type PlanCatalogConsistencyValidator struct {
+	builtIn internal.PlanCatalog
+}
+
+func NewPlanCatalogConsistencyValidator() *PlanCatalogConsistencyValidator {
+	return &PlanCatalogConsistencyValidator{builtIn: DefaultPlanCatalog()}
+}
+
+func (v *PlanCatalogConsistencyValidator) Validate(catalog internal.PlanCatalog) error {
+	if catalog.Version != supportedPlanCatalogVersion {
+		return fmt.Errorf("unsupported catalog version %d, supported version: %d", catalog.Version, supportedPlanCatalogVersion)
+	}
+	if len(catalog.Plans) == 0 {
+		return fmt.Errorf("catalog does not contain any plan")
+	}
+
+	var problems []string
+	ids := map[string]bool{}
+	names := map[string]bool{}
+	for i, plan := range catalog.Plans {
+		prefix := fmt.Sprintf("plans[%d] (%s)", i, plan.Name)
+		if plan.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: name is required", prefix))
+		}
+		if _, err := uuid.Parse(plan.ID); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: ID %q is not a valid UUID", prefix, plan.ID))
+		}
+		if ids[plan.ID] {
+			problems = append(problems, fmt.Sprintf("%s: duplicated ID %s", prefix, plan.ID))
+		}
+		if names[plan.Name] {
+			problems = append(problems, fmt.Sprintf("%s: duplicated name", prefix))
+		}
+		ids[plan.ID] = true
+		names[plan.Name] = true
+
+		problems = append(problems, v.validateBasePlan(prefix, plan)...)
+		problems = append(problems, validatePlanParameters(prefix, plan)...)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid plan catalog: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func (v *PlanCatalogConsistencyValidator) validateBasePlan(prefix string, plan internal.CatalogPlan) []string {
+	if plan.BasePlan == "" {
+		builtIn, ok := v.builtIn.PlanByID(plan.ID)
+		if !ok {
+			return []string{fmt.Sprintf("%s: plan is not built-in, the base plan is required", prefix)}
+		}
+		if builtIn.Name != plan.Name {
+			return []string{fmt.Sprintf("%s: the name of the built-in plan %s cannot be changed", prefix, builtIn.Name)}
+		}
+		return nil
+	}
+	if _, ok := v.builtIn.PlanByID(plan.ID); ok {
+		return []string{fmt.Sprintf("%s: built-in plan cannot have a base plan", prefix)}
+	}
+	if _, ok := v.builtIn.PlanByName(plan.BasePlan); !ok {
+		return []string{fmt.Sprintf("%s: unknown base plan %s", prefix, plan.BasePlan)}
+	}
+	return nil
+}
+
+func validatePlanParameters(prefix string, plan internal.CatalogPlan) []string {
+	var problems []string
+	machineTypes := map[string]bool{}
+	for _, machineType := range plan.MachineTypes {
+		if machineType.Name == "" {
+			problems = append(problems, fmt.Sprintf("%s: machine type name is required", prefix))
+		}
+		machineTypes[machineType.Name] = true
+	}
+	for _, name := range plan.ProvisioningMachineTypes {
+		if !machineTypes[name] {
+			problems = append(problems, fmt.Sprintf("%s: provisioning machine type %s is not in the machine types", prefix, name))
+		}
+	}
+	if len(plan.MachineTypes) > 0 && len(plan.Regions) == 0 {
+		problems = append(problems, fmt.Sprintf("%s: regions are required for the plan with machine types", prefix))
+	}
+
+	as := plan.AutoScaler
+	if as.Min < 0 || as.Max < 0 || as.Maximum < 0 {
+		problems = append(problems, fmt.Sprintf("%s: autoscaler values cannot be negative", prefix))
+	}
+	if as.Min > 0 && as.Max > 0 && as.Min > as.Max {
+		problems = append(problems, fmt.Sprintf("%s: autoscaler min %d is greater than max %d", prefix, as.Min, as.Max))
+	}
+	if as.Max > 0 && as.Maximum > 0 && as.Max > as.Maximum {
+		problems = append(problems, fmt.Sprintf("%s: autoscaler max %d is greater than maximum %d", prefix, as.Max, as.Maximum))
+	}
+	return problems
+}
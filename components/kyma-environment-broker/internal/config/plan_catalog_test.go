@@ -0,0 +1,309 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coreV1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	planCatalogConfigMapName = "keb-plan-catalog"
+	customPlanID             = "0e4cd2a3-7d7f-4b2c-a8a2-6a8b3d0f2c11"
+
+	customPlanCatalog = `version: 1
+plans:
+  - id: 361c511f-f939-4621-b228-d0fb79a1fe15
+    name: aws
+    regions: [eu-central-1]
+    machineTypes:
+      - {name: m5.xlarge, displayName: "m5.xlarge (4vCPU, 16GB RAM)"}
+  - id: 0e4cd2a3-7d7f-4b2c-a8a2-6a8b3d0f2c11
+    name: aws_small
+    basePlan: aws
+    regions: [eu-west-2]
+    machineTypes:
+      - {name: m5.large}
+    autoScaler:
+      min: 1
+      max: 2
+      maximum: 3
+`
+)
+
+func TestDefaultPlanCatalog(t *testing.T) {
+	// when
+	catalog := config.DefaultPlanCatalog()
+
+	// then
+	require.NoError(t, config.NewPlanCatalogConsistencyValidator().Validate(catalog))
+	for id, name := range map[string]string{
+		broker.AWSPlanID:        broker.AWSPlanName,
+		broker.GCPPlanID:        broker.GCPPlanName,
+		broker.AzurePlanID:      broker.AzurePlanName,
+		broker.AzureLitePlanID:  broker.AzureLitePlanName,
+		broker.OpenStackPlanID:  broker.OpenStackPlanName,
+		broker.TrialPlanID:      broker.TrialPlanName,
+		broker.FreemiumPlanID:   broker.FreemiumPlanName,
+		broker.OwnClusterPlanID: broker.OwnClusterPlanName,
+		broker.PreviewPlanID:    broker.PreviewPlanName,
+	} {
+		plan, found := catalog.PlanByID(id)
+		require.True(t, found, "plan %s", name)
+		assert.Equal(t, name, plan.Name)
+	}
+}
+
+func TestPlanCatalogConfigMapReader(t *testing.T) {
+	// setup
+	ctx := context.TODO()
+	logger := logrus.New()
+
+	t.Run("should return the built-in catalog when the configmap does not exist", func(t *testing.T) {
+		// given
+		reader := config.NewPlanCatalogConfigMapReader(ctx, fake.NewClientBuilder().Build(), planCatalogConfigMapName, logger)
+
+		// when
+		cfgString, err := reader.Read()
+
+		// then
+		require.NoError(t, err)
+		catalog, err := config.NewPlanCatalogYAMLConverter().ConvertToStruct(cfgString)
+		require.NoError(t, err)
+		assert.Equal(t, config.DefaultPlanCatalog(), catalog)
+	})
+
+	t.Run("should read the catalog from the configmap", func(t *testing.T) {
+		// given
+		k8sClient := fake.NewClientBuilder().WithRuntimeObjects(fixPlanCatalogConfigMap(map[string]string{"catalog.yaml": customPlanCatalog})).Build()
+		reader := config.NewPlanCatalogConfigMapReader(ctx, k8sClient, planCatalogConfigMapName, logger)
+
+		// when
+		cfgString, err := reader.Read()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, customPlanCatalog, cfgString)
+	})
+
+	t.Run("should return error when the configmap does not contain the catalog", func(t *testing.T) {
+		// given
+		k8sClient := fake.NewClientBuilder().WithRuntimeObjects(fixPlanCatalogConfigMap(map[string]string{"other.yaml": ""})).Build()
+		reader := config.NewPlanCatalogConfigMapReader(ctx, k8sClient, planCatalogConfigMapName, logger)
+
+		// when
+		_, err := reader.Read()
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("should return error when the configmap cannot be fetched", func(t *testing.T) {
+		// given
+		reader := config.NewPlanCatalogConfigMapReader(ctx, failingK8sClient{}, planCatalogConfigMapName, logger)
+
+		// when
+		_, err := reader.Read()
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestPlanCatalogFileReader(t *testing.T) {
+	t.Run("should return the built-in catalog when the file does not exist", func(t *testing.T) {
+		// given
+		reader := config.NewPlanCatalogFileReader(filepath.Join(t.TempDir(), "catalog.yaml"))
+
+		// when
+		cfgString, err := reader.Read()
+
+		// then
+		require.NoError(t, err)
+		catalog, err := config.NewPlanCatalogYAMLConverter().ConvertToStruct(cfgString)
+		require.NoError(t, err)
+		assert.Equal(t, config.DefaultPlanCatalog(), catalog)
+	})
+
+	t.Run("should read the catalog from the file", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "catalog.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(customPlanCatalog), 0600))
+		reader := config.NewPlanCatalogFileReader(path)
+
+		// when
+		cfgString, err := reader.Read()
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, customPlanCatalog, cfgString)
+	})
+}
+
+func TestPlanCatalogConsistencyValidator(t *testing.T) {
+	validator := config.NewPlanCatalogConsistencyValidator()
+	converter := config.NewPlanCatalogYAMLConverter()
+
+	t.Run("should accept the catalog with a plan based on the built-in one", func(t *testing.T) {
+		// given
+		catalog, err := converter.ConvertToStruct(customPlanCatalog)
+		require.NoError(t, err)
+
+		// when
+		err = validator.Validate(catalog)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	for name, tc := range map[string]struct {
+		catalog internal.PlanCatalog
+		problem string
+	}{
+		"unsupported version": {
+			catalog: internal.PlanCatalog{Version: 2, Plans: []internal.CatalogPlan{{ID: broker.TrialPlanID, Name: broker.TrialPlanName}}},
+			problem: "unsupported catalog version 2",
+		},
+		"no plans": {
+			catalog: internal.PlanCatalog{Version: 1},
+			problem: "does not contain any plan",
+		},
+		"invalid ID": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: "abc", Name: "custom", BasePlan: broker.AWSPlanName}),
+			problem: `ID "abc" is not a valid UUID`,
+		},
+		"duplicated name": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: broker.TrialPlanName, BasePlan: broker.TrialPlanName}),
+			problem: "duplicated name",
+		},
+		"renamed built-in plan": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: broker.AWSPlanID, Name: "amazon"}),
+			problem: "the name of the built-in plan aws cannot be changed",
+		},
+		"custom plan without base plan": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom"}),
+			problem: "the base plan is required",
+		},
+		"built-in plan with base plan": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: broker.AWSPlanID, Name: broker.AWSPlanName, BasePlan: broker.GCPPlanName}),
+			problem: "built-in plan cannot have a base plan",
+		},
+		"unknown base plan": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom", BasePlan: "alicloud"}),
+			problem: "unknown base plan alicloud",
+		},
+		"provisioning machine type not allowed": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom", BasePlan: broker.AWSPlanName, Regions: []string{"eu-central-1"},
+				MachineTypes: []internal.CatalogMachineType{{Name: "m5.xlarge"}}, ProvisioningMachineTypes: []string{"m5.large"}}),
+			problem: "provisioning machine type m5.large is not in the machine types",
+		},
+		"machine types without regions": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom", BasePlan: broker.AWSPlanName,
+				MachineTypes: []internal.CatalogMachineType{{Name: "m5.xlarge"}}}),
+			problem: "regions are required",
+		},
+		"autoscaler min greater than max": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom", BasePlan: broker.AWSPlanName,
+				AutoScaler: internal.CatalogAutoScaler{Min: 5, Max: 3}}),
+			problem: "autoscaler min 5 is greater than max 3",
+		},
+		"autoscaler max greater than maximum": {
+			catalog: fixCatalog(internal.CatalogPlan{ID: customPlanID, Name: "custom", BasePlan: broker.AWSPlanName,
+				AutoScaler: internal.CatalogAutoScaler{Max: 50, Maximum: 40}}),
+			problem: "autoscaler max 50 is greater than maximum 40",
+		},
+	} {
+		t.Run("should reject the catalog with "+name, func(t *testing.T) {
+			// when
+			err := validator.Validate(tc.catalog)
+
+			// then
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.problem)
+		})
+	}
+}
+
+func TestPlanCatalogProviderWatch(t *testing.T) {
+	// given
+	reader := &fakePlanCatalogReader{cfgString: customPlanCatalog}
+	provider := config.NewPlanCatalogProvider(reader, config.NewPlanCatalogConsistencyValidator(), config.NewPlanCatalogYAMLConverter())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	catalog, err := provider.Provide()
+	require.NoError(t, err)
+	assert.Len(t, catalog.Plans, 2)
+
+	changes := make(chan internal.PlanCatalog, 10)
+	go provider.Watch(ctx, time.Millisecond, func(catalog internal.PlanCatalog) error {
+		changes <- catalog
+		return nil
+	}, logrus.New())
+
+	// when the catalog changes to an invalid one
+	reader.set("version: 2\nplans: []")
+	// and then to a valid one
+	time.Sleep(20 * time.Millisecond)
+	reader.set("version: 1\nplans:\n  - id: 7d55d31d-35ae-4438-bf13-6ffdfa107d9f\n    name: trial\n")
+
+	// then only the valid catalog is applied
+	select {
+	case changed := <-changes:
+		require.Len(t, changed.Plans, 1)
+		assert.Equal(t, broker.TrialPlanName, changed.Plans[0].Name)
+	case <-time.After(time.Second):
+		t.Fatal("the changed catalog was not applied")
+	}
+	assert.Empty(t, changes)
+}
+
+type fakePlanCatalogReader struct {
+	mu        sync.Mutex
+	cfgString string
+}
+
+func (r *fakePlanCatalogReader) set(cfgString string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cfgString = cfgString
+}
+
+func (r *fakePlanCatalogReader) Read() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cfgString, nil
+}
+
+func fixCatalog(plan internal.CatalogPlan) internal.PlanCatalog {
+	return internal.PlanCatalog{
+		Version: 1,
+		Plans:   []internal.CatalogPlan{{ID: broker.TrialPlanID, Name: broker.TrialPlanName}, plan},
+	}
+}
+
+func fixPlanCatalogConfigMap(data map[string]string) *coreV1.ConfigMap {
+	return &coreV1.ConfigMap{
+		TypeMeta: metaV1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      planCatalogConfigMapName,
+			Namespace: namespace,
+		},
+		Data: data,
+	}
+}
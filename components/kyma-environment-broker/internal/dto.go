@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"reflect"
 	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/hibernation"
 )
 
 const (
@@ -182,6 +184,78 @@ type ProvisioningParametersDTO struct {
 	ShootDomain string `json:"shootDomain,omitempty"`
 
 	OIDC *OIDCConfigDTO `json:"oidc,omitempty"`
+
+	AdditionalWorkerNodePools []WorkerNodePoolDTO `json:"additionalWorkerNodePools,omitempty"`
+
+	Networking *NetworkingDTO `json:"networking,omitempty"`
+
+	CustomDomain *CustomDomainDTO `json:"customDomain,omitempty"`
+
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules,omitempty"`
+}
+
+// HibernationScheduleDTO defines the window in which the runtime is hibernated, the start and the end are
+// standard cron expressions evaluated in the location time zone, UTC is used if the location is not provided
+type HibernationScheduleDTO struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+// HibernationSchedules converts the schedules to the schedules evaluated by the hibernation package
+func HibernationSchedules(dtos []HibernationScheduleDTO) []hibernation.Schedule {
+	schedules := make([]hibernation.Schedule, 0, len(dtos))
+	for _, dto := range dtos {
+		schedules = append(schedules, hibernation.Schedule{Start: dto.Start, End: dto.End, Location: dto.Location})
+	}
+	return schedules
+}
+
+const (
+	// CustomDomainIssuerName is the name of the certificate issuer created in the shoot for the custom domain
+	CustomDomainIssuerName = "custom-domain"
+	// DefaultCertificateIssuerServer is the ACME server used when the certificate issuer does not define it
+	DefaultCertificateIssuerServer = "https://acme-v02.api.letsencrypt.org/directory"
+)
+
+// CustomDomainDTO defines the domain of the runtime owned by the customer, the DNS records are managed
+// with the credentials of the DNS provider stored in the secret of the Gardener project, the names of the secrets
+// start with the subaccount ID
+type CustomDomainDTO struct {
+	Domain            string               `json:"domain"`
+	DNSProvider       DNSProviderDTO       `json:"dnsProvider"`
+	CertificateIssuer CertificateIssuerDTO `json:"certificateIssuer"`
+}
+
+type DNSProviderDTO struct {
+	Type       string `json:"type"`
+	SecretName string `json:"secretName"`
+}
+
+// CertificateIssuerDTO defines the ACME account used to issue the certificates of the custom domain,
+// a new account is registered if the private key secret is not provided
+type CertificateIssuerDTO struct {
+	Email                string  `json:"email"`
+	Server               *string `json:"server,omitempty"`
+	PrivateKeySecretName *string `json:"privateKeySecretName,omitempty"`
+}
+
+// NetworkingDTO defines the networks of the cluster, the subnets of the zones are created in the nodes CIDR,
+// Gardener defaults are used for the pods and the services CIDRs if not provided
+type NetworkingDTO struct {
+	NodesCidr    string  `json:"nodes"`
+	PodsCidr     *string `json:"pods,omitempty"`
+	ServicesCidr *string `json:"services,omitempty"`
+}
+
+// WorkerNodePoolDTO describes the worker node pool created next to the main worker node pool,
+// the pool uses the machine image, the disk type, and the zones of the main worker node pool
+type WorkerNodePoolDTO struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	VolumeSizeGb  *int   `json:"volumeSizeGb,omitempty"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
 }
 
 type UpdatingParametersDTO struct {
@@ -189,6 +263,14 @@ type UpdatingParametersDTO struct {
 
 	OIDC                  *OIDCConfigDTO `json:"oidc,omitempty"`
 	RuntimeAdministrators []string       `json:"administrators,omitempty"`
+	MachineType           *string        `json:"machineType,omitempty"`
+	VolumeSizeGb          *int           `json:"volumeSizeGb,omitempty"`
+	// AdditionalWorkerNodePools replaces the additional worker node pools if not nil,
+	// an empty list removes all additional worker node pools
+	AdditionalWorkerNodePools []WorkerNodePoolDTO `json:"additionalWorkerNodePools"`
+	// HibernationSchedules replaces the hibernation schedules if not nil,
+	// an empty list removes all hibernation schedules
+	HibernationSchedules []HibernationScheduleDTO `json:"hibernationSchedules"`
 
 	// Expired - means that the trial SKR is marked as expired
 	Expired bool `json:"expired"`
@@ -215,6 +297,20 @@ func (u UpdatingParametersDTO) UpdateAutoScaler(p *ProvisioningParametersDTO) bo
 	return updated
 }
 
+// UpdateMachine sets the machine type and the volume size of the main worker node pool
+func (u UpdatingParametersDTO) UpdateMachine(p *ProvisioningParametersDTO) bool {
+	updated := false
+	if u.MachineType != nil {
+		updated = true
+		p.MachineType = u.MachineType
+	}
+	if u.VolumeSizeGb != nil {
+		updated = true
+		p.VolumeSizeGb = u.VolumeSizeGb
+	}
+	return updated
+}
+
 type ERSContext struct {
 	TenantID              string                             `json:"tenant_id,omitempty"`
 	SubAccountID          string                             `json:"subaccount_id"`
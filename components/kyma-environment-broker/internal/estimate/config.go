@@ -0,0 +1,123 @@
+package estimate
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// MachineSpecsFilePath points to the JSON file with the CPU cores and the memory of the machine types,
+	// the file has the same format as the public cloud specs of Kyma Metrics Collector
+	MachineSpecsFilePath string `envconfig:"optional"`
+	// PricesFilePath points to the YAML file with the price table, the costs are not estimated if not set
+	PricesFilePath string `envconfig:"optional"`
+	// KMCURL is the URL of Kyma Metrics Collector which provides the live node counts of the runtimes,
+	// the current consumption of the runtimes is not estimated if not set
+	KMCURL     string        `envconfig:"optional"`
+	KMCTimeout time.Duration `envconfig:"default=10s"`
+	// DefaultHours is the period of the estimation if not specified in the request, a month by default
+	DefaultHours int `envconfig:"default=730"`
+}
+
+// MachineSpec describes the resources of a single machine
+type MachineSpec struct {
+	CpuCores int     `json:"cpu_cores"`
+	Memory   float64 `json:"memory"`
+}
+
+// MachineSpecs contains the machine types of every provider, keyed by the provider type (e.g. aws) and the
+// lower case name of the machine type
+type MachineSpecs map[string]map[string]MachineSpec
+
+// Get returns the spec of the machine type, the machine type is case insensitive
+func (s MachineSpecs) Get(provider, machineType string) (MachineSpec, bool) {
+	spec, found := s[provider][strings.ToLower(machineType)]
+	return spec, found
+}
+
+// PriceTable contains the hourly prices of the machines and the storage of every provider. The prices of
+// the region replace the prices of the provider.
+type PriceTable struct {
+	Currency  string                    `yaml:"currency"`
+	Providers map[string]ProviderPrices `yaml:"providers"`
+}
+
+type ProviderPrices struct {
+	Prices  `yaml:",inline"`
+	Regions map[string]Prices `yaml:"regions"`
+}
+
+type Prices struct {
+	// MachineTypes contains the price of a node hour of the machine type
+	MachineTypes map[string]float64 `yaml:"machineTypes"`
+	// StorageGbHour is the price of a gigabyte of the node volume for an hour
+	StorageGbHour *float64 `yaml:"storageGbHour"`
+}
+
+// MachinePrice returns the price of a node hour of the machine type in the region
+func (t PriceTable) MachinePrice(provider, region, machineType string) (float64, bool) {
+	prices := t.Providers[provider]
+	machineType = strings.ToLower(machineType)
+	if price, found := lowerKeys(prices.Regions[region].MachineTypes)[machineType]; found {
+		return price, true
+	}
+	price, found := lowerKeys(prices.MachineTypes)[machineType]
+	return price, found
+}
+
+// StoragePrice returns the price of a gigabyte of the node volume for an hour in the region
+func (t PriceTable) StoragePrice(provider, region string) (float64, bool) {
+	prices := t.Providers[provider]
+	if price := prices.Regions[region].StorageGbHour; price != nil {
+		return *price, true
+	}
+	if price := prices.StorageGbHour; price != nil {
+		return *price, true
+	}
+	return 0, false
+}
+
+func lowerKeys(prices map[string]float64) map[string]float64 {
+	result := make(map[string]float64, len(prices))
+	for key, price := range prices {
+		result[strings.ToLower(key)] = price
+	}
+	return result
+}
+
+func ReadMachineSpecsFromFile(path string) (MachineSpecs, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading JSON file with machine specs")
+	}
+	var specs MachineSpecs
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling JSON file with machine specs")
+	}
+	result := MachineSpecs{}
+	for provider, machines := range specs {
+		result[provider] = map[string]MachineSpec{}
+		for machineType, spec := range machines {
+			result[provider][strings.ToLower(machineType)] = spec
+		}
+	}
+	return result, nil
+}
+
+func ReadPriceTableFromFile(path string) (PriceTable, error) {
+	var table PriceTable
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return table, errors.Wrap(err, "while reading YAML file with price table")
+	}
+	if err := yaml.Unmarshal(data, &table); err != nil {
+		return table, errors.Wrap(err, "while unmarshaling YAML file with price table")
+	}
+	return table, nil
+}
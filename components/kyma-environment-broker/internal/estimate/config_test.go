@@ -0,0 +1,64 @@
+package estimate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadMachineSpecsFromFile(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "providers.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "azure": {"standard_d4_v3": {"cpu_cores": 4, "memory": 16, "storage": 100, "max_nics": 2}},
+  "aws": {"m5.xlarge": {"cpu_cores": 4, "memory": 16}}
+}`), 0600))
+
+	// when
+	specs, err := ReadMachineSpecsFromFile(path)
+
+	// then
+	require.NoError(t, err)
+	spec, found := specs.Get("azure", "Standard_D4_v3")
+	assert.True(t, found)
+	assert.Equal(t, MachineSpec{CpuCores: 4, Memory: 16}, spec)
+	_, found = specs.Get("gcp", "m5.xlarge")
+	assert.False(t, found)
+}
+
+func TestReadPriceTableFromFile(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "prices.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`currency: EUR
+providers:
+  azure:
+    machineTypes:
+      Standard_D4_v3: 0.2
+      Standard_D8_v3: 0.4
+    storageGbHour: 0.0001
+    regions:
+      westus2:
+        machineTypes:
+          standard_d4_v3: 0.18
+`), 0600))
+
+	// when
+	table, err := ReadPriceTableFromFile(path)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", table.Currency)
+	price, found := table.MachinePrice("azure", "westus2", "Standard_D4_v3")
+	assert.True(t, found)
+	assert.Equal(t, 0.18, price)
+	price, _ = table.MachinePrice("azure", "westus2", "Standard_D8_v3")
+	assert.Equal(t, 0.4, price)
+	price, found = table.StoragePrice("azure", "westus2")
+	assert.True(t, found)
+	assert.Equal(t, 0.0001, price)
+	_, found = table.MachinePrice("aws", "eu-central-1", "m5.xlarge")
+	assert.False(t, found)
+}
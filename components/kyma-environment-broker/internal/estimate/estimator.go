@@ -0,0 +1,249 @@
+package estimate
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/pkg/errors"
+)
+
+const mainWorkerPoolName = "cpu-worker-0"
+
+// Estimator estimates the resource consumption of the runtimes from the worker pools defined by the plan defaults
+// and the provisioning parameters, the resources of the machine types, and the price table
+type Estimator struct {
+	specs        MachineSpecs
+	prices       PriceTable
+	planDefaults broker.PlanDefaults
+}
+
+func NewEstimator(specs MachineSpecs, prices PriceTable, planDefaults broker.PlanDefaults) *Estimator {
+	return &Estimator{
+		specs:        specs,
+		prices:       prices,
+		planDefaults: planDefaults,
+	}
+}
+
+// ForPlan estimates the consumption of a new runtime of the plan, the parameters which are not set are taken from the plan defaults
+func (e *Estimator) ForPlan(params estimate.Parameters, platformProvider internal.CloudProvider, hours int) (estimate.Estimate, error) {
+	planID, found := planID(params.Plan)
+	if !found {
+		return estimate.Estimate{}, fmt.Errorf("plan %s does not exist", params.Plan)
+	}
+	parameters := internal.ProvisioningParametersDTO{
+		AutoScalerParameters: internal.AutoScalerParameters{
+			AutoScalerMin: params.AutoScalerMin,
+			AutoScalerMax: params.AutoScalerMax,
+		},
+		VolumeSizeGb: params.VolumeSizeGb,
+	}
+	if params.MachineType != "" {
+		parameters.MachineType = &params.MachineType
+	}
+	if params.Region != "" {
+		parameters.Region = &params.Region
+	}
+
+	return e.forParameters(planID, platformProvider, parameters, "", hours)
+}
+
+// ForRuntime estimates the consumption of the existing runtime, the current consumption is estimated
+// if the metric record of the runtime is given
+func (e *Estimator) ForRuntime(instance internal.Instance, record *Record, hours int) (estimate.Estimate, error) {
+	parameters := instance.Parameters.Parameters
+	if instance.ProviderRegion != "" {
+		parameters.Region = &instance.ProviderRegion
+	}
+
+	result, err := e.forParameters(instance.ServicePlanID, instance.Parameters.PlatformProvider, parameters, instance.RuntimeID, hours)
+	if err != nil {
+		return estimate.Estimate{}, err
+	}
+	if record != nil && record.RuntimeID == instance.RuntimeID && record.Metric != nil {
+		current := e.current(result, record.Metric)
+		result.Current = &current
+	}
+	return result, nil
+}
+
+func (e *Estimator) forParameters(planID string, platformProvider internal.CloudProvider, parameters internal.ProvisioningParametersDTO, runtimeID string, hours int) (estimate.Estimate, error) {
+	if hours <= 0 {
+		return estimate.Estimate{}, fmt.Errorf("the number of hours must be greater than 0")
+	}
+	if broker.BasePlanID(planID) == broker.OwnClusterPlanID {
+		return estimate.Estimate{}, fmt.Errorf("the consumption of the plan %s cannot be estimated", broker.PlanNameByID(planID))
+	}
+	defaults, err := e.planDefaults(planID, platformProvider, parameters.Provider)
+	if err != nil {
+		return estimate.Estimate{}, errors.Wrap(err, "while obtaining plan defaults")
+	}
+	if defaults.GardenerConfig == nil {
+		return estimate.Estimate{}, fmt.Errorf("the plan %s does not define the cluster configuration", broker.PlanNameByID(planID))
+	}
+	config := defaults.GardenerConfig
+	if err := parameters.AutoScalerParameters.Validate(config.AutoScalerMin, config.AutoScalerMax); err != nil {
+		return estimate.Estimate{}, err
+	}
+
+	mainPool := estimate.WorkerPool{
+		Name:          mainWorkerPoolName,
+		MachineType:   config.MachineType,
+		AutoScalerMin: config.AutoScalerMin,
+		AutoScalerMax: config.AutoScalerMax,
+	}
+	if config.VolumeSizeGb != nil {
+		mainPool.VolumeSizeGb = *config.VolumeSizeGb
+	}
+	if parameters.MachineType != nil {
+		mainPool.MachineType = *parameters.MachineType
+	}
+	if parameters.VolumeSizeGb != nil {
+		mainPool.VolumeSizeGb = *parameters.VolumeSizeGb
+	}
+	if parameters.AutoScalerMin != nil {
+		mainPool.AutoScalerMin = *parameters.AutoScalerMin
+	}
+	if parameters.AutoScalerMax != nil {
+		mainPool.AutoScalerMax = *parameters.AutoScalerMax
+	}
+	pools := []estimate.WorkerPool{mainPool}
+	for _, pool := range parameters.AdditionalWorkerNodePools {
+		additionalPool := estimate.WorkerPool{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			VolumeSizeGb:  mainPool.VolumeSizeGb,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		}
+		if pool.VolumeSizeGb != nil {
+			additionalPool.VolumeSizeGb = *pool.VolumeSizeGb
+		}
+		pools = append(pools, additionalPool)
+	}
+
+	result := estimate.Estimate{
+		PlanID:      planID,
+		PlanName:    broker.PlanNameByID(planID),
+		RuntimeID:   runtimeID,
+		Provider:    config.Provider,
+		Region:      config.Region,
+		Hours:       hours,
+		Currency:    e.prices.Currency,
+		WorkerPools: pools,
+	}
+	if parameters.Region != nil && *parameters.Region != "" {
+		result.Region = *parameters.Region
+	}
+
+	result.Min, err = e.resources(result, func(pool estimate.WorkerPool) int { return pool.AutoScalerMin })
+	if err != nil {
+		return estimate.Estimate{}, err
+	}
+	result.Max, err = e.resources(result, func(pool estimate.WorkerPool) int { return pool.AutoScalerMax })
+	if err != nil {
+		return estimate.Estimate{}, err
+	}
+	return result, nil
+}
+
+// resources sums up the resources of all worker pools scaled to the given number of nodes
+func (e *Estimator) resources(result estimate.Estimate, nodes func(pool estimate.WorkerPool) int) (estimate.Resources, error) {
+	resources := estimate.Resources{}
+	cost := newCost(e.prices, result.Provider, result.Region, result.Hours)
+	for _, pool := range result.WorkerPools {
+		spec, found := e.specs.Get(result.Provider, pool.MachineType)
+		if !found {
+			return resources, fmt.Errorf("machine type %s of the provider %s is not known", pool.MachineType, result.Provider)
+		}
+		count := nodes(pool)
+		resources.Nodes += count
+		resources.VCPU += count * spec.CpuCores
+		resources.MemoryGb += float64(count) * spec.Memory
+		resources.StorageGb += count * pool.VolumeSizeGb
+		cost.addNodes(pool.MachineType, count, pool.VolumeSizeGb)
+	}
+	resources.NodeHours = resources.Nodes * result.Hours
+	resources.Cost = cost.total()
+	return resources, nil
+}
+
+// current estimates the consumption of the live nodes, the volume size of the nodes is taken from the worker pool
+// with the same machine type
+func (e *Estimator) current(result estimate.Estimate, metric *Metric) estimate.Resources {
+	resources := estimate.Resources{
+		VCPU:     metric.Compute.ProvisionedCpus,
+		MemoryGb: metric.Compute.ProvisionedRAMGb,
+	}
+	cost := newCost(e.prices, result.Provider, result.Region, result.Hours)
+	for _, vmType := range metric.Compute.VMTypes {
+		volumeSizeGb := result.WorkerPools[0].VolumeSizeGb
+		for _, pool := range result.WorkerPools {
+			if strings.EqualFold(pool.MachineType, vmType.Name) {
+				volumeSizeGb = pool.VolumeSizeGb
+				break
+			}
+		}
+		resources.Nodes += vmType.Count
+		resources.StorageGb += vmType.Count * volumeSizeGb
+		cost.addNodes(vmType.Name, vmType.Count, volumeSizeGb)
+	}
+	resources.NodeHours = resources.Nodes * result.Hours
+	resources.Cost = cost.total()
+	return resources
+}
+
+// cost sums up the prices of the nodes, the cost is unknown if any price is missing in the price table
+type cost struct {
+	prices   PriceTable
+	provider string
+	region   string
+	hours    int
+	sum      float64
+	unknown  bool
+}
+
+func newCost(prices PriceTable, provider, region string, hours int) *cost {
+	return &cost{prices: prices, provider: provider, region: region, hours: hours}
+}
+
+func (c *cost) addNodes(machineType string, count, volumeSizeGb int) {
+	if count == 0 {
+		return
+	}
+	machinePrice, found := c.prices.MachinePrice(c.provider, c.region, machineType)
+	if !found {
+		c.unknown = true
+		return
+	}
+	storagePrice, found := c.prices.StoragePrice(c.provider, c.region)
+	if !found {
+		c.unknown = true
+		return
+	}
+	nodeHours := float64(count * c.hours)
+	c.sum += nodeHours*machinePrice + nodeHours*float64(volumeSizeGb)*storagePrice
+}
+
+func (c *cost) total() *float64 {
+	if c.unknown {
+		return nil
+	}
+	total := math.Round(c.sum*100) / 100
+	return &total
+}
+
+// planID accepts both the name and the ID of the plan
+func planID(plan string) (string, bool) {
+	if id, found := broker.PlanIDByName(plan); found {
+		return id, true
+	}
+	if broker.PlanNameByID(plan) != "" {
+		return plan, true
+	}
+	return "", false
+}
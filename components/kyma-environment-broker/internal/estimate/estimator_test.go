@@ -0,0 +1,203 @@
+package estimate
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimator_ForPlan(t *testing.T) {
+	for name, tc := range map[string]struct {
+		params   estimate.Parameters
+		prices   PriceTable
+		expected estimate.Estimate
+	}{
+		"plan defaults": {
+			params: estimate.Parameters{Plan: "aws"},
+			prices: fixPriceTable(),
+			expected: estimate.Estimate{
+				PlanID: broker.AWSPlanID, PlanName: "aws", Provider: "aws", Region: "eu-central-1", Hours: 10, Currency: "EUR",
+				WorkerPools: []estimate.WorkerPool{{Name: "cpu-worker-0", MachineType: "m5.xlarge", VolumeSizeGb: 50, AutoScalerMin: 3, AutoScalerMax: 20}},
+				Min:         estimate.Resources{Nodes: 3, NodeHours: 30, VCPU: 12, MemoryGb: 48, StorageGb: 150, Cost: ptr.Float64(6.15)},
+				Max:         estimate.Resources{Nodes: 20, NodeHours: 200, VCPU: 80, MemoryGb: 320, StorageGb: 1000, Cost: ptr.Float64(41)},
+			},
+		},
+		"parameters with region prices": {
+			params: estimate.Parameters{Plan: broker.AWSPlanID, Region: "us-east-1", MachineType: "M5.2xlarge", AutoScalerMin: ptr.Integer(2), AutoScalerMax: ptr.Integer(4), VolumeSizeGb: ptr.Integer(100)},
+			prices: fixPriceTable(),
+			expected: estimate.Estimate{
+				PlanID: broker.AWSPlanID, PlanName: "aws", Provider: "aws", Region: "us-east-1", Hours: 10, Currency: "EUR",
+				WorkerPools: []estimate.WorkerPool{{Name: "cpu-worker-0", MachineType: "M5.2xlarge", VolumeSizeGb: 100, AutoScalerMin: 2, AutoScalerMax: 4}},
+				Min:         estimate.Resources{Nodes: 2, NodeHours: 20, VCPU: 16, MemoryGb: 64, StorageGb: 200, Cost: ptr.Float64(8.2)},
+				Max:         estimate.Resources{Nodes: 4, NodeHours: 40, VCPU: 32, MemoryGb: 128, StorageGb: 400, Cost: ptr.Float64(16.4)},
+			},
+		},
+		"no prices": {
+			params: estimate.Parameters{Plan: "aws", AutoScalerMin: ptr.Integer(1), AutoScalerMax: ptr.Integer(1)},
+			expected: estimate.Estimate{
+				PlanID: broker.AWSPlanID, PlanName: "aws", Provider: "aws", Region: "eu-central-1", Hours: 10,
+				WorkerPools: []estimate.WorkerPool{{Name: "cpu-worker-0", MachineType: "m5.xlarge", VolumeSizeGb: 50, AutoScalerMin: 1, AutoScalerMax: 1}},
+				Min:         estimate.Resources{Nodes: 1, NodeHours: 10, VCPU: 4, MemoryGb: 16, StorageGb: 50},
+				Max:         estimate.Resources{Nodes: 1, NodeHours: 10, VCPU: 4, MemoryGb: 16, StorageGb: 50},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			estimator := NewEstimator(fixMachineSpecs(), tc.prices, fixPlanDefaults)
+
+			// when
+			result, err := estimator.ForPlan(tc.params, internal.AWS, 10)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestEstimator_ForPlanErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		params estimate.Parameters
+		err    string
+	}{
+		"unknown plan": {
+			params: estimate.Parameters{Plan: "foo"},
+			err:    "plan foo does not exist",
+		},
+		"own cluster": {
+			params: estimate.Parameters{Plan: "own_cluster"},
+			err:    "the consumption of the plan own_cluster cannot be estimated",
+		},
+		"unknown machine type": {
+			params: estimate.Parameters{Plan: "aws", MachineType: "m5.64xlarge"},
+			err:    "machine type m5.64xlarge of the provider aws is not known",
+		},
+		"invalid autoscaler parameters": {
+			params: estimate.Parameters{Plan: "aws", AutoScalerMin: ptr.Integer(30)},
+			err:    "AutoScalerMax 20 should be larger than AutoScalerMin 30. User provided values min:30, max:<nil>; plan defaults min:3, max:20",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			estimator := NewEstimator(fixMachineSpecs(), fixPriceTable(), fixPlanDefaults)
+
+			// when
+			_, err := estimator.ForPlan(tc.params, internal.AWS, 10)
+
+			// then
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestEstimator_ForRuntime(t *testing.T) {
+	// given
+	estimator := NewEstimator(fixMachineSpecs(), fixPriceTable(), fixPlanDefaults)
+	instance := fixInstance()
+	record := &Record{
+		SubAccountID: "sub-account-id",
+		RuntimeID:    "runtime-id",
+		Metric: &Metric{Compute: Compute{
+			VMTypes:          []VMType{{Name: "m5.xlarge", Count: 3}, {Name: "m5.2xlarge", Count: 1}},
+			ProvisionedCpus:  20,
+			ProvisionedRAMGb: 80,
+		}},
+	}
+
+	// when
+	result, err := estimator.ForRuntime(instance, record, 10)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "runtime-id", result.RuntimeID)
+	assert.Equal(t, "eu-central-1", result.Region)
+	assert.Equal(t, []estimate.WorkerPool{
+		{Name: "cpu-worker-0", MachineType: "m5.xlarge", VolumeSizeGb: 80, AutoScalerMin: 3, AutoScalerMax: 5},
+		{Name: "high-mem", MachineType: "m5.2xlarge", VolumeSizeGb: 80, AutoScalerMin: 0, AutoScalerMax: 2},
+	}, result.WorkerPools)
+	assert.Equal(t, estimate.Resources{Nodes: 3, NodeHours: 30, VCPU: 12, MemoryGb: 48, StorageGb: 240, Cost: ptr.Float64(6.24)}, result.Min)
+	assert.Equal(t, estimate.Resources{Nodes: 7, NodeHours: 70, VCPU: 36, MemoryGb: 144, StorageGb: 560, Cost: ptr.Float64(18.56)}, result.Max)
+	assert.Equal(t, &estimate.Resources{Nodes: 4, NodeHours: 40, VCPU: 20, MemoryGb: 80, StorageGb: 320, Cost: ptr.Float64(10.32)}, result.Current)
+}
+
+func TestEstimator_ForRuntimeWithRecordOfPreviousRuntime(t *testing.T) {
+	// given
+	estimator := NewEstimator(fixMachineSpecs(), fixPriceTable(), fixPlanDefaults)
+	record := &Record{RuntimeID: "previous-runtime-id", Metric: &Metric{}}
+
+	// when
+	result, err := estimator.ForRuntime(fixInstance(), record, 10)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, result.Current)
+}
+
+func fixInstance() internal.Instance {
+	return internal.Instance{
+		InstanceID:     "instance-id",
+		RuntimeID:      "runtime-id",
+		SubAccountID:   "sub-account-id",
+		ServicePlanID:  broker.AWSPlanID,
+		ProviderRegion: "eu-central-1",
+		Parameters: internal.ProvisioningParameters{
+			PlanID: broker.AWSPlanID,
+			Parameters: internal.ProvisioningParametersDTO{
+				AutoScalerParameters: internal.AutoScalerParameters{AutoScalerMax: ptr.Integer(5)},
+				VolumeSizeGb:         ptr.Integer(80),
+				AdditionalWorkerNodePools: []internal.WorkerNodePoolDTO{
+					{Name: "high-mem", MachineType: "m5.2xlarge", AutoScalerMin: 0, AutoScalerMax: 2},
+				},
+			},
+		},
+	}
+}
+
+func fixMachineSpecs() MachineSpecs {
+	return MachineSpecs{
+		"aws": {
+			"m5.xlarge":  {CpuCores: 4, Memory: 16},
+			"m5.2xlarge": {CpuCores: 8, Memory: 32},
+		},
+	}
+}
+
+func fixPriceTable() PriceTable {
+	return PriceTable{
+		Currency: "EUR",
+		Providers: map[string]ProviderPrices{
+			"aws": {
+				Prices: Prices{
+					MachineTypes:  map[string]float64{"m5.xlarge": 0.2, "m5.2xlarge": 0.4},
+					StorageGbHour: ptr.Float64(0.0001),
+				},
+				Regions: map[string]Prices{
+					"us-east-1": {MachineTypes: map[string]float64{"M5.2XLARGE": 0.4}, StorageGbHour: ptr.Float64(0.0001)},
+				},
+			},
+		},
+	}
+}
+
+func fixPlanDefaults(planID string, _ internal.CloudProvider, _ *internal.CloudProvider) (*gqlschema.ClusterConfigInput, error) {
+	if planID == broker.OwnClusterPlanID {
+		return &gqlschema.ClusterConfigInput{}, nil
+	}
+	return &gqlschema.ClusterConfigInput{
+		GardenerConfig: &gqlschema.GardenerConfigInput{
+			VolumeSizeGb:  ptr.Integer(50),
+			MachineType:   "m5.xlarge",
+			Region:        "eu-central-1",
+			Provider:      "aws",
+			AutoScalerMin: 3,
+			AutoScalerMax: 20,
+		},
+	}, nil
+}
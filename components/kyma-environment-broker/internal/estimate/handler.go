@@ -0,0 +1,148 @@
+package estimate
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/middleware"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Handler estimates the consumption of a new runtime of the given plan or of an existing runtime
+type Handler struct {
+	estimator    *Estimator
+	instances    storage.Instances
+	kmcClient    KMCClient
+	defaultHours int
+	log          logrus.FieldLogger
+}
+
+// NewHandler creates the handler of the /estimate endpoint, the current consumption of the runtimes is not estimated
+// if the KMC client is nil
+func NewHandler(estimator *Estimator, instances storage.Instances, kmcClient KMCClient, defaultHours int, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		estimator:    estimator,
+		instances:    instances,
+		kmcClient:    kmcClient,
+		defaultHours: defaultHours,
+		log:          log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/estimate", h.getEstimate).Methods(http.MethodGet)
+}
+
+func (h *Handler) getEstimate(w http.ResponseWriter, req *http.Request) {
+	params, err := h.getParameters(req)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if params.RuntimeID != "" {
+		h.estimateRuntime(w, req, params)
+		return
+	}
+
+	platformProvider, found := middleware.ProviderFromContext(req.Context())
+	if !found {
+		platformProvider = internal.AWS
+	}
+	result, err := h.estimator.ForPlan(params, platformProvider, params.Hours)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+	httputil.WriteResponse(w, http.StatusOK, result)
+}
+
+func (h *Handler) estimateRuntime(w http.ResponseWriter, req *http.Request, params estimate.Parameters) {
+	instances, err := h.instances.FindAllInstancesForRuntimes([]string{params.RuntimeID})
+	if err != nil {
+		code := http.StatusInternalServerError
+		if dberr.IsNotFound(errors.Cause(err)) {
+			code = http.StatusNotFound
+		}
+		httputil.WriteErrorResponse(w, code, errors.Wrapf(err, "while getting instance for runtime %s", params.RuntimeID))
+		return
+	}
+	if len(instances) == 0 {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("runtime %s not found", params.RuntimeID))
+		return
+	}
+	instance := instances[0]
+
+	var record *Record
+	if h.kmcClient != nil {
+		record, err = h.kmcClient.GetRecord(req.Context(), instance.SubAccountID)
+		if err != nil {
+			// the estimation at the min and max scale is still useful without the live node counts
+			h.log.Warnf("unable to get the metric record of the runtime %s from KMC: %s", params.RuntimeID, err)
+		}
+	}
+
+	result, err := h.estimator.ForRuntime(instance, record, params.Hours)
+	if err != nil {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.Wrapf(err, "while estimating runtime %s", params.RuntimeID))
+		return
+	}
+	httputil.WriteResponse(w, http.StatusOK, result)
+}
+
+func (h *Handler) getParameters(req *http.Request) (estimate.Parameters, error) {
+	query := req.URL.Query()
+	params := estimate.Parameters{
+		Plan:        query.Get(estimate.PlanParam),
+		Region:      query.Get(estimate.RegionParam),
+		MachineType: query.Get(estimate.MachineTypeParam),
+		RuntimeID:   query.Get(estimate.RuntimeIDParam),
+		Hours:       h.defaultHours,
+	}
+
+	var err error
+	if params.AutoScalerMin, err = intParam(query.Get(estimate.AutoScalerMinParam), estimate.AutoScalerMinParam); err != nil {
+		return params, err
+	}
+	if params.AutoScalerMax, err = intParam(query.Get(estimate.AutoScalerMaxParam), estimate.AutoScalerMaxParam); err != nil {
+		return params, err
+	}
+	if params.VolumeSizeGb, err = intParam(query.Get(estimate.VolumeSizeGbParam), estimate.VolumeSizeGbParam); err != nil {
+		return params, err
+	}
+	hours, err := intParam(query.Get(estimate.HoursParam), estimate.HoursParam)
+	if err != nil {
+		return params, err
+	}
+	if hours != nil {
+		params.Hours = *hours
+	}
+
+	if params.RuntimeID == "" && params.Plan == "" {
+		return params, fmt.Errorf("either the %s or the %s parameter must be provided", estimate.PlanParam, estimate.RuntimeIDParam)
+	}
+	if params.RuntimeID != "" && (params.Plan != "" || params.Region != "" || params.MachineType != "" ||
+		params.AutoScalerMin != nil || params.AutoScalerMax != nil || params.VolumeSizeGb != nil) {
+		return params, fmt.Errorf("the %s parameter can be used only together with the %s parameter", estimate.RuntimeIDParam, estimate.HoursParam)
+	}
+	return params, nil
+}
+
+func intParam(value, name string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < 0 {
+		return nil, fmt.Errorf("the %s parameter must be a non-negative integer", name)
+	}
+	return &v, nil
+}
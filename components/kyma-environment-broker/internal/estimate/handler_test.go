@@ -0,0 +1,107 @@
+package estimate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetEstimate(t *testing.T) {
+	st := storage.NewMemoryStorage()
+	require.NoError(t, st.Instances().Insert(fixInstance()))
+
+	kmc := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/records/sub-account-id" {
+			http.NotFound(w, r)
+			return
+		}
+		err := json.NewEncoder(w).Encode(Record{
+			SubAccountID: "sub-account-id",
+			RuntimeID:    "runtime-id",
+			Metric:       &Metric{Compute: Compute{VMTypes: []VMType{{Name: "m5.xlarge", Count: 4}}, ProvisionedCpus: 16, ProvisionedRAMGb: 64}},
+		})
+		require.NoError(t, err)
+	}))
+	defer kmc.Close()
+
+	router := mux.NewRouter()
+	handler := NewHandler(NewEstimator(fixMachineSpecs(), fixPriceTable(), fixPlanDefaults), st.Instances(), NewKMCClient(kmc.URL, http.DefaultClient), 730, logrus.New())
+	handler.AttachRoutes(router)
+
+	t.Run("should estimate the plan", func(t *testing.T) {
+		// when
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/estimate?plan=aws&machine_type=m5.2xlarge&autoscaler_min=2&autoscaler_max=3&hours=24", nil))
+
+		// then
+		require.Equal(t, http.StatusOK, resp.Code)
+		var result estimate.Estimate
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, 24, result.Hours)
+		assert.Equal(t, estimate.Resources{Nodes: 2, NodeHours: 48, VCPU: 16, MemoryGb: 64, StorageGb: 100, Cost: result.Min.Cost}, result.Min)
+		assert.Equal(t, 3, result.Max.Nodes)
+		assert.Nil(t, result.Current)
+	})
+
+	t.Run("should estimate the runtime with the live node counts", func(t *testing.T) {
+		// when
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/estimate?runtime_id=runtime-id", nil))
+
+		// then
+		require.Equal(t, http.StatusOK, resp.Code)
+		var result estimate.Estimate
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, 730, result.Hours)
+		assert.Equal(t, "runtime-id", result.RuntimeID)
+		require.NotNil(t, result.Current)
+		assert.Equal(t, 4, result.Current.Nodes)
+		assert.Equal(t, 2920, result.Current.NodeHours)
+		assert.Equal(t, 16, result.Current.VCPU)
+	})
+
+	for name, tc := range map[string]struct {
+		query string
+		code  int
+	}{
+		"no plan and runtime":       {query: "", code: http.StatusBadRequest},
+		"runtime with plan":         {query: "?runtime_id=runtime-id&plan=aws", code: http.StatusBadRequest},
+		"invalid number":            {query: "?plan=aws&autoscaler_max=many", code: http.StatusBadRequest},
+		"unknown plan":              {query: "?plan=foo", code: http.StatusBadRequest},
+		"zero hours":                {query: "?plan=aws&hours=0", code: http.StatusBadRequest},
+		"unknown runtime":           {query: "?runtime_id=other-runtime-id", code: http.StatusNotFound},
+		"runtime with custom hours": {query: "?runtime_id=runtime-id&hours=1", code: http.StatusOK},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			resp := httptest.NewRecorder()
+			router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/estimate"+tc.query, nil))
+
+			// then
+			assert.Equal(t, tc.code, resp.Code)
+		})
+	}
+}
+
+func TestKMCClient_GetRecordNotFound(t *testing.T) {
+	// given
+	kmc := httptest.NewServer(http.NotFoundHandler())
+	defer kmc.Close()
+	client := NewKMCClient(kmc.URL, http.DefaultClient)
+
+	// when
+	record, err := client.GetRecord(context.Background(), "sub-account-id")
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, record)
+}
@@ -0,0 +1,85 @@
+package estimate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Record is the metric record of the subaccount collected by Kyma Metrics Collector
+type Record struct {
+	SubAccountID string  `json:"subAccountID"`
+	RuntimeID    string  `json:"runtimeID"`
+	ShootName    string  `json:"shootName"`
+	Metric       *Metric `json:"metric,omitempty"`
+}
+
+type Metric struct {
+	Timestamp string  `json:"timestamp"`
+	Compute   Compute `json:"compute"`
+}
+
+type Compute struct {
+	VMTypes          []VMType `json:"vm_types"`
+	ProvisionedCpus  int      `json:"provisioned_cpus"`
+	ProvisionedRAMGb float64  `json:"provisioned_ram_gb"`
+}
+
+type VMType struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// KMCClient gets the metric records of the runtimes from Kyma Metrics Collector
+type KMCClient interface {
+	// GetRecord returns nil if Kyma Metrics Collector does not track the subaccount
+	GetRecord(ctx context.Context, subAccountID string) (*Record, error)
+}
+
+type kmcClient struct {
+	url        string
+	httpClient *http.Client
+}
+
+func NewKMCClient(url string, httpClient *http.Client) KMCClient {
+	return &kmcClient{
+		url:        url,
+		httpClient: httpClient,
+	}
+}
+
+func (c *kmcClient) GetRecord(ctx context.Context, subAccountID string) (*Record, error) {
+	recordURL := fmt.Sprintf("%s/records/%s", c.url, url.PathEscape(subAccountID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, recordURL, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "while creating request")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while calling %s", recordURL)
+	}
+	defer func() {
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("calling %s returned %d (%s) status", recordURL, resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+
+	var record Record
+	if err := json.NewDecoder(resp.Body).Decode(&record); err != nil {
+		return nil, errors.Wrap(err, "while decoding the record")
+	}
+	return &record, nil
+}
@@ -1,11 +1,13 @@
 package events
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
 )
 
 type Config struct {
@@ -15,10 +17,16 @@ type Config struct {
 }
 
 var (
-	ev       Interface
-	initLock sync.Mutex
+	ev        Interface
+	publisher event.Publisher
+	initLock  sync.Mutex
 )
 
+// EventInserted is published for every written event, also when storing the events is disabled
+type EventInserted struct {
+	Event events.EventDTO
+}
+
 type Interface interface {
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
 	InsertEvent(eventLevel events.EventLevel, message, instanceID, operationID string)
@@ -38,6 +46,13 @@ func New(cfg Config, events Interface) Interface {
 	return ev
 }
 
+// SetPublisher sets the publisher notified about every written event
+func SetPublisher(p event.Publisher) {
+	initLock.Lock()
+	defer initLock.Unlock()
+	publisher = p
+}
+
 func Infof(instanceID, operationID, format string, args ...any) {
 	insertEvent(events.InfoEventLevel, fmt.Sprintf(format, args...), instanceID, operationID)
 }
@@ -50,4 +65,16 @@ func insertEvent(eventLevel events.EventLevel, msg, instanceID, operationID stri
 	if ev != nil {
 		ev.InsertEvent(eventLevel, msg, instanceID, operationID)
 	}
+	initLock.Lock()
+	p := publisher
+	initLock.Unlock()
+	if p != nil {
+		p.Publish(context.TODO(), EventInserted{Event: events.EventDTO{
+			Level:       eventLevel,
+			InstanceID:  &instanceID,
+			OperationID: &operationID,
+			Message:     msg,
+			CreatedAt:   time.Now(),
+		}})
+	}
 }
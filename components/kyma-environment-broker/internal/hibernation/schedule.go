@@ -0,0 +1,42 @@
+package hibernation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Schedule is the hibernation window of the runtime, Gardener hibernates the shoot at the start and wakes it up
+// at the end cron expressions evaluated in the location time zone
+type Schedule struct {
+	Start    *string
+	End      *string
+	Location *string
+}
+
+// Validate checks the cron expressions and the time zone of the schedule, the cron expressions are parsed
+// the same way as by Gardener
+func Validate(schedule Schedule) error {
+	if isEmpty(schedule.Start) && isEmpty(schedule.End) {
+		return fmt.Errorf("hibernation schedule must define the start or the end")
+	}
+	if !isEmpty(schedule.Location) {
+		if _, err := time.LoadLocation(*schedule.Location); err != nil {
+			return fmt.Errorf("location %q of the hibernation schedule is not a valid time zone", *schedule.Location)
+		}
+	}
+	for _, expr := range []*string{schedule.Start, schedule.End} {
+		if isEmpty(expr) {
+			continue
+		}
+		if _, err := cron.ParseStandard(*expr); err != nil {
+			return fmt.Errorf("cron expression %q is not valid: %s", *expr, err)
+		}
+	}
+	return nil
+}
+
+func isEmpty(value *string) bool {
+	return value == nil || *value == ""
+}
@@ -0,0 +1,57 @@
+package hibernation
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		schedule Schedule
+		err      string
+	}{
+		"working days": {
+			schedule: Schedule{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+		},
+		"names, ranges, and steps": {
+			schedule: Schedule{Start: ptr.String("*/30 18-23 * JAN-DEC mon-fri")},
+		},
+		"descriptor": {
+			schedule: Schedule{End: ptr.String("@daily")},
+		},
+		"no start and end": {
+			schedule: Schedule{Location: ptr.String("Europe/Berlin")},
+			err:      "hibernation schedule must define the start or the end",
+		},
+		"invalid location": {
+			schedule: Schedule{Start: ptr.String("00 20 * * *"), Location: ptr.String("Europe/Nowhere")},
+			err:      `location "Europe/Nowhere" of the hibernation schedule is not a valid time zone`,
+		},
+		"missing field": {
+			schedule: Schedule{Start: ptr.String("00 20 * *")},
+			err:      `cron expression "00 20 * *" is not valid`,
+		},
+		"hour out of range": {
+			schedule: Schedule{End: ptr.String("00 24 * * *")},
+			err:      `cron expression "00 24 * * *" is not valid`,
+		},
+		"invalid range": {
+			schedule: Schedule{Start: ptr.String("00 20 * * 5-1")},
+			err:      `cron expression "00 20 * * 5-1" is not valid`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := Validate(tc.schedule)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
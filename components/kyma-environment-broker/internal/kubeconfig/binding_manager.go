@@ -0,0 +1,170 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+
+	"github.com/pkg/errors"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	BindingNamespace       = "kyma-system"
+	bindingNamePrefix      = "kyma-binding-"
+	bindingIDLabel         = "kyma-project.io/binding-id"
+	managedByLabel         = "app.kubernetes.io/managed-by"
+	managedByLabelValue    = "kcp-kyma-environment-broker"
+	clusterRoleKind        = "ClusterRole"
+	serviceAccountKindName = "ServiceAccount"
+)
+
+// BindingManager issues kubeconfigs for service bindings. Every binding gets its own ServiceAccount
+// in the SKR bound to the requested ClusterRole, the kubeconfig carries a token with a limited lifetime.
+type BindingManager struct {
+	provisionerClient provisioner.Client
+	kubeconfigBuilder *Builder
+	clientProvider    func(kubeconfig string) (kubernetes.Interface, error)
+}
+
+func NewBindingManager(provisionerClient provisioner.Client, builder *Builder, clientProvider func(kubeconfig string) (kubernetes.Interface, error)) *BindingManager {
+	return &BindingManager{
+		provisionerClient: provisionerClient,
+		kubeconfigBuilder: builder,
+		clientProvider:    clientProvider,
+	}
+}
+
+// Create creates the ServiceAccount with the role binding in the SKR and returns the binding with a kubeconfig
+// which contains a token valid for the given time
+func (m *BindingManager) Create(ctx context.Context, instance *internal.Instance, bindingID, role string, expiration time.Duration) (internal.Binding, error) {
+	adminKubeconfig, err := m.adminKubeconfig(instance)
+	if err != nil {
+		return internal.Binding{}, err
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return internal.Binding{}, errors.Wrap(err, "while creating SKR client")
+	}
+
+	name := ServiceAccountName(bindingID)
+	labels := map[string]string{
+		bindingIDLabel: bindingID,
+		managedByLabel: managedByLabelValue,
+	}
+
+	_, err = cli.CoreV1().ServiceAccounts(BindingNamespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: BindingNamespace,
+			Labels:    labels,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return internal.Binding{}, errors.Wrapf(err, "while creating service account %s", name)
+	}
+
+	_, err = cli.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     clusterRoleKind,
+			Name:     role,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      serviceAccountKindName,
+				Name:      name,
+				Namespace: BindingNamespace,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return internal.Binding{}, errors.Wrapf(err, "while creating cluster role binding %s", name)
+	}
+
+	expirationSeconds := int64(expiration.Seconds())
+	tokenRequest, err := cli.CoreV1().ServiceAccounts(BindingNamespace).CreateToken(ctx, name, &authv1.TokenRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: BindingNamespace,
+		},
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return internal.Binding{}, errors.Wrapf(err, "while creating token for service account %s", name)
+	}
+
+	expiresAt := tokenRequest.Status.ExpirationTimestamp.Time
+	if expiresAt.IsZero() {
+		expiresAt = time.Now().Add(expiration)
+	}
+
+	kubeconfig, err := m.kubeconfigBuilder.BuildWithToken(adminKubeconfig, name, tokenRequest.Status.Token)
+	if err != nil {
+		return internal.Binding{}, errors.Wrap(err, "while building kubeconfig")
+	}
+
+	return internal.Binding{
+		ID:                 bindingID,
+		InstanceID:         instance.InstanceID,
+		ExpiresAt:          expiresAt,
+		Role:               role,
+		ServiceAccountName: name,
+		Kubeconfig:         kubeconfig,
+	}, nil
+}
+
+// Revoke removes the ServiceAccount and the role binding from the SKR, which invalidates all tokens issued for the binding
+func (m *BindingManager) Revoke(ctx context.Context, instance *internal.Instance, bindingID string) error {
+	adminKubeconfig, err := m.adminKubeconfig(instance)
+	if err != nil {
+		return err
+	}
+	cli, err := m.clientProvider(adminKubeconfig)
+	if err != nil {
+		return errors.Wrap(err, "while creating SKR client")
+	}
+
+	name := ServiceAccountName(bindingID)
+	err = cli.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while deleting cluster role binding %s", name)
+	}
+	err = cli.CoreV1().ServiceAccounts(BindingNamespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "while deleting service account %s", name)
+	}
+
+	return nil
+}
+
+func (m *BindingManager) adminKubeconfig(instance *internal.Instance) (string, error) {
+	status, err := m.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while fetching runtime status from provisioner")
+	}
+	if status.RuntimeConfiguration == nil || status.RuntimeConfiguration.Kubeconfig == nil {
+		return "", errors.New("Kubeconfig is nil (nil response from Provisioner)")
+	}
+	return *status.RuntimeConfiguration.Kubeconfig, nil
+}
+
+// ServiceAccountName returns the name of the ServiceAccount created in the SKR for the given binding
+func ServiceAccountName(bindingID string) string {
+	return fmt.Sprintf("%s%s", bindingNamePrefix, strings.ToLower(bindingID))
+}
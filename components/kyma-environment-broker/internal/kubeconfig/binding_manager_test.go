@@ -0,0 +1,134 @@
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
+	schema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const bindingID = "5D6A2F3E-binding"
+
+func TestBindingManager_Create(t *testing.T) {
+	t.Run("should create service account, role binding and kubeconfig with token", func(t *testing.T) {
+		// given
+		provisionerClient := fixProvisionerClientWithKubeconfig()
+		defer provisionerClient.AssertExpectations(t)
+		cli := fixFakeClientWithTokens("issued-token")
+
+		manager := NewBindingManager(provisionerClient, NewBuilder(provisionerClient), fixClientProvider(cli))
+
+		// when
+		binding, err := manager.Create(context.Background(), fixBindingInstance(), bindingID, "view", time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, bindingID, binding.ID)
+		assert.Equal(t, "view", binding.Role)
+		assert.Equal(t, "kyma-binding-5d6a2f3e-binding", binding.ServiceAccountName)
+		assert.Contains(t, binding.Kubeconfig, "token: issued-token")
+		assert.Contains(t, binding.Kubeconfig, "user: kyma-binding-5d6a2f3e-binding")
+		assert.WithinDuration(t, time.Now().Add(time.Hour), binding.ExpiresAt, time.Minute)
+
+		sa, err := cli.CoreV1().ServiceAccounts(BindingNamespace).Get(context.Background(), "kyma-binding-5d6a2f3e-binding", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, bindingID, sa.Labels[bindingIDLabel])
+
+		crb, err := cli.RbacV1().ClusterRoleBindings().Get(context.Background(), "kyma-binding-5d6a2f3e-binding", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "view", crb.RoleRef.Name)
+		assert.Equal(t, "kyma-binding-5d6a2f3e-binding", crb.Subjects[0].Name)
+	})
+
+	t.Run("should return error when provisioner does not return kubeconfig", func(t *testing.T) {
+		// given
+		provisionerClient := &automock.Client{}
+		provisionerClient.On("RuntimeStatus", globalAccountID, runtimeID).Return(schema.RuntimeStatus{}, fmt.Errorf("cannot return kubeconfig"))
+		defer provisionerClient.AssertExpectations(t)
+
+		manager := NewBindingManager(provisionerClient, NewBuilder(provisionerClient), fixClientProvider(fake.NewSimpleClientset()))
+
+		// when
+		_, err := manager.Create(context.Background(), fixBindingInstance(), bindingID, "view", time.Hour)
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "while fetching runtime status from provisioner")
+	})
+}
+
+func TestBindingManager_Revoke(t *testing.T) {
+	// given
+	provisionerClient := fixProvisionerClientWithKubeconfig()
+	cli := fixFakeClientWithTokens("issued-token")
+	manager := NewBindingManager(provisionerClient, NewBuilder(provisionerClient), fixClientProvider(cli))
+	_, err := manager.Create(context.Background(), fixBindingInstance(), bindingID, "view", time.Hour)
+	require.NoError(t, err)
+
+	// when
+	err = manager.Revoke(context.Background(), fixBindingInstance(), bindingID)
+
+	// then
+	require.NoError(t, err)
+	_, err = cli.CoreV1().ServiceAccounts(BindingNamespace).Get(context.Background(), "kyma-binding-5d6a2f3e-binding", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+	_, err = cli.RbacV1().ClusterRoleBindings().Get(context.Background(), "kyma-binding-5d6a2f3e-binding", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// revoking already removed binding is not an error
+	err = manager.Revoke(context.Background(), fixBindingInstance(), bindingID)
+	require.NoError(t, err)
+}
+
+func fixBindingInstance() *internal.Instance {
+	return &internal.Instance{
+		RuntimeID:       runtimeID,
+		GlobalAccountID: globalAccountID,
+	}
+}
+
+func fixProvisionerClientWithKubeconfig() *automock.Client {
+	provisionerClient := &automock.Client{}
+	provisionerClient.On("RuntimeStatus", globalAccountID, runtimeID).Return(schema.RuntimeStatus{
+		RuntimeConfiguration: &schema.RuntimeConfig{
+			Kubeconfig: skrKubeconfig(),
+		},
+	}, nil)
+	return provisionerClient
+}
+
+func fixFakeClientWithTokens(token string) *fake.Clientset {
+	cli := fake.NewSimpleClientset()
+	cli.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		request := action.(k8stesting.CreateAction).GetObject().(*authv1.TokenRequest)
+		request.Status = authv1.TokenRequestStatus{
+			Token:               token,
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(time.Duration(*request.Spec.ExpirationSeconds) * time.Second)),
+		}
+		return true, request, nil
+	})
+	return cli
+}
+
+func fixClientProvider(cli kubernetes.Interface) func(string) (kubernetes.Interface, error) {
+	return func(string) (kubernetes.Interface, error) {
+		return cli, nil
+	}
+}
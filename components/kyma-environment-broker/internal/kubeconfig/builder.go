@@ -33,6 +33,14 @@ type kubeconfigData struct {
 	OIDCClientID  string
 }
 
+type tokenKubeconfigData struct {
+	ContextName string
+	CAData      string
+	ServerURL   string
+	UserName    string
+	Token       string
+}
+
 func (b *Builder) BuildFromAdminKubeconfig(instance *internal.Instance, adminKubeconfig string) (string, error) {
 	status, err := b.provisionerClient.RuntimeStatus(instance.GlobalAccountID, instance.RuntimeID)
 	if err != nil {
@@ -68,7 +76,37 @@ func (b *Builder) Build(instance *internal.Instance) (string, error) {
 	return b.BuildFromAdminKubeconfig(instance, "")
 }
 
+// BuildWithToken creates a kubeconfig for the cluster described by the admin kubeconfig
+// which authenticates with the given service account token instead of OIDC
+func (b *Builder) BuildWithToken(adminKubeconfig, userName, token string) (string, error) {
+	var kubeCfg kubeconfig
+	err := yaml.Unmarshal([]byte(adminKubeconfig), &kubeCfg)
+	if err != nil {
+		return "", errors.Wrapf(err, "while unmarshaling kubeconfig")
+	}
+
+	if err := b.validKubeconfig(kubeCfg); err != nil {
+		return "", errors.Wrap(err, "while validation kubeconfig fetched by provisioner")
+	}
+
+	return b.parseTokenTemplate(tokenKubeconfigData{
+		ContextName: kubeCfg.CurrentContext,
+		CAData:      kubeCfg.Clusters[0].Cluster.CertificateAuthorityData,
+		ServerURL:   kubeCfg.Clusters[0].Cluster.Server,
+		UserName:    userName,
+		Token:       token,
+	})
+}
+
 func (b *Builder) parseTemplate(payload kubeconfigData) (string, error) {
+	return b.executeTemplate(kubeconfigTemplate, payload)
+}
+
+func (b *Builder) parseTokenTemplate(payload tokenKubeconfigData) (string, error) {
+	return b.executeTemplate(tokenKubeconfigTemplate, payload)
+}
+
+func (b *Builder) executeTemplate(kubeconfigTemplate string, payload interface{}) (string, error) {
 	var result bytes.Buffer
 	t := template.New("kubeconfigParser")
 	t, err := t.Parse(kubeconfigTemplate)
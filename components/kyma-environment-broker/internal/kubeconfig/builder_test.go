@@ -137,6 +137,51 @@ func TestBuilder_BuildFromAdminKubeconfig(t *testing.T) {
 	})
 }
 
+func TestBuilder_BuildWithToken(t *testing.T) {
+	t.Run("new kubeconfig was build properly", func(t *testing.T) {
+		// given
+		builder := NewBuilder(&automock.Client{})
+
+		// when
+		kubeconfig, err := builder.BuildWithToken(*skrKubeconfig(), "binding-sa", "token-value")
+
+		//then
+		require.NoError(t, err)
+		require.Equal(t, `
+---
+apiVersion: v1
+kind: Config
+current-context: shoot--kyma-dev--ac0d8d9
+clusters:
+- name: shoot--kyma-dev--ac0d8d9
+  cluster:
+    certificate-authority-data: LS0tLS1CRUdJTiBDRVJUSUZJQ0FURUSUZJQ0FURS0tLS0tCg==
+    server: https://api.ac0d8d9.kyma-dev.shoot.canary.k8s-hana.ondemand.com
+contexts:
+- name: shoot--kyma-dev--ac0d8d9
+  context:
+    cluster: shoot--kyma-dev--ac0d8d9
+    user: binding-sa
+users:
+- name: binding-sa
+  user:
+    token: token-value
+`, kubeconfig)
+	})
+
+	t.Run("admin kubeconfig is not valid", func(t *testing.T) {
+		// given
+		builder := NewBuilder(&automock.Client{})
+
+		// when
+		_, err := builder.BuildWithToken(*skrWrongKubeconfig(), "binding-sa", "token-value")
+
+		//then
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "while validation kubeconfig fetched by provisioner")
+	})
+}
+
 func skrKubeconfig() *string {
 	kc := `
 ---
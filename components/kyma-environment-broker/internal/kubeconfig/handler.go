@@ -112,7 +112,7 @@ func (h *Handler) GetKubeconfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var newKubeconfig string
-	if instance.ServicePlanID == broker.OwnClusterPlanID {
+	if broker.BasePlanID(instance.ServicePlanID) == broker.OwnClusterPlanID {
 		newKubeconfig, err = h.kubeconfigBuilder.BuildFromAdminKubeconfig(instance, instance.InstanceDetails.Kubeconfig)
 	} else {
 		newKubeconfig, err = h.kubeconfigBuilder.Build(instance)
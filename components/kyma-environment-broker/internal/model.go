@@ -268,6 +268,11 @@ func (o *Orchestration) IsFinished() bool {
 	return o.State == orchestration.Succeeded || o.State == orchestration.Failed || o.State == orchestration.Canceled
 }
 
+// IsPaused returns true if orchestration is paused and must not start new operations
+func (o *Orchestration) IsPaused() bool {
+	return o.State == orchestration.Paused
+}
+
 // IsCanceled returns true if orchestration's cancellation endpoint was ever triggered
 func (o *Orchestration) IsCanceled() bool {
 	return o.State == orchestration.Canceling || o.State == orchestration.Canceled
@@ -338,6 +343,9 @@ type UpgradeKymaOperation struct {
 // UpgradeClusterOperation holds all information about upgrade cluster (shoot) operation
 type UpgradeClusterOperation struct {
 	Operation
+
+	// ClusterUpgradeDiff is computed by the dry run, it contains the changes of the cluster configuration which the upgrade applies
+	ClusterUpgradeDiff *orchestration.ClusterUpgradeDiff `json:"cluster_upgrade_diff,omitempty"`
 }
 
 func NewRuntimeState(runtimeID, operationID string, kymaConfig *gqlschema.KymaConfigInput, clusterConfig *gqlschema.GardenerConfigInput) RuntimeState {
@@ -436,6 +444,50 @@ func (r *RuntimeState) buildKymaConfigFromClusterSetup() gqlschema.KymaConfigInp
 	return kymaConfig
 }
 
+// Binding holds all information about a service binding created for an instance.
+// The kubeconfig is issued for a service account created in the SKR and is valid until ExpiresAt.
+type Binding struct {
+	ID         string
+	InstanceID string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+
+	Role               string
+	ServiceAccountName string
+	Kubeconfig         string
+}
+
+// IsExpired returns true if the credentials of the binding are no longer valid
+func (b *Binding) IsExpired() bool {
+	return time.Now().After(b.ExpiresAt)
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// WebhookDelivery is the log entry of a notification sent to a webhook about an operation lifecycle event
+type WebhookDelivery struct {
+	ID          string
+	Webhook     string
+	Event       string
+	OperationID string
+	InstanceID  string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Payload      string
+	State        string
+	Attempts     int
+	ResponseCode int
+	LastError    string
+}
+
 // OperationStats provide number of operations per type and state
 type OperationStats struct {
 	Provisioning   map[domain.LastOperationState]int
@@ -537,7 +589,16 @@ func NewUpdateOperation(operationID string, instance *Instance, updatingParams U
 		op.ProvisioningParameters.Parameters.RuntimeAdministrators = updatingParams.RuntimeAdministrators
 	}
 
+	if updatingParams.AdditionalWorkerNodePools != nil {
+		op.ProvisioningParameters.Parameters.AdditionalWorkerNodePools = updatingParams.AdditionalWorkerNodePools
+	}
+
+	if updatingParams.HibernationSchedules != nil {
+		op.ProvisioningParameters.Parameters.HibernationSchedules = updatingParams.HibernationSchedules
+	}
+
 	updatingParams.UpdateAutoScaler(&op.ProvisioningParameters.Parameters)
+	updatingParams.UpdateMachine(&op.ProvisioningParameters.Parameters)
 
 	return op
 }
@@ -0,0 +1,81 @@
+package networking
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+)
+
+const (
+	// DefaultNodesCIDR is the CIDR of the nodes used when the networking parameters are not provided
+	DefaultNodesCIDR = "10.250.0.0/16"
+	// DefaultPodsCIDR and DefaultServicesCIDR are the CIDRs set by Gardener when the shoot does not define them
+	DefaultPodsCIDR     = "100.64.0.0/12"
+	DefaultServicesCIDR = "100.104.0.0/13"
+
+	// the nodes CIDR is split into the subnets of the zones, the smallest network leaves 64 addresses
+	// for the AWS worker subnet of the zone
+	MaxNodesPrefixLength    = 23
+	MaxPodsPrefixLength     = 16
+	MaxServicesPrefixLength = 20
+)
+
+// Validate checks if the CIDRs are valid IPv4 networks, large enough for the cluster and not overlapping,
+// the Gardener defaults are used for the pods and the services CIDRs if not provided
+func Validate(nodes string, pods, services *string) error {
+	networks := []struct {
+		name            string
+		cidr            string
+		maxPrefixLength int
+	}{
+		{name: "nodes", cidr: nodes, maxPrefixLength: MaxNodesPrefixLength},
+		{name: "pods", cidr: valueOrDefault(pods, DefaultPodsCIDR), maxPrefixLength: MaxPodsPrefixLength},
+		{name: "services", cidr: valueOrDefault(services, DefaultServicesCIDR), maxPrefixLength: MaxServicesPrefixLength},
+	}
+
+	parsed := make([]*net.IPNet, 0, len(networks))
+	for _, n := range networks {
+		ip, network, err := net.ParseCIDR(n.cidr)
+		if err != nil || ip.To4() == nil {
+			return fmt.Errorf("%s CIDR %q is not a valid IPv4 CIDR", n.name, n.cidr)
+		}
+		if !ip.Equal(network.IP) {
+			return fmt.Errorf("%s CIDR %q must be the network address, for example %s", n.name, n.cidr, network)
+		}
+		if ones, _ := network.Mask.Size(); ones > n.maxPrefixLength {
+			return fmt.Errorf("%s CIDR %s is too small, the prefix length must not be greater than %d", n.name, network, n.maxPrefixLength)
+		}
+		for j, other := range parsed {
+			if overlaps(network, other) {
+				return fmt.Errorf("%s CIDR %s overlaps with %s CIDR %s", n.name, network, networks[j].name, other)
+			}
+		}
+		parsed = append(parsed, network)
+	}
+
+	return nil
+}
+
+// Subnet returns the num-th subnet of the network extended by newBits, for example
+// the subnet 2 of 10.250.0.0/16 extended by 3 bits is 10.250.64.0/19
+func Subnet(network *net.IPNet, newBits, num int) *net.IPNet {
+	ones, bits := network.Mask.Size()
+	ip := new(big.Int).SetBytes(network.IP.To4())
+	offset := new(big.Int).Lsh(big.NewInt(int64(num)), uint(bits-ones-newBits))
+	ip.Add(ip, offset)
+
+	subnetIP := make(net.IP, net.IPv4len)
+	ip.FillBytes(subnetIP)
+	return &net.IPNet{IP: subnetIP, Mask: net.CIDRMask(ones+newBits, bits)}
+}
+
+func overlaps(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func valueOrDefault(value *string, defaultValue string) string {
+	if value == nil || *value == "" {
+		return defaultValue
+	}
+	return *value
+}
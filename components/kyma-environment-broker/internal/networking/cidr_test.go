@@ -0,0 +1,88 @@
+package networking
+
+import (
+	"net"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nodes    string
+		pods     *string
+		services *string
+		err      string
+	}{
+		"defaults": {
+			nodes: DefaultNodesCIDR,
+		},
+		"custom networks": {
+			nodes:    "10.180.0.0/20",
+			pods:     ptr.String("10.96.0.0/13"),
+			services: ptr.String("10.104.0.0/16"),
+		},
+		"empty pods and services": {
+			nodes:    "10.180.0.0/20",
+			pods:     ptr.String(""),
+			services: ptr.String(""),
+		},
+		"invalid nodes CIDR": {
+			nodes: "10.180.0.0",
+			err:   `nodes CIDR "10.180.0.0" is not a valid IPv4 CIDR`,
+		},
+		"IPv6 CIDR": {
+			nodes: "fd00::/64",
+			err:   `nodes CIDR "fd00::/64" is not a valid IPv4 CIDR`,
+		},
+		"not a network address": {
+			nodes: "10.180.1.0/16",
+			err:   `nodes CIDR "10.180.1.0/16" must be the network address, for example 10.180.0.0/16`,
+		},
+		"too small nodes network": {
+			nodes: "10.180.0.0/24",
+			err:   "nodes CIDR 10.180.0.0/24 is too small, the prefix length must not be greater than 23",
+		},
+		"too small pods network": {
+			nodes: DefaultNodesCIDR,
+			pods:  ptr.String("10.96.0.0/17"),
+			err:   "pods CIDR 10.96.0.0/17 is too small, the prefix length must not be greater than 16",
+		},
+		"pods overlap with nodes": {
+			nodes: "10.0.0.0/8",
+			pods:  ptr.String("10.96.0.0/13"),
+			err:   "pods CIDR 10.96.0.0/13 overlaps with nodes CIDR 10.0.0.0/8",
+		},
+		"services overlap with default pods": {
+			nodes:    DefaultNodesCIDR,
+			services: ptr.String("100.64.0.0/16"),
+			err:      "services CIDR 100.64.0.0/16 overlaps with pods CIDR 100.64.0.0/12",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := Validate(tc.nodes, tc.pods, tc.services)
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestSubnet(t *testing.T) {
+	// given
+	_, network, err := net.ParseCIDR("10.250.0.0/16")
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "10.250.0.0/18", Subnet(network, 2, 0).String())
+	assert.Equal(t, "10.250.64.0/19", Subnet(network, 3, 2).String())
+	assert.Equal(t, "10.250.224.0/19", Subnet(network, 3, 7).String())
+	assert.Equal(t, "10.250.48.0/20", Subnet(Subnet(network, 2, 0), 2, 3).String())
+}
@@ -56,6 +56,45 @@ func TestClusterHandler_AttachRoutes(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotEmpty(t, out.OrchestrationID)
 	})
+
+	t.Run("upgrade with invalid canary strategy", func(t *testing.T) {
+		// given
+		handler := fixClusterHandler(t)
+
+		params := orchestration.Parameters{
+			Targets: orchestration.TargetSpec{
+				Include: []orchestration.RuntimeTarget{
+					{
+						RuntimeID: "test",
+					},
+				},
+			},
+			Strategy: orchestration.StrategySpec{
+				Type:     orchestration.CanaryStrategy,
+				Schedule: "now",
+				Canary: orchestration.CanaryStrategySpec{
+					FirstWaveSize:    1,
+					WaveSize:         10,
+					FailureThreshold: 1.5,
+				},
+			},
+		}
+		p, err := json.Marshal(&params)
+		require.NoError(t, err)
+
+		req, err := http.NewRequest("POST", "/upgrade/cluster", bytes.NewBuffer(p))
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		handler.AttachRoutes(router)
+
+		// when
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
 }
 
 func fixClusterHandler(t *testing.T) *clusterHandler {
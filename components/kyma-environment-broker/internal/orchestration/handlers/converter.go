@@ -49,7 +49,7 @@ func (c *Converter) UpgradeKymaOperationToDTO(op internal.UpgradeKymaOperation)
 		SubAccountID:           op.RuntimeOperation.SubAccountID,
 		OrchestrationID:        op.OrchestrationID,
 		ServicePlanID:          op.ProvisioningParameters.PlanID,
-		ServicePlanName:        broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		ServicePlanName:        broker.PlanNameByID(op.ProvisioningParameters.PlanID),
 		DryRun:                 op.DryRun,
 		ShootName:              op.RuntimeOperation.ShootName,
 		MaintenanceWindowBegin: op.MaintenanceWindowBegin,
@@ -96,13 +96,14 @@ func (c *Converter) UpgradeClusterOperationToDTO(op internal.UpgradeClusterOpera
 		SubAccountID:           op.RuntimeOperation.SubAccountID,
 		OrchestrationID:        op.OrchestrationID,
 		ServicePlanID:          op.ProvisioningParameters.PlanID,
-		ServicePlanName:        broker.PlanNamesMapping[op.ProvisioningParameters.PlanID],
+		ServicePlanName:        broker.PlanNameByID(op.ProvisioningParameters.PlanID),
 		DryRun:                 op.DryRun,
 		ShootName:              op.RuntimeOperation.ShootName,
 		MaintenanceWindowBegin: op.MaintenanceWindowBegin,
 		MaintenanceWindowEnd:   op.MaintenanceWindowEnd,
 		State:                  string(op.Operation.State),
 		Description:            op.Operation.Description,
+		ClusterUpgradeDiff:     op.ClusterUpgradeDiff,
 	}, nil
 }
 
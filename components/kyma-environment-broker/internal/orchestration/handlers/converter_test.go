@@ -3,6 +3,7 @@ package handlers_test
 import (
 	"testing"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/orchestration/handlers"
@@ -112,6 +113,9 @@ func TestConverter_UpgradeClusterOperationToDTO(t *testing.T) {
 
 	id := "id"
 	givenOperation := fixUpgradeClusterOperation(id)
+	givenOperation.ClusterUpgradeDiff = &orchestration.ClusterUpgradeDiff{
+		KubernetesVersion: orchestration.ValueDiff{Current: "1.24.8", Target: "1.25.4"},
+	}
 
 	// when
 	resp, err := c.UpgradeClusterOperationToDTO(givenOperation)
@@ -119,6 +123,7 @@ func TestConverter_UpgradeClusterOperationToDTO(t *testing.T) {
 	// then
 	require.NoError(t, err)
 	assert.Equal(t, id, resp.OrchestrationID)
+	assert.Equal(t, givenOperation.ClusterUpgradeDiff, resp.ClusterUpgradeDiff)
 }
 
 func TestConverter_UpgradeClusterOperationListToDTO(t *testing.T) {
@@ -67,3 +67,25 @@ func ValidateScheduleParameter(params *orchestration.Parameters) error {
 	}
 	return nil
 }
+
+// ValidateStrategyParameter checks if the parameters of the canary strategy are valid and sets the default halt action.
+func ValidateStrategyParameter(params *orchestration.Parameters) error {
+	if params.Strategy.Type != orchestration.CanaryStrategy {
+		return nil
+	}
+	canary := &params.Strategy.Canary
+	if canary.FirstWaveSize < 1 || canary.WaveSize < 1 {
+		return fmt.Errorf("the canary firstWaveSize and waveSize must be greater than 0")
+	}
+	if canary.FailureThreshold < 0 || canary.FailureThreshold > 1 {
+		return fmt.Errorf("the canary failureThreshold must be a ratio between 0 and 1")
+	}
+	switch canary.OnFailure {
+	case "":
+		canary.OnFailure = orchestration.CanaryHaltCancel
+	case orchestration.CanaryHaltCancel, orchestration.CanaryHaltPause:
+	default:
+		return fmt.Errorf("the canary onFailure action %q is not supported", canary.OnFailure)
+	}
+	return nil
+}
@@ -90,6 +90,14 @@ func (h *kymaHandler) createOrchestration(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// validate `strategy` field
+	err = ValidateStrategyParameter(&params)
+	if err != nil {
+		h.log.Errorf("while validating strategy: %v", err)
+		httputil.WriteErrorResponse(w, http.StatusBadRequest, errors.Wrapf(err, "while validating strategy"))
+		return
+	}
+
 	now := time.Now()
 	o := internal.Orchestration{
 		OrchestrationID: uuid.New().String(),
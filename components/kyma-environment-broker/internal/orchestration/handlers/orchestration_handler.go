@@ -30,6 +30,7 @@ type orchestrationHandler struct {
 	log       logrus.FieldLogger
 
 	canceler       *Canceler
+	pauser         *Pauser
 	kymaRetryer    *kymaRetryer
 	clusterRetryer *clusterRetryer
 
@@ -52,6 +53,7 @@ func NewOrchestrationStatusHandler(operations storage.Operations,
 		defaultMaxPage: defaultMaxPage,
 		converter:      Converter{},
 		canceler:       NewCanceler(orchestrations, log),
+		pauser:         NewPauser(orchestrations, log),
 		kymaRetryer:    NewKymaRetryer(orchestrations, operations, kymaQueue, log),
 		clusterRetryer: NewClusterRetryer(orchestrations, operations, clusterQueue, log),
 	}
@@ -61,6 +63,8 @@ func (h *orchestrationHandler) AttachRoutes(router *mux.Router) {
 	router.HandleFunc("/orchestrations", h.listOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}", h.getOrchestration).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/cancel", h.cancelOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/pause", h.pauseOrchestrationByID).Methods(http.MethodPut)
+	router.HandleFunc("/orchestrations/{orchestration_id}/resume", h.resumeOrchestrationByID).Methods(http.MethodPut)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations", h.listOperations).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/operations/{operation_id}", h.getOperation).Methods(http.MethodGet)
 	router.HandleFunc("/orchestrations/{orchestration_id}/retry", h.retryOrchestrationByID).Methods(http.MethodPost)
@@ -108,6 +112,36 @@ func (h *orchestrationHandler) cancelOrchestrationByID(w http.ResponseWriter, r
 	httputil.WriteResponse(w, http.StatusOK, response)
 }
 
+func (h *orchestrationHandler) pauseOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.PauseForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while pausing orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while pausing orchestration %s", orchestrationID))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
+func (h *orchestrationHandler) resumeOrchestrationByID(w http.ResponseWriter, r *http.Request) {
+	orchestrationID := mux.Vars(r)["orchestration_id"]
+
+	err := h.pauser.ResumeForID(orchestrationID)
+	if err != nil {
+		h.log.Errorf("while resuming orchestration %s: %v", orchestrationID, err)
+		httputil.WriteErrorResponse(w, h.resolveErrorStatus(err), errors.Wrapf(err, "while resuming orchestration %s", orchestrationID))
+		return
+	}
+
+	response := commonOrchestration.UpgradeResponse{OrchestrationID: orchestrationID}
+
+	httputil.WriteResponse(w, http.StatusOK, response)
+}
+
 func (h *orchestrationHandler) retryOrchestrationByID(w http.ResponseWriter, r *http.Request) {
 	contentType := r.Header.Get("Content-type")
 	if contentType != "application/x-www-form-urlencoded" {
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"time"
+
+	orchestrationExt "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+type Pauser struct {
+	orchestrations storage.Orchestrations
+	log            logrus.FieldLogger
+}
+
+func NewPauser(orchestrations storage.Orchestrations, logger logrus.FieldLogger) *Pauser {
+	return &Pauser{
+		orchestrations: orchestrations,
+		log:            logger,
+	}
+}
+
+// PauseForID pauses orchestration by ID, the operations which are already in progress are not affected
+func (p *Pauser) PauseForID(orchestrationID string) error {
+	o, err := p.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if o.State == orchestrationExt.Paused {
+		return nil
+	}
+	if o.State != orchestrationExt.InProgress {
+		return apiErrors.NewBadRequest(fmt.Sprintf("orchestration in state %s cannot be paused", o.State))
+	}
+
+	o.UpdatedAt = time.Now()
+	o.Description = "Orchestration was paused"
+	o.State = orchestrationExt.Paused
+	err = p.orchestrations.Update(*o)
+	if err != nil {
+		return errors.Wrap(err, "while updating orchestration")
+	}
+	return nil
+}
+
+// ResumeForID resumes paused orchestration by ID
+func (p *Pauser) ResumeForID(orchestrationID string) error {
+	o, err := p.orchestrations.GetByID(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if o.State == orchestrationExt.InProgress {
+		return nil
+	}
+	if o.State != orchestrationExt.Paused {
+		return apiErrors.NewBadRequest(fmt.Sprintf("orchestration in state %s cannot be resumed", o.State))
+	}
+
+	o.UpdatedAt = time.Now()
+	o.Description = "Orchestration was resumed"
+	o.State = orchestrationExt.InProgress
+	err = p.orchestrations.Update(*o)
+	if err != nil {
+		return errors.Wrap(err, "while updating orchestration")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPauser_PauseForID(t *testing.T) {
+	t.Run("should pause and resume orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		err := s.Orchestrations().Insert(fixOrchestration())
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		o, err := s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Paused, o.State)
+
+		err = p.ResumeForID(fixOrchestrationID)
+		require.NoError(t, err)
+
+		o, err = s.Orchestrations().GetByID(fixOrchestrationID)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.InProgress, o.State)
+	})
+	t.Run("should not pause finished orchestration", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Succeeded
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.PauseForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should not resume orchestration which is not paused", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		o := fixOrchestration()
+		o.State = orchestration.Canceling
+		err := s.Orchestrations().Insert(o)
+		require.NoError(t, err)
+
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err = p.ResumeForID(fixOrchestrationID)
+		assert.True(t, apiErrors.IsBadRequest(err))
+	})
+	t.Run("should return error when orchestration not found", func(t *testing.T) {
+		s := storage.NewMemoryStorage()
+		p := NewPauser(s.Orchestrations(), logrus.New())
+
+		err := p.PauseForID(fixOrchestrationID)
+		assert.Error(t, err)
+	})
+}
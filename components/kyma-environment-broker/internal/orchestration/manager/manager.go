@@ -67,6 +67,11 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		}
 		return m.failOrchestration(o, errors.Wrap(err, "while getting orchestration"))
 	}
+	// do not dispatch any operation until the paused orchestration is resumed
+	if o.IsPaused() {
+		logger.Infof("Orchestration is paused, waiting to be resumed")
+		return m.pollingInterval, nil
+	}
 
 	maintenancePolicy, err := m.getMaintenancePolicy()
 	if err != nil {
@@ -89,7 +94,7 @@ func (m *orchestrationManager) Execute(orchestrationID string) (time.Duration, e
 		return 0, nil
 	}
 
-	strategy := m.resolveStrategy(o.Parameters.Strategy.Type, m.executor, logger)
+	strategy := m.resolveStrategy(o, m.executor, logger)
 
 	// ctreate notification after orchestration resolved
 	if !m.bundleBuilder.DisabledCheck() {
@@ -270,22 +275,65 @@ func (m *orchestrationManager) resolveOperations(o *internal.Orchestration, poli
 	return result, nil
 }
 
-func (m *orchestrationManager) resolveStrategy(sType orchestration.StrategyType, executor orchestration.OperationExecutor, log logrus.FieldLogger) orchestration.Strategy {
-	switch sType {
+func (m *orchestrationManager) resolveStrategy(o *internal.Orchestration, executor orchestration.OperationExecutor, log logrus.FieldLogger) orchestration.Strategy {
+	var s orchestration.Strategy
+	switch o.Parameters.Strategy.Type {
 	case orchestration.ParallelStrategy:
-		s := strategies.NewParallelOrchestrationStrategy(executor, log, 0)
-		if m.speedFactor != 0 {
-			s.SpeedUp(m.speedFactor)
+		s = strategies.NewParallelOrchestrationStrategy(executor, log, 0)
+	case orchestration.CanaryStrategy:
+		s = strategies.NewCanaryOrchestrationStrategy(executor, &operationStateProvider{operations: m.operationStorage}, m.haltOrchestration(o.OrchestrationID, log), log, 0)
+	default:
+		return nil
+	}
+	if m.speedFactor != 0 {
+		s.SpeedUp(m.speedFactor)
+	}
+	return s
+}
+
+// haltOrchestration returns a function which cancels or pauses the orchestration on behalf of the strategy, e.g. when
+// the failure threshold of the canary strategy is exceeded
+func (m *orchestrationManager) haltOrchestration(orchestrationID string, log logrus.FieldLogger) func(reason string) {
+	return func(reason string) {
+		log.Infof("Halting orchestration: %s", reason)
+		o, err := m.orchestrationStorage.GetByID(orchestrationID)
+		if err != nil {
+			log.Errorf("while getting orchestration to halt: %v", err)
+			return
+		}
+		if o.State != orchestration.InProgress {
+			return
+		}
+		o.State = orchestration.Canceling
+		if o.Parameters.Strategy.Canary.OnFailure == orchestration.CanaryHaltPause {
+			o.State = orchestration.Paused
+		}
+		o.Description = reason
+		o.UpdatedAt = time.Now()
+		err = m.orchestrationStorage.Update(*o)
+		if err != nil {
+			log.Errorf("while updating orchestration to halt: %v", err)
 		}
-		return s
 	}
-	return nil
+}
+
+type operationStateProvider struct {
+	operations storage.Operations
+}
+
+func (p *operationStateProvider) OperationState(operationID string) (string, error) {
+	op, err := p.operations.GetOperationByID(operationID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while getting operation %s", operationID)
+	}
+	return string(op.State), nil
 }
 
 // waitForCompletion waits until processing of given orchestration ends or if it's canceled
 func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, strategy orchestration.Strategy, execID string, log logrus.FieldLogger) (*internal.Orchestration, error) {
 	orchestrationID := o.OrchestrationID
 	canceled := false
+	paused := false
 	var err error
 	var stats map[string]int
 	execIDs := []string{execID}
@@ -299,6 +347,16 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 				log.Info("Orchestration was canceled")
 				canceled = true
 			}
+			if o.IsPaused() != paused {
+				paused = o.IsPaused()
+				for _, id := range execIDs {
+					if paused {
+						strategy.Pause(id)
+					} else {
+						strategy.Resume(id)
+					}
+				}
+			}
 		case dberr.IsNotFound(err):
 			log.Errorf("while getting orchestration: %v", err)
 			return false, err
@@ -347,6 +405,9 @@ func (m *orchestrationManager) waitForCompletion(o *internal.Orchestration, stra
 				}
 				execIDs = append(execIDs, retryExecID)
 				execID = retryExecID
+				if paused {
+					strategy.Pause(retryExecID)
+				}
 			}
 			o.Description = updateRetryingDescription(o.Description, fmt.Sprintf("retried %d operations", len(o.Parameters.RetryOperation.RetryOperations)))
 			o.Parameters.RetryOperation.RetryOperations = nil
@@ -307,6 +307,58 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		assert.Equal(t, orchestration.Canceled, string(op.State))
 	})
 
+	t.Run("Paused", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Paused,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{Strategy: orchestration.StrategySpec{
+				Type:     orchestration.ParallelStrategy,
+				Schedule: time.Now().Format(time.RFC3339),
+			}},
+		})
+		require.NoError(t, err)
+		err = store.Operations().InsertUpgradeKymaOperation(internal.UpgradeKymaOperation{
+			Operation: internal.Operation{
+				ID:              id,
+				OrchestrationID: id,
+				State:           orchestration.Pending,
+				RuntimeOperation: orchestration.RuntimeOperation{
+					Runtime: orchestration.Runtime{
+						RuntimeID:    id,
+						SubAccountID: "sub",
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &failingTestExecutor{store: store},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, &notificationAutomock.BundleBuilder{}, 1000)
+
+		// when
+		when, err := svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, poolingInterval, when)
+
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Paused, o.State)
+
+		op, err := store.Operations().GetUpgradeKymaOperationByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Pending, string(op.State))
+	})
+
 	t.Run("Retrying failed orchestration", func(t *testing.T) {
 		// given
 		store := storage.NewMemoryStorage()
@@ -577,6 +629,141 @@ func TestUpgradeKymaManager_Execute(t *testing.T) {
 		}
 	})
 
+	t.Run("Canary halted after failed first wave", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		var runtimes []orchestration.Runtime
+		for _, suffix := range []string{"a", "b", "c"} {
+			runtime := orchestration.Runtime{InstanceID: "instance-" + suffix, RuntimeID: "runtime-" + suffix}
+			runtimes = append(runtimes, runtime)
+			err := store.Instances().Insert(internal.Instance{InstanceID: runtime.InstanceID, RuntimeID: runtime.RuntimeID})
+			require.NoError(t, err)
+		}
+		resolver.On("Resolve", orchestration.TargetSpec{}).Return(runtimes, nil)
+
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Pending,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Canary: orchestration.CanaryStrategySpec{
+						Workers:          1,
+						FirstWaveSize:    1,
+						WaveSize:         2,
+						FailureThreshold: 0.5,
+						OnFailure:        orchestration.CanaryHaltCancel,
+					},
+				},
+				Kyma: &orchestration.KymaParameters{Version: ""},
+			},
+		})
+		require.NoError(t, err)
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		notificationBuilder.On("DisabledCheck").Return(true)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &failingTestExecutor{store: store},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+
+		// when
+		_, err = svc.Execute(id)
+		require.NoError(t, err)
+
+		// then
+		o, err := store.Orchestrations().GetByID(id)
+		require.NoError(t, err)
+		assert.Equal(t, orchestration.Canceled, o.State)
+		assert.Contains(t, o.Description, "canary wave 1 exceeded failure threshold")
+
+		stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats[orchestration.Failed])
+		assert.Equal(t, 2, stats[orchestration.Canceled])
+	})
+
+	t.Run("Canary paused after failed first wave and resumed", func(t *testing.T) {
+		// given
+		store := storage.NewMemoryStorage()
+
+		resolver := &automock.RuntimeResolver{}
+		defer resolver.AssertExpectations(t)
+
+		id := "id"
+		var runtimes []orchestration.Runtime
+		for _, suffix := range []string{"a", "b", "c"} {
+			runtime := orchestration.Runtime{InstanceID: "instance-" + suffix, RuntimeID: "runtime-" + suffix}
+			runtimes = append(runtimes, runtime)
+			err := store.Instances().Insert(internal.Instance{InstanceID: runtime.InstanceID, RuntimeID: runtime.RuntimeID})
+			require.NoError(t, err)
+		}
+		resolver.On("Resolve", orchestration.TargetSpec{}).Return(runtimes, nil)
+
+		err := store.Orchestrations().Insert(internal.Orchestration{
+			OrchestrationID: id,
+			State:           orchestration.Pending,
+			Type:            orchestration.UpgradeKymaOrchestration,
+			Parameters: orchestration.Parameters{
+				Strategy: orchestration.StrategySpec{
+					Type:     orchestration.CanaryStrategy,
+					Schedule: time.Now().Format(time.RFC3339),
+					Canary: orchestration.CanaryStrategySpec{
+						Workers:          1,
+						FirstWaveSize:    1,
+						WaveSize:         2,
+						FailureThreshold: 0.5,
+						OnFailure:        orchestration.CanaryHaltPause,
+					},
+				},
+				Kyma: &orchestration.KymaParameters{Version: ""},
+			},
+		})
+		require.NoError(t, err)
+
+		notificationBuilder := &notificationAutomock.BundleBuilder{}
+		notificationBuilder.On("DisabledCheck").Return(true)
+
+		svc := manager.NewUpgradeKymaManager(store.Orchestrations(), store.Operations(), store.Instances(), &failingTestExecutor{store: store},
+			resolver, poolingInterval, logrus.New(), k8sClient, &orchestrationConfig, notificationBuilder, 1000)
+
+		// when
+		executed := make(chan error)
+		go func() {
+			_, err := svc.Execute(id)
+			executed <- err
+		}()
+
+		// then
+		var o *internal.Orchestration
+		require.Eventually(t, func() bool {
+			o, err = store.Orchestrations().GetByID(id)
+			return err == nil && o.State == orchestration.Paused
+		}, time.Second, poolingInterval)
+		time.Sleep(5 * poolingInterval)
+		stats, err := store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 1, stats[orchestration.Failed])
+		assert.Equal(t, 2, stats[orchestration.Pending])
+
+		// when
+		o.State = orchestration.InProgress
+		err = store.Orchestrations().Update(*o)
+		require.NoError(t, err)
+
+		// then
+		require.NoError(t, <-executed)
+		stats, err = store.Operations().GetOperationStatsForOrchestration(id)
+		require.NoError(t, err)
+		assert.Equal(t, 3, stats[orchestration.Failed])
+	})
+
 	t.Run("Retrying --now failed orchestration with `--schedule maintancewindow`  and create a new operation on same instanceID", func(t *testing.T) {
 		// given
 		store := storage.NewMemoryStorage()
@@ -843,3 +1030,22 @@ func (t *retryTestExecutor) Execute(opID string) (time.Duration, error) {
 func (t *retryTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
 	return nil
 }
+
+type failingTestExecutor struct {
+	store storage.BrokerStorage
+}
+
+func (t *failingTestExecutor) Execute(opID string) (time.Duration, error) {
+	op, err := t.store.Operations().GetUpgradeKymaOperationByID(opID)
+	if err != nil {
+		return 0, err
+	}
+	op.State = orchestration.Failed
+	_, err = t.store.Operations().UpdateUpgradeKymaOperation(*op)
+
+	return 0, err
+}
+
+func (t *failingTestExecutor) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
+	return nil
+}
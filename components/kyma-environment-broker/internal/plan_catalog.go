@@ -0,0 +1,100 @@
+package internal
+
+import "strings"
+
+// PlanCatalog describes the service plans offered by KEB, their regions, machine types and schema defaults
+type PlanCatalog struct {
+	// Version is the version of the catalog format
+	Version int           `json:"version" yaml:"version"`
+	Plans   []CatalogPlan `json:"plans" yaml:"plans"`
+}
+
+type CatalogPlan struct {
+	ID   string `json:"id" yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	// BasePlan is the name of the built-in plan which defines how the runtimes of the plan are provisioned.
+	// It is required for the plans which are not built into KEB and must be empty for the built-in ones.
+	BasePlan string `json:"basePlan,omitempty" yaml:"basePlan,omitempty"`
+
+	Regions []string `json:"regions,omitempty" yaml:"regions,omitempty"`
+	// ProviderRegions overrides the regions for the given platform provider, for example, "aws" or "azure"
+	ProviderRegions map[string][]string `json:"providerRegions,omitempty" yaml:"providerRegions,omitempty"`
+
+	// MachineTypes are the machine types allowed for the plan, all of them can be chosen in the update
+	MachineTypes []CatalogMachineType `json:"machineTypes,omitempty" yaml:"machineTypes,omitempty"`
+	// ProvisioningMachineTypes limits the machine types which can be chosen in the provisioning, all are allowed if empty
+	ProvisioningMachineTypes []string `json:"provisioningMachineTypes,omitempty" yaml:"provisioningMachineTypes,omitempty"`
+
+	AutoScaler CatalogAutoScaler `json:"autoScaler,omitempty" yaml:"autoScaler,omitempty"`
+}
+
+type CatalogMachineType struct {
+	Name        string `json:"name" yaml:"name"`
+	DisplayName string `json:"displayName,omitempty" yaml:"displayName,omitempty"`
+}
+
+// CatalogAutoScaler overrides the autoscaler defaults of the schema, zero values keep the defaults
+type CatalogAutoScaler struct {
+	// Min is the default value of the autoScalerMin parameter
+	Min int `json:"min,omitempty" yaml:"min,omitempty"`
+	// Max is the default value of the autoScalerMax parameter
+	Max int `json:"max,omitempty" yaml:"max,omitempty"`
+	// Maximum is the maximum allowed value of the autoScalerMax parameter
+	Maximum int `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+}
+
+func (c PlanCatalog) PlanByID(id string) (CatalogPlan, bool) {
+	for _, plan := range c.Plans {
+		if plan.ID == id {
+			return plan, true
+		}
+	}
+	return CatalogPlan{}, false
+}
+
+func (c PlanCatalog) PlanByName(name string) (CatalogPlan, bool) {
+	for _, plan := range c.Plans {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+	return CatalogPlan{}, false
+}
+
+// RegionsFor returns the regions of the plan for the given platform provider
+func (p CatalogPlan) RegionsFor(provider CloudProvider) []string {
+	if regions, ok := p.ProviderRegions[strings.ToLower(string(provider))]; ok {
+		return regions
+	}
+	return p.Regions
+}
+
+// MachineTypeNames returns the names of the machine types of the plan, limited to the provisioning ones if requested
+func (p CatalogPlan) MachineTypeNames(provisioning bool) []string {
+	allowed := map[string]bool{}
+	for _, name := range p.ProvisioningMachineTypes {
+		allowed[name] = true
+	}
+	var names []string
+	for _, machineType := range p.MachineTypes {
+		if provisioning && len(allowed) > 0 && !allowed[machineType.Name] {
+			continue
+		}
+		names = append(names, machineType.Name)
+	}
+	return names
+}
+
+// MachineTypesDisplay returns the display names of the machine types of the plan, limited to the provisioning ones if requested
+func (p CatalogPlan) MachineTypesDisplay(provisioning bool) map[string]string {
+	display := map[string]string{}
+	names := p.MachineTypeNames(provisioning)
+	for _, machineType := range p.MachineTypes {
+		for _, name := range names {
+			if machineType.Name == name && machineType.DisplayName != "" {
+				display[name] = machineType.DisplayName
+			}
+		}
+	}
+	return display
+}
@@ -52,7 +52,7 @@ func (s *BTPOperatorCleanupStep) Run(operation internal.Operation, log logrus.Fi
 		log.Info("cleanup executed only for suspensions")
 		return operation, 0, nil
 	}
-	if operation.ProvisioningParameters.PlanID != broker.TrialPlanID {
+	if broker.BasePlanID(operation.ProvisioningParameters.PlanID) != broker.TrialPlanID {
 		log.Info("cleanup executed only for trial plan")
 		return operation, 0, nil
 	}
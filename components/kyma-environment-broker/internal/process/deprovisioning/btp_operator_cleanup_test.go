@@ -296,6 +296,10 @@ func (f fakeProvisionerClient) RuntimeOperationStatus(accountID, operationID str
 	panic("not implemented")
 }
 
+func (f fakeProvisionerClient) HibernatedRuntimes(ctx context.Context, accountID string, runtimeIDs []string) (map[string]bool, error) {
+	panic("not implemented")
+}
+
 func (f fakeProvisionerClient) RuntimeStatus(accountID, runtimeID string) (gqlschema.RuntimeStatus, error) {
 	if f.empty {
 		return gqlschema.RuntimeStatus{}, fmt.Errorf("not found")
@@ -0,0 +1,78 @@
+package deprovisioning
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+
+	"github.com/sirupsen/logrus"
+)
+
+const bindingsRevocationTimeout = 30 * time.Minute
+
+// RemoveBindingsStep revokes the credentials of the service bindings in the SKR and removes the bindings from the storage
+type RemoveBindingsStep struct {
+	instanceStorage storage.Instances
+	bindingsStorage storage.Bindings
+	bindingManager  broker.BindingManager
+}
+
+var _ process.Step = &RemoveBindingsStep{}
+
+func NewRemoveBindingsStep(instanceStorage storage.Instances, bindingsStorage storage.Bindings, bindingManager broker.BindingManager) *RemoveBindingsStep {
+	return &RemoveBindingsStep{
+		instanceStorage: instanceStorage,
+		bindingsStorage: bindingsStorage,
+		bindingManager:  bindingManager,
+	}
+}
+
+func (s *RemoveBindingsStep) Name() string {
+	return "Remove_Bindings"
+}
+
+func (s *RemoveBindingsStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
+	bindings, err := s.bindingsStorage.ListByInstanceID(operation.InstanceID)
+	if err != nil {
+		log.Errorf("unable to list bindings of the instance: %s", err)
+		return operation, 10 * time.Second, nil
+	}
+	if len(bindings) == 0 {
+		return operation, 0, nil
+	}
+
+	instance, err := s.instanceStorage.GetByID(operation.InstanceID)
+	switch {
+	case dberr.IsNotFound(err):
+		instance = nil
+	case err != nil:
+		log.Errorf("unable to get instance from the storage: %s", err)
+		return operation, 10 * time.Second, nil
+	}
+
+	for _, binding := range bindings {
+		if instance != nil && instance.RuntimeID != "" {
+			err := s.bindingManager.Revoke(context.Background(), instance, binding.ID)
+			if err != nil {
+				if time.Since(operation.CreatedAt) < bindingsRevocationTimeout {
+					log.Errorf("unable to revoke credentials of the binding %s: %s. Retry...", binding.ID, err)
+					return operation, 10 * time.Second, nil
+				}
+				// the runtime is removed in the next steps, which invalidates the credentials anyway
+				log.Errorf("unable to revoke credentials of the binding %s, removing the binding: %s", binding.ID, err)
+			}
+		}
+		if err := s.bindingsStorage.Delete(operation.InstanceID, binding.ID); err != nil {
+			log.Errorf("unable to remove the binding %s from the storage: %s", binding.ID, err)
+			return operation, 10 * time.Second, nil
+		}
+		log.Infof("Binding %s removed", binding.ID)
+	}
+
+	return operation, 0, nil
+}
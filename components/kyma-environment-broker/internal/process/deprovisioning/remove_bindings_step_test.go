@@ -0,0 +1,127 @@
+package deprovisioning
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	brokerMocks "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker/automock"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoveBindingsStep(t *testing.T) {
+	t.Run("should revoke and remove all bindings of the instance", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		operation := fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID)
+		instance := fixture.FixInstance(instanceID)
+		require.NoError(t, memoryStorage.Instances().Insert(instance))
+		fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1", "binding-2")
+		fixBindings(t, memoryStorage.Bindings(), "other-instance", "binding-3")
+
+		bindingManager := &brokerMocks.BindingManager{}
+		bindingManager.On("Revoke", mock.Anything, mock.Anything, "binding-1").Return(nil).Once()
+		bindingManager.On("Revoke", mock.Anything, mock.Anything, "binding-2").Return(nil).Once()
+		step := NewRemoveBindingsStep(memoryStorage.Instances(), memoryStorage.Bindings(), bindingManager)
+
+		// when
+		_, backoff, err := step.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, backoff)
+		bindingManager.AssertExpectations(t)
+		bindings, err := memoryStorage.Bindings().ListByInstanceID(instanceID)
+		require.NoError(t, err)
+		assert.Empty(t, bindings)
+		_, err = memoryStorage.Bindings().GetByID("other-instance", "binding-3")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should retry when revocation fails", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		operation := fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID)
+		operation.CreatedAt = time.Now()
+		require.NoError(t, memoryStorage.Instances().Insert(fixture.FixInstance(instanceID)))
+		fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1")
+
+		bindingManager := &brokerMocks.BindingManager{}
+		bindingManager.On("Revoke", mock.Anything, mock.Anything, "binding-1").Return(fmt.Errorf("SKR not reachable"))
+		step := NewRemoveBindingsStep(memoryStorage.Instances(), memoryStorage.Bindings(), bindingManager)
+
+		// when
+		_, backoff, err := step.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.NotZero(t, backoff)
+		_, err = memoryStorage.Bindings().GetByID(instanceID, "binding-1")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should remove the bindings when revocation keeps failing", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		operation := fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID)
+		operation.CreatedAt = time.Now().Add(-time.Hour)
+		require.NoError(t, memoryStorage.Instances().Insert(fixture.FixInstance(instanceID)))
+		fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1")
+
+		bindingManager := &brokerMocks.BindingManager{}
+		bindingManager.On("Revoke", mock.Anything, mock.Anything, "binding-1").Return(fmt.Errorf("SKR not reachable"))
+		step := NewRemoveBindingsStep(memoryStorage.Instances(), memoryStorage.Bindings(), bindingManager)
+
+		// when
+		_, backoff, err := step.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, backoff)
+		_, err = memoryStorage.Bindings().GetByID(instanceID, "binding-1")
+		assert.True(t, dberr.IsNotFound(err))
+	})
+
+	t.Run("should remove the bindings without revocation when the runtime does not exist", func(t *testing.T) {
+		// given
+		memoryStorage := storage.NewMemoryStorage()
+		operation := fixture.FixDeprovisioningOperationAsOperation(operationID, instanceID)
+		instance := fixture.FixInstance(instanceID)
+		instance.RuntimeID = ""
+		require.NoError(t, memoryStorage.Instances().Insert(instance))
+		fixBindings(t, memoryStorage.Bindings(), instanceID, "binding-1")
+
+		bindingManager := &brokerMocks.BindingManager{}
+		step := NewRemoveBindingsStep(memoryStorage.Instances(), memoryStorage.Bindings(), bindingManager)
+
+		// when
+		_, backoff, err := step.Run(operation, logrus.New())
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, backoff)
+		bindingManager.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+		_, err = memoryStorage.Bindings().GetByID(instanceID, "binding-1")
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
+
+func fixBindings(t *testing.T, bindings storage.Bindings, instanceID string, bindingIDs ...string) {
+	for _, id := range bindingIDs {
+		require.NoError(t, bindings.Insert(internal.Binding{
+			ID:         id,
+			InstanceID: instanceID,
+			CreatedAt:  time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+			Role:       "view",
+		}))
+	}
+}
@@ -97,8 +97,9 @@ func (f *InputBuilderFactory) SetDefaultTrialProvider(p internal.CloudProvider)
 	f.config.DefaultTrialProvider = p
 }
 
+// IsPlanSupport checks if the plan is supported, the plans from the catalog are supported if their base plan is supported
 func (f *InputBuilderFactory) IsPlanSupport(planID string) bool {
-	switch planID {
+	switch broker.BasePlanID(planID) {
 	case broker.AWSPlanID, broker.GCPPlanID, broker.AzurePlanID, broker.FreemiumPlanID,
 		broker.AzureLitePlanID, broker.TrialPlanID, broker.OpenStackPlanID, broker.OwnClusterPlanID, broker.PreviewPlanID:
 		return true
@@ -117,7 +118,7 @@ func (f *InputBuilderFactory) GetPlanDefaults(planID string, platformProvider in
 
 func (f *InputBuilderFactory) getHyperscalerProviderForPlanID(planID string, platformProvider internal.CloudProvider, parametersProvider *internal.CloudProvider) (HyperscalerInputProvider, error) {
 	var provider HyperscalerInputProvider
-	switch planID {
+	switch broker.BasePlanID(planID) {
 	case broker.GCPPlanID:
 		provider = &cloudProvider.GcpInput{
 			MultiZone:                    f.config.MultiZoneCluster,
@@ -162,7 +163,8 @@ func (f *InputBuilderFactory) CreateProvisionInput(provisioningParameters intern
 		return nil, errors.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	// the plans from the catalog use the configuration of their base plan
+	planName := broker.PlanNameByID(broker.BasePlanID(provisioningParameters.PlanID))
 
 	cfg, err := f.configProvider.ProvideForGivenVersionAndPlan(version.Version, planName)
 	if err != nil {
@@ -278,7 +280,8 @@ func (f *InputBuilderFactory) CreateUpgradeInput(provisioningParameters internal
 		return nil, errors.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	// the plans from the catalog use the configuration of their base plan
+	planName := broker.PlanNameByID(broker.BasePlanID(provisioningParameters.PlanID))
 
 	cfg, err := f.configProvider.ProvideForGivenVersionAndPlan(version.Version, planName)
 	if err != nil {
@@ -373,7 +376,8 @@ func (f *InputBuilderFactory) CreateUpgradeShootInput(provisioningParameters int
 		return nil, errors.Errorf("plan %s in not supported", provisioningParameters.PlanID)
 	}
 
-	planName := broker.PlanNamesMapping[provisioningParameters.PlanID]
+	// the plans from the catalog use the configuration of their base plan
+	planName := broker.PlanNameByID(broker.BasePlanID(provisioningParameters.PlanID))
 
 	cfg, err := f.configProvider.ProvideForGivenVersionAndPlan(version.Version, planName)
 	if err != nil {
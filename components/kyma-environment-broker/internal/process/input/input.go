@@ -396,7 +396,7 @@ func (r *RuntimeInput) CreateClusterConfiguration() (reconcilerApi.Cluster, erro
 			SubAccountID:    r.provisioningParameters.ErsContext.SubAccountID,
 			ServiceID:       r.provisioningParameters.ServiceID,
 			ServicePlanID:   r.provisioningParameters.PlanID,
-			ServicePlanName: broker.PlanNamesMapping[r.provisioningParameters.PlanID],
+			ServicePlanName: broker.PlanNameByID(r.provisioningParameters.PlanID),
 			ShootName:       *r.shootName,
 			InstanceID:      r.instanceID,
 		},
@@ -437,7 +437,7 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 	params := r.provisioningParameters.Parameters
 	updateString(&r.provisionRuntimeInput.RuntimeInput.Name, &params.Name)
 
-	if r.provisioningParameters.PlanID == broker.OwnClusterPlanID {
+	if broker.BasePlanID(r.provisioningParameters.PlanID) == broker.OwnClusterPlanID {
 		return nil
 	}
 
@@ -456,6 +456,21 @@ func (r *RuntimeInput) applyProvisioningParametersForProvisionRuntime() error {
 	if params.LicenceType != nil {
 		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.LicenceType = params.LicenceType
 	}
+	if len(params.AdditionalWorkerNodePools) > 0 {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.AdditionalWorkerNodePools = workerNodePoolsInput(params.AdditionalWorkerNodePools)
+	}
+	if params.Networking != nil {
+		// the nodes CIDR is applied by the hyperscaler input provider together with the zone subnets
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.PodsCidr = params.Networking.PodsCidr
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.ServicesCidr = params.Networking.ServicesCidr
+	}
+	if len(params.HibernationSchedules) > 0 {
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.HibernationSchedules = hibernationSchedulesInput(params.HibernationSchedules)
+	}
+	if params.CustomDomain != nil {
+		// the shoot domain and the DNS providers are set from the custom domain when the operation is created
+		r.provisionRuntimeInput.ClusterConfig.GardenerConfig.CertIssuers = certIssuersInput(*params.CustomDomain)
+	}
 
 	// admins parameter check
 	if len(r.provisioningParameters.Parameters.RuntimeAdministrators) == 0 {
@@ -493,10 +508,59 @@ func (r *RuntimeInput) applyProvisioningParametersForUpgradeShoot() error {
 
 	updateInt(r.upgradeShootInput.GardenerConfig.MaxSurge, r.provisioningParameters.Parameters.MaxSurge)
 	updateInt(r.upgradeShootInput.GardenerConfig.MaxUnavailable, r.provisioningParameters.Parameters.MaxUnavailable)
+	if len(r.provisioningParameters.Parameters.AdditionalWorkerNodePools) > 0 {
+		r.upgradeShootInput.GardenerConfig.AdditionalWorkerNodePools = workerNodePoolsInput(r.provisioningParameters.Parameters.AdditionalWorkerNodePools)
+	}
+	if len(r.provisioningParameters.Parameters.HibernationSchedules) > 0 {
+		r.upgradeShootInput.GardenerConfig.HibernationSchedules = hibernationSchedulesInput(r.provisioningParameters.Parameters.HibernationSchedules)
+	}
 
 	return nil
 }
 
+func workerNodePoolsInput(pools []internal.WorkerNodePoolDTO) []*gqlschema.WorkerNodePoolInput {
+	input := make([]*gqlschema.WorkerNodePoolInput, 0, len(pools))
+	for _, pool := range pools {
+		input = append(input, &gqlschema.WorkerNodePoolInput{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			VolumeSizeGb:  pool.VolumeSizeGb,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		})
+	}
+	return input
+}
+
+func hibernationSchedulesInput(schedules []internal.HibernationScheduleDTO) []*gqlschema.HibernationScheduleInput {
+	input := make([]*gqlschema.HibernationScheduleInput, 0, len(schedules))
+	for _, schedule := range schedules {
+		input = append(input, &gqlschema.HibernationScheduleInput{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return input
+}
+
+func certIssuersInput(customDomain internal.CustomDomainDTO) []*gqlschema.CertIssuerInput {
+	issuer := customDomain.CertificateIssuer
+	server := internal.DefaultCertificateIssuerServer
+	if issuer.Server != nil {
+		server = *issuer.Server
+	}
+	return []*gqlschema.CertIssuerInput{
+		{
+			Name:                 internal.CustomDomainIssuerName,
+			Server:               server,
+			Email:                issuer.Email,
+			PrivateKeySecretName: issuer.PrivateKeySecretName,
+			Domains:              []string{customDomain.Domain},
+		},
+	}
+}
+
 func (r *RuntimeInput) resolveOptionalComponentsForProvisionRuntime() error {
 	r.muOptionalComponents.Lock()
 	defer r.muOptionalComponents.Unlock()
@@ -1395,3 +1395,125 @@ func mockConfigProvider() ConfigurationProvider {
 		}, nil)
 	return configProvider
 }
+
+func TestCreateProvisionRuntimeInput_ConfigureNetworking(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.Networking = &internal.NetworkingDTO{
+		NodesCidr:    "10.180.0.0/20",
+		PodsCidr:     ptr.String("10.96.0.0/13"),
+		ServicesCidr: ptr.String("10.104.0.0/16"),
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	gardenerConfig := input.ClusterConfig.GardenerConfig
+	assert.Equal(t, "10.180.0.0/20", gardenerConfig.WorkerCidr)
+	assert.Equal(t, ptr.String("10.96.0.0/13"), gardenerConfig.PodsCidr)
+	assert.Equal(t, ptr.String("10.104.0.0/16"), gardenerConfig.ServicesCidr)
+	assert.Equal(t, "10.180.0.0/20", gardenerConfig.ProviderSpecificConfig.AzureConfig.VnetCidr)
+	for _, zone := range gardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones {
+		assert.Contains(t, []string{"10.180.0.0/23", "10.180.2.0/23", "10.180.4.0/23"}, zone.Cidr)
+	}
+}
+
+func TestCreateProvisionRuntimeInput_ConfigureCustomDomain(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.CustomDomain = &internal.CustomDomainDTO{
+		Domain:      "kyma.example.com",
+		DNSProvider: internal.DNSProviderDTO{Type: "aws-route53", SecretName: "route53-secret"},
+		CertificateIssuer: internal.CertificateIssuerDTO{
+			Email:                "admin@example.com",
+			PrivateKeySecretName: ptr.String("acme-account-key"),
+		},
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []*gqlschema.CertIssuerInput{
+		{
+			Name:                 internal.CustomDomainIssuerName,
+			Server:               internal.DefaultCertificateIssuerServer,
+			Email:                "admin@example.com",
+			PrivateKeySecretName: ptr.String("acme-account-key"),
+			Domains:              []string{"kyma.example.com"},
+		},
+	}, input.ClusterConfig.GardenerConfig.CertIssuers)
+}
+
+func TestCreateProvisionRuntimeInput_ConfigureHibernationSchedules(t *testing.T) {
+	// given
+	id := uuid.New().String()
+
+	optComponentsSvc := dummyOptionalComponentServiceMock(fixKymaComponentList())
+	componentsProvider := &automock.ComponentListProvider{}
+	componentsProvider.On("AllComponents", mock.AnythingOfType("internal.RuntimeVersionData"), mock.AnythingOfType("*internal.ConfigForPlan")).Return(fixKymaComponentList(), nil)
+
+	configProvider := mockConfigProvider()
+
+	inputBuilder, err := NewInputBuilderFactory(optComponentsSvc, runtime.NewDisabledComponentsProvider(),
+		componentsProvider, configProvider, Config{}, "1.24.0",
+		fixTrialRegionMapping(), fixTrialProviders(), fixture.FixOIDCConfigDTO())
+	assert.NoError(t, err)
+
+	provisioningParams := fixture.FixProvisioningParameters(id)
+	provisioningParams.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+		{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+		{Start: ptr.String("@daily")},
+	}
+
+	creator, err := inputBuilder.CreateProvisionInput(provisioningParams, internal.RuntimeVersionData{Version: "", Origin: internal.Defaults})
+	require.NoError(t, err)
+	setRuntimeProperties(creator)
+
+	// when
+	input, err := creator.CreateProvisionRuntimeInput()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, []*gqlschema.HibernationScheduleInput{
+		{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+		{Start: ptr.String("@daily")},
+	}, input.ClusterConfig.GardenerConfig.HibernationSchedules)
+}
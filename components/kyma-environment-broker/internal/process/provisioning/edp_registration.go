@@ -131,7 +131,7 @@ func (s *EDPRegistrationStep) selectEnvironmentKey(region string, log logrus.Fie
 }
 
 func (s *EDPRegistrationStep) selectServicePlan(planID string) string {
-	switch planID {
+	switch broker.BasePlanID(planID) {
 	case broker.FreemiumPlanID:
 		return "free"
 	case broker.AzureLitePlanID:
@@ -6,11 +6,13 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/config"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/edp"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/logger"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -114,6 +116,13 @@ func TestEDPRegistrationStep_selectEnvironmentKey(t *testing.T) {
 }
 
 func TestEDPRegistrationStep_selectServicePlan(t *testing.T) {
+	catalog := config.DefaultPlanCatalog()
+	catalog.Plans = append(catalog.Plans, internal.CatalogPlan{ID: "azure-lite-small", Name: "azure_lite_small", BasePlan: broker.AzureLitePlanName})
+	require.NoError(t, broker.SetPlanCatalog(catalog))
+	t.Cleanup(func() {
+		require.NoError(t, broker.SetPlanCatalog(config.DefaultPlanCatalog()))
+	})
+
 	for name, tc := range map[string]struct {
 		planID   string
 		expected string
@@ -146,6 +155,10 @@ func TestEDPRegistrationStep_selectServicePlan(t *testing.T) {
 			planID:   broker.FreemiumPlanID,
 			expected: "free",
 		},
+		"Plan based on Azure Lite": {
+			planID:   "azure-lite-small",
+			expected: "tdd",
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			// given
@@ -43,8 +43,9 @@ func (s *OverridesFromSecretsAndConfigStep) Name() string {
 }
 
 func (s *OverridesFromSecretsAndConfigStep) Run(operation internal.Operation, log logrus.FieldLogger) (internal.Operation, time.Duration, error) {
-	planName, exists := broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]
-	if !exists {
+	// the runtime overrides are defined for the built-in plans, the plans from the catalog use the overrides of their base plan
+	planName := broker.PlanNameByID(broker.BasePlanID(operation.ProvisioningParameters.PlanID))
+	if planName == "" {
 		log.Errorf("cannot map planID '%s' to planName", operation.ProvisioningParameters.PlanID)
 		return s.operationManager.OperationFailed(operation, "invalid operation provisioning parameters", nil, log)
 	}
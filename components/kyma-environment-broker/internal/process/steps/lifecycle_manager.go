@@ -21,7 +21,7 @@ func ApplyLabelsAndAnnotationsForLM(object client.Object, operation internal.Ope
 	l["kyma-project.io/instance-id"] = operation.InstanceID
 	l["kyma-project.io/runtime-id"] = operation.RuntimeID
 	l["kyma-project.io/broker-plan-id"] = operation.ProvisioningParameters.PlanID
-	l["kyma-project.io/broker-plan-name"] = broker.PlanNamesMapping[operation.ProvisioningParameters.PlanID]
+	l["kyma-project.io/broker-plan-name"] = broker.PlanNameByID(operation.ProvisioningParameters.PlanID)
 	l["kyma-project.io/global-account-id"] = operation.GlobalAccountID
 	l["operator.kyma-project.io/kyma-name"] = KymaName(operation)
 	l["operator.kyma-project.io/managed-by"] = "lifecycle-manager"
@@ -109,22 +109,35 @@ func (s *UpgradeShootStep) createUpgradeShootInput(operation internal.Operation)
 			AutoScalerMin:  operation.UpdatingParameters.AutoScalerMin,
 			MaxSurge:       operation.UpdatingParameters.MaxSurge,
 			MaxUnavailable: operation.UpdatingParameters.MaxUnavailable,
+			// Gardener rolls the worker nodes when the machine type or the volume size is changed
+			MachineType:  operation.UpdatingParameters.MachineType,
+			VolumeSizeGb: operation.UpdatingParameters.VolumeSizeGb,
 		},
 		Administrators: fullInput.Administrators,
 	}
 	result.GardenerConfig.ShootNetworkingFilterDisabled = operation.ProvisioningParameters.ErsContext.DisableEnterprisePolicyFilter()
+	if operation.UpdatingParameters.AdditionalWorkerNodePools != nil {
+		// an empty list removes all additional worker node pools
+		result.GardenerConfig.AdditionalWorkerNodePools = append([]*gqlschema.WorkerNodePoolInput{}, fullInput.GardenerConfig.AdditionalWorkerNodePools...)
+	}
+	if operation.UpdatingParameters.HibernationSchedules != nil {
+		// an empty list removes all hibernation schedules
+		result.GardenerConfig.HibernationSchedules = append([]*gqlschema.HibernationScheduleInput{}, fullInput.GardenerConfig.HibernationSchedules...)
+	}
 
 	return result, nil
 }
 
 func gardenerUpgradeInputToConfigInput(input gqlschema.UpgradeShootInput) *gqlschema.GardenerConfigInput {
 	result := &gqlschema.GardenerConfigInput{
-		MachineImage:        input.GardenerConfig.MachineImage,
-		MachineImageVersion: input.GardenerConfig.MachineImageVersion,
-		DiskType:            input.GardenerConfig.DiskType,
-		VolumeSizeGb:        input.GardenerConfig.VolumeSizeGb,
-		Purpose:             input.GardenerConfig.Purpose,
-		OidcConfig:          input.GardenerConfig.OidcConfig,
+		MachineImage:              input.GardenerConfig.MachineImage,
+		MachineImageVersion:       input.GardenerConfig.MachineImageVersion,
+		DiskType:                  input.GardenerConfig.DiskType,
+		VolumeSizeGb:              input.GardenerConfig.VolumeSizeGb,
+		Purpose:                   input.GardenerConfig.Purpose,
+		OidcConfig:                input.GardenerConfig.OidcConfig,
+		AdditionalWorkerNodePools: input.GardenerConfig.AdditionalWorkerNodePools,
+		HibernationSchedules:      input.GardenerConfig.HibernationSchedules,
 	}
 	if input.GardenerConfig.KubernetesVersion != nil {
 		result.KubernetesVersion = *input.GardenerConfig.KubernetesVersion
@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
 	inputAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -76,6 +77,114 @@ func TestUpgradeShootStep_Run(t *testing.T) {
 	assert.NotEmpty(t, newOperation.ProvisionerOperationID)
 }
 
+func TestUpgradeShootStep_RunWithAdditionalWorkerNodePools(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	os := memoryStorage.Operations()
+	rs := memoryStorage.RuntimeStates()
+	cli := provisioner.NewFakeClient()
+	step := NewUpgradeShootStep(os, rs, cli)
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+	operation.RuntimeID = "runtime-id"
+	operation.ProvisionerOperationID = ""
+	operation.ProvisioningParameters.ErsContext.UserID = "test-user-id"
+	pools := []internal.WorkerNodePoolDTO{{Name: "worker-1", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 3}}
+	operation.ProvisioningParameters.Parameters.AdditionalWorkerNodePools = pools
+	operation.UpdatingParameters.AdditionalWorkerNodePools = pools
+	operation.InputCreator = fixInputCreator(t)
+	os.InsertOperation(operation.Operation)
+	runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "clientID", IssuerURL: "https://issuer.url"}
+	rs.Insert(runtimeState)
+
+	// when
+	_, d, err := step.Run(operation.Operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, d)
+	req, _ := cli.LastShootUpgrade("runtime-id")
+	assert.Equal(t, []*gqlschema.WorkerNodePoolInput{
+		{Name: "worker-1", MachineType: "m5.2xlarge", AutoScalerMin: 1, AutoScalerMax: 3},
+	}, req.GardenerConfig.AdditionalWorkerNodePools)
+}
+
+func TestUpgradeShootStep_RunWithHibernationSchedules(t *testing.T) {
+	for name, tc := range map[string]struct {
+		schedules []internal.HibernationScheduleDTO
+		expected  []*gqlschema.HibernationScheduleInput
+	}{
+		"replaced schedules": {
+			schedules: []internal.HibernationScheduleDTO{{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")}},
+			expected:  []*gqlschema.HibernationScheduleInput{{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")}},
+		},
+		"removed schedules": {
+			schedules: []internal.HibernationScheduleDTO{},
+			expected:  []*gqlschema.HibernationScheduleInput{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			os := memoryStorage.Operations()
+			rs := memoryStorage.RuntimeStates()
+			cli := provisioner.NewFakeClient()
+			step := NewUpgradeShootStep(os, rs, cli)
+			operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+			operation.RuntimeID = "runtime-id"
+			operation.ProvisionerOperationID = ""
+			operation.ProvisioningParameters.Parameters.HibernationSchedules = tc.schedules
+			operation.UpdatingParameters.HibernationSchedules = tc.schedules
+			operation.InputCreator = fixInputCreator(t)
+			os.InsertOperation(operation.Operation)
+			runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+			runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "clientID", IssuerURL: "https://issuer.url"}
+			rs.Insert(runtimeState)
+
+			// when
+			_, d, err := step.Run(operation.Operation, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Zero(t, d)
+			req, _ := cli.LastShootUpgrade("runtime-id")
+			assert.Equal(t, tc.expected, req.GardenerConfig.HibernationSchedules)
+		})
+	}
+}
+
+func TestUpgradeShootStep_RunWithMachineType(t *testing.T) {
+	// given
+	memoryStorage := storage.NewMemoryStorage()
+	os := memoryStorage.Operations()
+	rs := memoryStorage.RuntimeStates()
+	cli := provisioner.NewFakeClient()
+	step := NewUpgradeShootStep(os, rs, cli)
+	operation := fixture.FixUpdatingOperation("op-id", "inst-id")
+	operation.RuntimeID = "runtime-id"
+	operation.ProvisionerOperationID = ""
+	operation.UpdatingParameters.MachineType = ptr.String("Standard_D16_v3")
+	operation.UpdatingParameters.VolumeSizeGb = ptr.Integer(80)
+	operation.InputCreator = fixInputCreator(t)
+	os.InsertOperation(operation.Operation)
+	runtimeState := fixture.FixRuntimeState("runtime-id", "runtime-id", "provisioning-op-1")
+	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "clientID", IssuerURL: "https://issuer.url"}
+	rs.Insert(runtimeState)
+
+	// when
+	_, d, err := step.Run(operation.Operation, logrus.New())
+
+	// then
+	require.NoError(t, err)
+	assert.Zero(t, d)
+	req, _ := cli.LastShootUpgrade("runtime-id")
+	assert.Equal(t, ptr.String("Standard_D16_v3"), req.GardenerConfig.MachineType)
+	assert.Equal(t, ptr.Integer(80), req.GardenerConfig.VolumeSizeGb)
+	state, err := rs.GetByOperationID("op-id")
+	require.NoError(t, err)
+	assert.Equal(t, "Standard_D16_v3", state.ClusterConfig.MachineType)
+}
+
 func fixInputCreator(t *testing.T) internal.ProvisionerInputCreator {
 	optComponentsSvc := &inputAutomock.OptionalComponentService{}
 
@@ -0,0 +1,106 @@
+package upgrade_cluster
+
+import (
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// applyKubernetesParameters overrides the configuration created for the upgrade with the Kubernetes parameters of the orchestration
+func applyKubernetesParameters(config *gqlschema.GardenerUpgradeInput, params *orchestration.KubernetesParameters) {
+	if config == nil || params == nil {
+		return
+	}
+	if params.KubernetesVersion != "" {
+		config.KubernetesVersion = &params.KubernetesVersion
+	}
+	if params.MachineImage != "" {
+		config.MachineImage = &params.MachineImage
+	}
+	if params.MachineImageVersion != "" {
+		config.MachineImageVersion = &params.MachineImageVersion
+	}
+}
+
+// newClusterUpgradeDiff compares the current cluster configuration of the runtime with the configuration applied by the upgrade,
+// the attributes which are not set in the upgrade input are not changed
+func newClusterUpgradeDiff(current *gqlschema.GardenerConfig, target *gqlschema.GardenerUpgradeInput) orchestration.ClusterUpgradeDiff {
+	diff := orchestration.ClusterUpgradeDiff{}
+	if current == nil {
+		diff.Errors = append(diff.Errors, "the current cluster configuration of the runtime is not known")
+		current = &gqlschema.GardenerConfig{}
+	}
+	if target == nil {
+		target = &gqlschema.GardenerUpgradeInput{}
+	}
+
+	diff.KubernetesVersion = valueDiff(current.KubernetesVersion, target.KubernetesVersion)
+	diff.MachineImage = valueDiff(current.MachineImage, target.MachineImage)
+	diff.MachineImageVersion = valueDiff(current.MachineImageVersion, target.MachineImageVersion)
+
+	if diff.KubernetesVersion.Changed() {
+		if err := validateKubernetesVersion(diff.KubernetesVersion); err != nil {
+			diff.Errors = append(diff.Errors, err.Error())
+		}
+	}
+	if diff.MachineImage.Changed() && !diff.MachineImageVersion.Changed() {
+		diff.Errors = append(diff.Errors, fmt.Sprintf("machine image cannot be changed from %s to %s without changing the machine image version", diff.MachineImage.Current, diff.MachineImage.Target))
+	}
+	if !diff.MachineImage.Changed() && diff.MachineImageVersion.Changed() {
+		if err := validateMachineImageVersion(diff.MachineImageVersion); err != nil {
+			diff.Errors = append(diff.Errors, err.Error())
+		}
+	}
+	return diff
+}
+
+func valueDiff(current, target *string) orchestration.ValueDiff {
+	diff := orchestration.ValueDiff{}
+	if current != nil {
+		diff.Current = *current
+	}
+	diff.Target = diff.Current
+	if target != nil && *target != "" {
+		diff.Target = *target
+	}
+	return diff
+}
+
+// validateKubernetesVersion checks the Kubernetes version can be upgraded, Gardener does not allow downgrades and skipping minor versions
+func validateKubernetesVersion(diff orchestration.ValueDiff) error {
+	target, err := semver.NewVersion(diff.Target)
+	if err != nil {
+		return fmt.Errorf("kubernetes version %s is not valid", diff.Target)
+	}
+	current, err := semver.NewVersion(diff.Current)
+	if err != nil {
+		// the current version is not known, the target version cannot be compared
+		return nil
+	}
+	if target.LessThan(current) {
+		return fmt.Errorf("kubernetes version cannot be downgraded from %s to %s", diff.Current, diff.Target)
+	}
+	if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+		return fmt.Errorf("kubernetes version cannot be upgraded from %s to %s, minor versions cannot be skipped", diff.Current, diff.Target)
+	}
+	return nil
+}
+
+// validateMachineImageVersion checks the version of the same machine image is not downgraded
+func validateMachineImageVersion(diff orchestration.ValueDiff) error {
+	target, err := semver.NewVersion(diff.Target)
+	if err != nil {
+		// machine image versions do not have to follow semantic versioning
+		return nil
+	}
+	current, err := semver.NewVersion(diff.Current)
+	if err != nil {
+		return nil
+	}
+	if target.LessThan(current) {
+		return fmt.Errorf("machine image version cannot be downgraded from %s to %s", diff.Current, diff.Target)
+	}
+	return nil
+}
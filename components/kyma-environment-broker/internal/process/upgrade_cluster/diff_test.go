@@ -0,0 +1,101 @@
+package upgrade_cluster
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClusterUpgradeDiff(t *testing.T) {
+	for name, tc := range map[string]struct {
+		current          *gqlschema.GardenerConfig
+		target           *gqlschema.GardenerUpgradeInput
+		expectedChanges  bool
+		expectedErrors   []string
+		expectedKubeDiff orchestration.ValueDiff
+	}{
+		"no changes": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "1.24.8"},
+		},
+		"attributes not set in the upgrade are not changed": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           &gqlschema.GardenerUpgradeInput{},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "1.24.8"},
+		},
+		"patch and minor upgrade": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("1.25.4", "gardenlinux", "934.7.0"),
+			expectedChanges:  true,
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "1.25.4"},
+		},
+		"minor version skipped": {
+			current:          fixCurrentClusterConfig("1.23.6", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("1.25.4", "gardenlinux", "576.12.0"),
+			expectedChanges:  true,
+			expectedErrors:   []string{"kubernetes version cannot be upgraded from 1.23.6 to 1.25.4, minor versions cannot be skipped"},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.23.6", Target: "1.25.4"},
+		},
+		"invalid kubernetes version": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("latest", "gardenlinux", "576.12.0"),
+			expectedChanges:  true,
+			expectedErrors:   []string{"kubernetes version latest is not valid"},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "latest"},
+		},
+		"machine image changed without the version": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("1.24.8", "ubuntu", "576.12.0"),
+			expectedChanges:  true,
+			expectedErrors:   []string{"machine image cannot be changed from gardenlinux to ubuntu without changing the machine image version"},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "1.24.8"},
+		},
+		"machine image version downgrade": {
+			current:          fixCurrentClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			target:           fixTargetClusterConfig("1.24.8", "gardenlinux", "318.9.0"),
+			expectedChanges:  true,
+			expectedErrors:   []string{"machine image version cannot be downgraded from 576.12.0 to 318.9.0"},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "1.24.8", Target: "1.24.8"},
+		},
+		"current configuration not known": {
+			target:           fixTargetClusterConfig("1.24.8", "gardenlinux", "576.12.0"),
+			expectedChanges:  true,
+			expectedErrors:   []string{"the current cluster configuration of the runtime is not known"},
+			expectedKubeDiff: orchestration.ValueDiff{Current: "", Target: "1.24.8"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			diff := newClusterUpgradeDiff(tc.current, tc.target)
+
+			// then
+			assert.Equal(t, tc.expectedChanges, diff.HasChanges())
+			assert.Equal(t, tc.expectedErrors, diff.Errors)
+			assert.Equal(t, len(tc.expectedErrors) == 0, diff.Valid())
+			assert.Equal(t, tc.expectedKubeDiff, diff.KubernetesVersion)
+		})
+	}
+}
+
+func TestApplyKubernetesParameters(t *testing.T) {
+	// given
+	config := fixTargetClusterConfig("1.24.8", "gardenlinux", "576.12.0")
+
+	// when
+	applyKubernetesParameters(config, &orchestration.KubernetesParameters{MachineImageVersion: "934.7.0"})
+
+	// then
+	assert.Equal(t, fixTargetClusterConfig("1.24.8", "gardenlinux", "934.7.0"), config)
+}
+
+func fixTargetClusterConfig(kubernetesVersion, machineImage, machineImageVersion string) *gqlschema.GardenerUpgradeInput {
+	return &gqlschema.GardenerUpgradeInput{
+		KubernetesVersion:   ptr.String(kubernetesVersion),
+		MachineImage:        ptr.String(machineImage),
+		MachineImageVersion: ptr.String(machineImageVersion),
+	}
+}
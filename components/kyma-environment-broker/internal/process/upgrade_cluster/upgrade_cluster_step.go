@@ -2,6 +2,7 @@ package upgrade_cluster
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -16,15 +17,17 @@ import (
 const DryRunPrefix = "dry_run-"
 
 type UpgradeClusterStep struct {
-	operationManager    *process.UpgradeClusterOperationManager
-	provisionerClient   provisioner.Client
-	runtimeStateStorage storage.RuntimeStates
-	timeSchedule        TimeSchedule
+	operationManager     *process.UpgradeClusterOperationManager
+	provisionerClient    provisioner.Client
+	runtimeStateStorage  storage.RuntimeStates
+	orchestrationStorage storage.Orchestrations
+	timeSchedule         TimeSchedule
 }
 
 func NewUpgradeClusterStep(
 	os storage.Operations,
 	runtimeStorage storage.RuntimeStates,
+	orchestrationStorage storage.Orchestrations,
 	cli provisioner.Client,
 	timeSchedule *TimeSchedule) *UpgradeClusterStep {
 	ts := timeSchedule
@@ -37,10 +40,11 @@ func NewUpgradeClusterStep(
 	}
 
 	return &UpgradeClusterStep{
-		operationManager:    process.NewUpgradeClusterOperationManager(os),
-		provisionerClient:   cli,
-		runtimeStateStorage: runtimeStorage,
-		timeSchedule:        *ts,
+		operationManager:     process.NewUpgradeClusterOperationManager(os),
+		provisionerClient:    cli,
+		runtimeStateStorage:  runtimeStorage,
+		orchestrationStorage: orchestrationStorage,
+		timeSchedule:         *ts,
 	}
 }
 
@@ -59,20 +63,19 @@ func (s *UpgradeClusterStep) Run(operation internal.UpgradeClusterOperation, log
 		return s.operationManager.RetryOperation(operation, err.Error(), err, 5*time.Second, 1*time.Minute, log)
 	}
 
+	orchestration, err := s.orchestrationStorage.GetByID(operation.OrchestrationID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, err.Error(), err, 5*time.Second, 1*time.Minute, log)
+	}
+
 	input, err := s.createUpgradeShootInput(operation, &latestRuntimeStateWithOIDC.ClusterConfig)
 	if err != nil {
 		return s.operationManager.OperationFailed(operation, "invalid operation data - cannot create upgradeShoot input", err, log)
 	}
+	applyKubernetesParameters(input.GardenerConfig, orchestration.Parameters.Kubernetes)
 
 	if operation.DryRun {
-		// runtimeID is set with prefix to indicate the fake runtime state
-		err = s.runtimeStateStorage.Insert(
-			internal.NewRuntimeState(fmt.Sprintf("%s%s", DryRunPrefix, operation.RuntimeOperation.RuntimeID), operation.Operation.ID, nil, gardenerUpgradeInputToConfigInput(input)),
-		)
-		if err != nil {
-			return operation, 10 * time.Second, nil
-		}
-		return s.operationManager.OperationSucceeded(operation, "dry run succeeded", log)
+		return s.dryRun(operation, input, log)
 	}
 
 	var provisionerResponse gqlschema.OperationStatus
@@ -122,6 +125,34 @@ func (s *UpgradeClusterStep) Run(operation internal.UpgradeClusterOperation, log
 
 }
 
+// dryRun stores the configuration which would be applied together with its diff to the current cluster configuration,
+// the operation fails if the upgrade is not valid for the runtime
+func (s *UpgradeClusterStep) dryRun(operation internal.UpgradeClusterOperation, input gqlschema.UpgradeShootInput, log logrus.FieldLogger) (internal.UpgradeClusterOperation, time.Duration, error) {
+	status, err := s.provisionerClient.RuntimeStatus(operation.ProvisioningParameters.ErsContext.GlobalAccountID, operation.RuntimeOperation.RuntimeID)
+	if err != nil {
+		return s.operationManager.RetryOperation(operation, "while getting the runtime status from provisioner", err, 5*time.Second, 1*time.Minute, log)
+	}
+	var currentConfig *gqlschema.GardenerConfig
+	if status.RuntimeConfiguration != nil {
+		currentConfig = status.RuntimeConfiguration.ClusterConfig
+	}
+
+	// runtimeID is set with prefix to indicate the fake runtime state
+	err = s.runtimeStateStorage.Insert(
+		internal.NewRuntimeState(fmt.Sprintf("%s%s", DryRunPrefix, operation.RuntimeOperation.RuntimeID), operation.Operation.ID, nil, gardenerUpgradeInputToConfigInput(input)),
+	)
+	if err != nil {
+		return operation, 10 * time.Second, nil
+	}
+
+	diff := newClusterUpgradeDiff(currentConfig, input.GardenerConfig)
+	operation.ClusterUpgradeDiff = &diff
+	if !diff.Valid() {
+		return s.operationManager.OperationFailed(operation, fmt.Sprintf("dry run: upgrade is not valid: %s", strings.Join(diff.Errors, ", ")), nil, log)
+	}
+	return s.operationManager.OperationSucceeded(operation, "dry run succeeded", log)
+}
+
 func (s *UpgradeClusterStep) createUpgradeShootInput(operation internal.UpgradeClusterOperation, lastClusterConfig *gqlschema.GardenerConfigInput) (gqlschema.UpgradeShootInput, error) {
 	operation.InputCreator.SetProvisioningParameters(operation.ProvisioningParameters)
 	if lastClusterConfig.OidcConfig != nil {
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process/input"
@@ -12,6 +13,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -41,6 +43,12 @@ func TestUpgradeClusterStep_Run(t *testing.T) {
 	err = memoryStorage.Operations().InsertOperation(provisioningOperation)
 	assert.NoError(t, err)
 
+	// the Kubernetes parameters of the orchestration are applied in the same way as in the dry run
+	orchestrationFixture := fixture.FixOrchestration(operation.OrchestrationID)
+	orchestrationFixture.Parameters.Kubernetes = &orchestration.KubernetesParameters{MachineImage: "ubuntu", MachineImageVersion: "18.4.0"}
+	err = memoryStorage.Orchestrations().Insert(orchestrationFixture)
+	assert.NoError(t, err)
+
 	runtimeState := fixture.FixRuntimeState("runtimestate-1", fixRuntimeID, provisioningOperation.ID)
 	runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{
 		ClientID:       expectedOIDC.ClientID,
@@ -62,8 +70,8 @@ func TestUpgradeClusterStep_Run(t *testing.T) {
 	provisionerClient.On("UpgradeShoot", fixGlobalAccountID, fixRuntimeID, gqlschema.UpgradeShootInput{
 		GardenerConfig: &gqlschema.GardenerUpgradeInput{
 			KubernetesVersion:                   ptr.String(fixKubernetesVersion),
-			MachineImage:                        ptr.String(fixMachineImage),
-			MachineImageVersion:                 ptr.String(fixMachineImageVersion),
+			MachineImage:                        ptr.String("ubuntu"),
+			MachineImageVersion:                 ptr.String("18.4.0"),
 			MaxSurge:                            operation.ProvisioningParameters.Parameters.MaxSurge,
 			MaxUnavailable:                      operation.ProvisioningParameters.Parameters.MaxUnavailable,
 			EnableKubernetesVersionAutoUpdate:   ptr.Bool(fixAutoUpdateKubernetesVersion),
@@ -94,7 +102,7 @@ func TestUpgradeClusterStep_Run(t *testing.T) {
 		RuntimeID: ptr.String(fixRuntimeID),
 	}, nil)
 
-	step := NewUpgradeClusterStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), provisionerClient, nil)
+	step := NewUpgradeClusterStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Orchestrations(), provisionerClient, nil)
 
 	// when
 
@@ -106,6 +114,98 @@ func TestUpgradeClusterStep_Run(t *testing.T) {
 	assert.Equal(t, fixProvisionerOperationID, operation.ProvisionerOperationID)
 }
 
+func TestUpgradeClusterStep_RunDryRun(t *testing.T) {
+	for name, tc := range map[string]struct {
+		kubernetesParameters *orchestration.KubernetesParameters
+		currentConfig        *gqlschema.GardenerConfig
+		expectedState        domain.LastOperationState
+		expectedDiff         orchestration.ClusterUpgradeDiff
+	}{
+		"kubernetes version upgrade": {
+			currentConfig: fixCurrentClusterConfig("1.16.9", fixMachineImage, fixMachineImageVersion),
+			expectedState: orchestration.Succeeded,
+			expectedDiff: orchestration.ClusterUpgradeDiff{
+				KubernetesVersion:   orchestration.ValueDiff{Current: "1.16.9", Target: fixKubernetesVersion},
+				MachineImage:        orchestration.ValueDiff{Current: fixMachineImage, Target: fixMachineImage},
+				MachineImageVersion: orchestration.ValueDiff{Current: fixMachineImageVersion, Target: fixMachineImageVersion},
+			},
+		},
+		"machine image from the orchestration parameters": {
+			kubernetesParameters: &orchestration.KubernetesParameters{MachineImage: "ubuntu", MachineImageVersion: "18.4.0"},
+			currentConfig:        fixCurrentClusterConfig(fixKubernetesVersion, fixMachineImage, fixMachineImageVersion),
+			expectedState:        orchestration.Succeeded,
+			expectedDiff: orchestration.ClusterUpgradeDiff{
+				KubernetesVersion:   orchestration.ValueDiff{Current: fixKubernetesVersion, Target: fixKubernetesVersion},
+				MachineImage:        orchestration.ValueDiff{Current: fixMachineImage, Target: "ubuntu"},
+				MachineImageVersion: orchestration.ValueDiff{Current: fixMachineImageVersion, Target: "18.4.0"},
+			},
+		},
+		"kubernetes version downgrade": {
+			currentConfig: fixCurrentClusterConfig("1.18.2", fixMachineImage, fixMachineImageVersion),
+			expectedState: orchestration.Failed,
+			expectedDiff: orchestration.ClusterUpgradeDiff{
+				KubernetesVersion:   orchestration.ValueDiff{Current: "1.18.2", Target: fixKubernetesVersion},
+				MachineImage:        orchestration.ValueDiff{Current: fixMachineImage, Target: fixMachineImage},
+				MachineImageVersion: orchestration.ValueDiff{Current: fixMachineImageVersion, Target: fixMachineImageVersion},
+				Errors:              []string{"kubernetes version cannot be downgraded from 1.18.2 to 1.17.16"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			memoryStorage := storage.NewMemoryStorage()
+			operation := fixUpgradeClusterOperationWithInputCreator(t)
+			operation.DryRun = true
+			err := memoryStorage.Operations().InsertUpgradeClusterOperation(operation)
+			require.NoError(t, err)
+
+			orchestrationFixture := fixture.FixOrchestration(operation.OrchestrationID)
+			orchestrationFixture.Parameters.DryRun = true
+			orchestrationFixture.Parameters.Kubernetes = tc.kubernetesParameters
+			err = memoryStorage.Orchestrations().Insert(orchestrationFixture)
+			require.NoError(t, err)
+
+			runtimeState := fixture.FixRuntimeState("runtimestate-1", fixRuntimeID, fixProvisioningOperationID)
+			runtimeState.ClusterConfig.OidcConfig = &gqlschema.OIDCConfigInput{ClientID: "client-id"}
+			err = memoryStorage.RuntimeStates().Insert(runtimeState)
+			require.NoError(t, err)
+
+			provisionerClient := &provisionerAutomock.Client{}
+			provisionerClient.On("RuntimeStatus", fixGlobalAccountID, fixRuntimeID).Return(gqlschema.RuntimeStatus{
+				RuntimeConfiguration: &gqlschema.RuntimeConfig{ClusterConfig: tc.currentConfig},
+			}, nil)
+
+			step := NewUpgradeClusterStep(memoryStorage.Operations(), memoryStorage.RuntimeStates(), memoryStorage.Orchestrations(), provisionerClient, nil)
+
+			// when
+			operation, repeat, _ := step.Run(operation, logrus.New())
+
+			// then
+			assert.Zero(t, repeat)
+			assert.Equal(t, tc.expectedState, operation.State)
+			provisionerClient.AssertNotCalled(t, "UpgradeShoot", mock.Anything, mock.Anything, mock.Anything)
+
+			storedOperation, err := memoryStorage.Operations().GetUpgradeClusterOperationByID(operation.Operation.ID)
+			require.NoError(t, err)
+			require.NotNil(t, storedOperation.ClusterUpgradeDiff)
+			assert.Equal(t, tc.expectedDiff, *storedOperation.ClusterUpgradeDiff)
+
+			dryRunState, err := memoryStorage.RuntimeStates().GetByOperationID(operation.Operation.ID)
+			require.NoError(t, err)
+			assert.Equal(t, DryRunPrefix+fixRuntimeID, dryRunState.RuntimeID)
+			assert.Equal(t, tc.expectedDiff.MachineImage.Target, *dryRunState.ClusterConfig.MachineImage)
+		})
+	}
+}
+
+func fixCurrentClusterConfig(kubernetesVersion, machineImage, machineImageVersion string) *gqlschema.GardenerConfig {
+	return &gqlschema.GardenerConfig{
+		KubernetesVersion:   ptr.String(kubernetesVersion),
+		MachineImage:        ptr.String(machineImage),
+		MachineImageVersion: ptr.String(machineImageVersion),
+	}
+}
+
 func fixUpgradeClusterOperationWithInputCreator(t *testing.T) internal.UpgradeClusterOperation {
 	upgradeOperation := fixture.FixUpgradeClusterOperation(fixUpgradeOperationID, fixInstanceID)
 	upgradeOperation.Description = ""
@@ -3,8 +3,10 @@ package provider
 import (
 	"fmt"
 	"math/rand"
+	"net"
 	"strings"
 
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
@@ -55,14 +57,14 @@ func (p *AWSInput) Defaults() *gqlschema.ClusterConfigInput {
 			MachineType:    "m5.xlarge",
 			Region:         DefaultAWSRegion,
 			Provider:       "aws",
-			WorkerCidr:     "10.250.0.0/16",
+			WorkerCidr:     networking.DefaultNodesCIDR,
 			AutoScalerMin:  3,
 			AutoScalerMax:  20,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AwsConfig: &gqlschema.AWSProviderConfigInput{
-					VpcCidr:  "10.250.0.0/16",
+					VpcCidr:  networking.DefaultNodesCIDR,
 					AwsZones: generateMultipleAWSZones(MultipleZonesForAWSRegion(DefaultAWSRegion, zonesCount)),
 				},
 			},
@@ -121,9 +123,15 @@ func MultipleZonesForAWSRegion(region string, zonesCount int) []string {
 }
 
 func generateMultipleAWSZones(zoneNames []string) []*gqlschema.AWSZoneInput {
+	_, nodes, _ := net.ParseCIDR(networking.DefaultNodesCIDR)
+	return generateAWSZones(nodes, zoneNames)
+}
+
+func generateAWSZones(nodes *net.IPNet, zoneNames []string) []*gqlschema.AWSZoneInput {
 	var zones []*gqlschema.AWSZoneInput
 
-	// generate subnets - the subnets in AZ must be inside of the cidr block and non overlapping. example values:
+	// generate subnets - the subnets in AZ must be inside of the cidr block and non overlapping,
+	// every zone gets a quarter of the nodes CIDR. example values:
 	//vpc:
 	//cidr: 10.250.0.0/16
 	//zones:
@@ -139,14 +147,13 @@ func generateMultipleAWSZones(zoneNames []string) []*gqlschema.AWSZoneInput {
 	//workers: 10.250.128.0/19
 	//public: 10.250.160.0/20
 	//internal: 10.250.176.0/20
-	workerSubnetFmt := "10.250.%d.0/19"
-	lbSubnetFmt := "10.250.%d.0/20"
 	for i, name := range zoneNames {
+		zone := networking.Subnet(nodes, 2, i)
 		zones = append(zones, &gqlschema.AWSZoneInput{
 			Name:         name,
-			WorkerCidr:   fmt.Sprintf(workerSubnetFmt, 64*i),
-			PublicCidr:   fmt.Sprintf(lbSubnetFmt, 64*i+32),
-			InternalCidr: fmt.Sprintf(lbSubnetFmt, 64*i+48),
+			WorkerCidr:   networking.Subnet(zone, 1, 0).String(),
+			PublicCidr:   networking.Subnet(zone, 2, 2).String(),
+			InternalCidr: networking.Subnet(zone, 2, 3).String(),
 		})
 	}
 
@@ -166,6 +173,22 @@ func (p *AWSInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp inter
 		}
 		input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones = generateMultipleAWSZones(MultipleZonesForAWSRegion(*pp.Parameters.Region, zonesCount))
 	}
+
+	if pp.Parameters.Networking != nil {
+		_, nodes, err := net.ParseCIDR(pp.Parameters.Networking.NodesCidr)
+		if err != nil {
+			// the networking parameters are validated by the broker
+			return
+		}
+		awsConfig := input.GardenerConfig.ProviderSpecificConfig.AwsConfig
+		zoneNames := make([]string, 0, len(awsConfig.AwsZones))
+		for _, zone := range awsConfig.AwsZones {
+			zoneNames = append(zoneNames, zone.Name)
+		}
+		input.GardenerConfig.WorkerCidr = nodes.String()
+		awsConfig.VpcCidr = nodes.String()
+		awsConfig.AwsZones = generateAWSZones(nodes, zoneNames)
+	}
 }
 
 func (p *AWSInput) Profile() gqlschema.KymaProfile {
@@ -6,6 +6,7 @@ import (
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -200,6 +201,30 @@ func TestAWSInput_MultiZone_ApplyParameters(t *testing.T) {
 		}
 		assert.Equal(t, "zone", *input.GardenerConfig.ControlPlaneFailureTolerance)
 	})
+
+	// when
+	t.Run("use networking input parameter", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+		zones := []string{"eu-central-1a", "eu-central-1b", "eu-central-1c"}
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{
+			Parameters: internal.ProvisioningParametersDTO{
+				Zones:      zones,
+				Networking: &internal.NetworkingDTO{NodesCidr: "10.180.0.0/20"},
+			},
+		})
+
+		//then
+		assert.Equal(t, "10.180.0.0/20", input.GardenerConfig.WorkerCidr)
+		assert.Equal(t, "10.180.0.0/20", input.GardenerConfig.ProviderSpecificConfig.AwsConfig.VpcCidr)
+		assert.Equal(t, []*gqlschema.AWSZoneInput{
+			{Name: "eu-central-1a", WorkerCidr: "10.180.0.0/23", PublicCidr: "10.180.2.0/24", InternalCidr: "10.180.3.0/24"},
+			{Name: "eu-central-1b", WorkerCidr: "10.180.4.0/23", PublicCidr: "10.180.6.0/24", InternalCidr: "10.180.7.0/24"},
+			{Name: "eu-central-1c", WorkerCidr: "10.180.8.0/23", PublicCidr: "10.180.10.0/24", InternalCidr: "10.180.11.0/24"},
+		}, input.GardenerConfig.ProviderSpecificConfig.AwsConfig.AwsZones)
+	})
 }
 
 func TestAWSTrialInput_ApplyParameters(t *testing.T) {
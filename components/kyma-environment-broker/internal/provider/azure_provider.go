@@ -1,12 +1,13 @@
 package provider
 
 import (
-	"fmt"
 	"math/rand"
+	"net"
 	"strconv"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/networking"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
@@ -56,14 +57,14 @@ func (p *AzureInput) Defaults() *gqlschema.ClusterConfigInput {
 			MachineType:    "Standard_D4_v3",
 			Region:         DefaultAzureRegion,
 			Provider:       "azure",
-			WorkerCidr:     "10.250.0.0/16",
+			WorkerCidr:     networking.DefaultNodesCIDR,
 			AutoScalerMin:  3,
 			AutoScalerMax:  20,
 			MaxSurge:       1,
 			MaxUnavailable: 0,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
-					VnetCidr:         "10.250.0.0/16",
+					VnetCidr:         networking.DefaultNodesCIDR,
 					AzureZones:       generateMultipleAzureZones(generateRandomAzureZones(zonesCount)),
 					EnableNatGateway: ptr.Bool(true),
 				},
@@ -86,6 +87,22 @@ func (p *AzureInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp int
 		}
 		input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones = generateMultipleAzureZones(zones)
 	}
+
+	if pp.Parameters.Networking != nil {
+		_, nodes, err := net.ParseCIDR(pp.Parameters.Networking.NodesCidr)
+		if err != nil {
+			// the networking parameters are validated by the broker
+			return
+		}
+		azureConfig := input.GardenerConfig.ProviderSpecificConfig.AzureConfig
+		zoneNames := make([]int, 0, len(azureConfig.AzureZones))
+		for _, zone := range azureConfig.AzureZones {
+			zoneNames = append(zoneNames, zone.Name)
+		}
+		input.GardenerConfig.WorkerCidr = nodes.String()
+		azureConfig.VnetCidr = nodes.String()
+		azureConfig.AzureZones = generateAzureZones(nodes, zoneNames)
+	}
 }
 
 func (p *AzureInput) Profile() gqlschema.KymaProfile {
@@ -236,12 +253,18 @@ func generateRandomAzureZones(zonesCount int) []int {
 }
 
 func generateMultipleAzureZones(zoneNames []int) []*gqlschema.AzureZoneInput {
-	subnetFmt := "10.250.%d.0/19"
+	_, nodes, _ := net.ParseCIDR(networking.DefaultNodesCIDR)
+	return generateAzureZones(nodes, zoneNames)
+}
+
+// generateAzureZones creates the subnets of the zones, every zone gets an eighth of the nodes CIDR,
+// for example 10.250.0.0/19, 10.250.32.0/19, and 10.250.64.0/19 for 10.250.0.0/16
+func generateAzureZones(nodes *net.IPNet, zoneNames []int) []*gqlschema.AzureZoneInput {
 	zones := []*gqlschema.AzureZoneInput{}
 	for i, zone := range zoneNames {
 		zones = append(zones, &gqlschema.AzureZoneInput{
 			Name: zone,
-			Cidr: fmt.Sprintf(subnetFmt, i*32),
+			Cidr: networking.Subnet(nodes, 3, i).String(),
 		})
 	}
 
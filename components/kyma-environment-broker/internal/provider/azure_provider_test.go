@@ -206,6 +206,28 @@ func TestAzureInput_MultiZone_ApplyParameters(t *testing.T) {
 		}
 		assert.Equal(t, "zone", *input.GardenerConfig.ControlPlaneFailureTolerance)
 	})
+
+	// when
+	t.Run("use networking parameter", func(t *testing.T) {
+		// given
+		input := svc.Defaults()
+
+		// when
+		svc.ApplyParameters(input, internal.ProvisioningParameters{
+			Parameters: internal.ProvisioningParametersDTO{
+				Zones:      []string{"2", "3"},
+				Networking: &internal.NetworkingDTO{NodesCidr: "10.180.0.0/20"},
+			},
+		})
+
+		//then
+		assert.Equal(t, "10.180.0.0/20", input.GardenerConfig.WorkerCidr)
+		assert.Equal(t, "10.180.0.0/20", input.GardenerConfig.ProviderSpecificConfig.AzureConfig.VnetCidr)
+		assert.Equal(t, []*gqlschema.AzureZoneInput{
+			{Name: 2, Cidr: "10.180.0.0/23"},
+			{Name: 3, Cidr: "10.180.2.0/23"},
+		}, input.GardenerConfig.ProviderSpecificConfig.AzureConfig.AzureZones)
+	})
 }
 
 func azureZoneNames(zones []*gqlschema.AzureZoneInput) []int {
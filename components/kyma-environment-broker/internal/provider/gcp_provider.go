@@ -82,6 +82,10 @@ func (p *GcpInput) ApplyParameters(input *gqlschema.ClusterConfigInput, pp inter
 		}
 		updateSlice(&input.GardenerConfig.ProviderSpecificConfig.GcpConfig.Zones, ZonesForGCPRegion(*pp.Parameters.Region, zonesCount))
 	}
+
+	if pp.Parameters.Networking != nil {
+		input.GardenerConfig.WorkerCidr = pp.Parameters.Networking.NodesCidr
+	}
 }
 
 func (p *GcpInput) Profile() gqlschema.KymaProfile {
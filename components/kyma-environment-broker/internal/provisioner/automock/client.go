@@ -3,6 +3,8 @@
 package automock
 
 import (
+	context "context"
+
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -33,6 +35,29 @@ func (_m *Client) DeprovisionRuntime(accountID string, runtimeID string) (string
 	return r0, r1
 }
 
+// HibernatedRuntimes provides a mock function with given fields: ctx, accountID, runtimeIDs
+func (_m *Client) HibernatedRuntimes(ctx context.Context, accountID string, runtimeIDs []string) (map[string]bool, error) {
+	ret := _m.Called(ctx, accountID, runtimeIDs)
+
+	var r0 map[string]bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) map[string]bool); ok {
+		r0 = rf(ctx, accountID, runtimeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, accountID, runtimeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ProvisionRuntime provides a mock function with given fields: accountID, subAccountID, config
 func (_m *Client) ProvisionRuntime(accountID string, subAccountID string, config gqlschema.ProvisionRuntimeInput) (gqlschema.OperationStatus, error) {
 	ret := _m.Called(accountID, subAccountID, config)
@@ -19,6 +19,9 @@ const (
 	subAccountIDKey = "sub-account"
 )
 
+// runtimesPageSize is the maximum number of runtimes returned by one runtimes query
+const runtimesPageSize = 100
+
 //go:generate mockery --name=Client --output=automock --outpkg=automock --case=underscore
 
 type Client interface {
@@ -29,6 +32,9 @@ type Client interface {
 	ReconnectRuntimeAgent(accountID, runtimeID string) (string, error)
 	RuntimeOperationStatus(accountID, operationID string) (schema.OperationStatus, error)
 	RuntimeStatus(accountID, runtimeID string) (schema.RuntimeStatus, error)
+	// HibernatedRuntimes returns the hibernation state of the given runtimes mapped by the runtime ID,
+	// the runtimes not known by the provisioner are omitted
+	HibernatedRuntimes(ctx context.Context, accountID string, runtimeIDs []string) (map[string]bool, error)
 }
 
 type client struct {
@@ -160,7 +166,41 @@ func (c *client) RuntimeStatus(accountID, runtimeID string) (schema.RuntimeStatu
 	return response, nil
 }
 
+// HibernatedRuntimes lists the given runtimes in pages, the hibernation state is based on the last operation
+// of the runtime, so the provisioner does not call Gardener. The account ID is required by the query,
+// but the runtimes of the other accounts are listed as well.
+func (c *client) HibernatedRuntimes(ctx context.Context, accountID string, runtimeIDs []string) (map[string]bool, error) {
+	hibernated := make(map[string]bool, len(runtimeIDs))
+	for start := 0; start < len(runtimeIDs); start += runtimesPageSize {
+		end := start + runtimesPageSize
+		if end > len(runtimeIDs) {
+			end = len(runtimeIDs)
+		}
+		query := c.queryProvider.runtimesHibernationStatus(runtimeIDs[start:end])
+		req := gcli.NewRequest(query)
+		req.Header.Add(accountIDKey, accountID)
+
+		var response schema.RuntimeConnection
+		err := c.executeRequestWithContext(ctx, req, &response)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to get the hibernation status of Runtimes")
+		}
+		for _, edge := range response.Edges {
+			if edge == nil || edge.Node == nil || edge.Node.LastOperationStatus == nil || edge.Node.LastOperationStatus.RuntimeID == nil {
+				continue
+			}
+			status := edge.Node.HibernationStatus
+			hibernated[*edge.Node.LastOperationStatus.RuntimeID] = status != nil && status.Hibernated != nil && *status.Hibernated
+		}
+	}
+	return hibernated, nil
+}
+
 func (c *client) executeRequest(req *gcli.Request, respDestination interface{}) error {
+	return c.executeRequestWithContext(context.TODO(), req, respDestination)
+}
+
+func (c *client) executeRequestWithContext(ctx context.Context, req *gcli.Request, respDestination interface{}) error {
 	if reflect.ValueOf(respDestination).Kind() != reflect.Ptr {
 		return errors.New("destination is not of pointer type")
 	}
@@ -170,7 +210,7 @@ func (c *client) executeRequest(req *gcli.Request, respDestination interface{})
 	}
 
 	wrapper := &graphQLResponseWrapper{Result: respDestination}
-	err := c.graphQLClient.Run(context.TODO(), req, wrapper)
+	err := c.graphQLClient.Run(ctx, req, wrapper)
 	switch {
 	case isNotFoundError(err):
 		return kebError.NotFoundError{}
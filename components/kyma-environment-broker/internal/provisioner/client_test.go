@@ -393,6 +393,43 @@ func TestClient_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestClient_HibernatedRuntimes(t *testing.T) {
+	t.Run("should return the hibernation state of the known runtimes", func(t *testing.T) {
+		// Given
+		tr := &testResolver{t: t, runtime: &testRuntime{}}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+		_, err := client.ProvisionRuntime(testAccountID, testSubAccountID, fixProvisionRuntimeInput())
+		assert.NoError(t, err)
+		tr.getRuntime().hibernated = true
+
+		// When
+		hibernated, err := client.HibernatedRuntimes(context.Background(), testAccountID, []string{provisionRuntimeID, "unknown-runtime-id"})
+
+		// Then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]bool{provisionRuntimeID: true}, hibernated)
+	})
+
+	t.Run("provisioner should return error", func(t *testing.T) {
+		// Given
+		tr := &testResolver{t: t, runtime: &testRuntime{}, failed: true}
+		testServer := fixHTTPServer(tr)
+		defer testServer.Close()
+
+		client := NewProvisionerClient(testServer.URL, false)
+
+		// When
+		hibernated, err := client.HibernatedRuntimes(context.Background(), testAccountID, []string{provisionRuntimeID})
+
+		// Then
+		assert.Error(t, err)
+		assert.Nil(t, hibernated)
+	})
+}
+
 func TestClient_OperationStatusLastError(t *testing.T) {
 	t.Run("nil last error", func(t *testing.T) {
 		// Given
@@ -451,6 +488,7 @@ type testRuntime struct {
 	provisionOperationID   string
 	upgradeOperationID     string
 	deprovisionOperationID string
+	hibernated             bool
 }
 
 type testResolver struct {
@@ -576,6 +614,10 @@ func (tmr testMutationResolver) HibernateRuntime(ctx context.Context, id string)
 	return nil, errors.New("not implemented")
 }
 
+func (tmr testMutationResolver) WakeUpRuntime(ctx context.Context, id string) (*schema.OperationStatus, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (tmr testMutationResolver) RollBackUpgradeOperation(_ context.Context, id string) (*schema.RuntimeStatus, error) {
 	return nil, nil
 }
@@ -643,6 +685,36 @@ func (tqr testQueryResolver) RuntimeOperationStatus(_ context.Context, id string
 	return nil, nil
 }
 
+func (tqr testQueryResolver) Runtimes(_ context.Context, filter *schema.RuntimesFilter, _ *int, _ *string) (*schema.RuntimeConnection, error) {
+	tqr.t.Log("Runtimes - testQueryResolver")
+
+	if tqr.failed {
+		return nil, fmt.Errorf("query about runtimes failed")
+	}
+
+	connection := &schema.RuntimeConnection{PageInfo: &schema.PageInfo{}}
+	for _, id := range filter.RuntimeIDs {
+		if tqr.runtime.runtimeID != id {
+			continue
+		}
+		connection.Edges = append(connection.Edges, &schema.RuntimeEdge{
+			Cursor: id,
+			Node: &schema.RuntimeStatus{
+				LastOperationStatus: &schema.OperationStatus{
+					ID:        ptr.String(tqr.runtime.provisionOperationID),
+					Operation: schema.OperationTypeProvision,
+					State:     schema.OperationStateSucceeded,
+					RuntimeID: ptr.String(id),
+				},
+				HibernationStatus: &schema.HibernationStatus{Hibernated: ptr.Bool(tqr.runtime.hibernated)},
+			},
+		})
+	}
+	connection.TotalCount = len(connection.Edges)
+
+	return connection, nil
+}
+
 func fixProvisionRuntimeInput() schema.ProvisionRuntimeInput {
 	disabled := false
 	return schema.ProvisionRuntimeInput{
@@ -210,6 +210,22 @@ func (c *FakeClient) RuntimeStatus(accountID, runtimeID string) (schema.RuntimeS
 	return schema.RuntimeStatus{}, errors.New("no status for given runtime id")
 }
 
+// HibernatedRuntimes reports the runtimes provisioned by the fake client as not hibernated
+func (c *FakeClient) HibernatedRuntimes(_ context.Context, accountID string, runtimeIDs []string) (map[string]bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hibernated := make(map[string]bool)
+	for _, id := range runtimeIDs {
+		for _, ops := range c.operations {
+			if ops.RuntimeID != nil && *ops.RuntimeID == id {
+				hibernated[id] = false
+			}
+		}
+	}
+	return hibernated, nil
+}
+
 func (c *FakeClient) UpgradeRuntime(accountID, runtimeID string, config schema.UpgradeRuntimeInput) (schema.OperationStatus, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
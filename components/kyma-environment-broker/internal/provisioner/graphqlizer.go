@@ -90,6 +90,12 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 		{{- end }}
 		targetSecret: "{{ .TargetSecret }}",
 		workerCidr: "{{ .WorkerCidr }}",
+		{{- if .PodsCidr }}
+		podsCidr: "{{ .PodsCidr }}",
+		{{- end }}
+		{{- if .ServicesCidr }}
+		servicesCidr: "{{ .ServicesCidr }}",
+		{{- end }}
 		autoScalerMin: {{ .AutoScalerMin }},
 		autoScalerMax: {{ .AutoScalerMax }},
 		maxSurge: {{ .MaxSurge }},
@@ -135,9 +141,70 @@ func (g *Graphqlizer) GardenerConfigInputToGraphQL(in gqlschema.GardenerConfigIn
 		{{- if .ControlPlaneFailureTolerance }}
 		controlPlaneFailureTolerance: "{{ .ControlPlaneFailureTolerance }}",
 		{{- end }}
+		{{- if .AdditionalWorkerNodePools }}
+		additionalWorkerNodePools: {{ WorkerNodePoolsInputToGraphQL .AdditionalWorkerNodePools }},
+		{{- end }}
+		{{- if .CertIssuers }}
+		certIssuers: {{ CertIssuersInputToGraphQL .CertIssuers }},
+		{{- end }}
+		{{- if .HibernationSchedules }}
+		hibernationSchedules: {{ HibernationSchedulesInputToGraphQL .HibernationSchedules }},
+		{{- end }}
 	}`)
 }
 
+func (g *Graphqlizer) HibernationSchedulesInputToGraphQL(in []*gqlschema.HibernationScheduleInput) (string, error) {
+	return g.genericToGraphQL(in, `[
+		{{- range . }}
+		{
+			{{- if .Start }}
+			start: "{{ .Start }}",
+			{{- end }}
+			{{- if .End }}
+			end: "{{ .End }}",
+			{{- end }}
+			{{- if .Location }}
+			location: "{{ .Location }}",
+			{{- end }}
+		}
+		{{- end }}
+	]`)
+}
+
+func (g *Graphqlizer) CertIssuersInputToGraphQL(in []*gqlschema.CertIssuerInput) (string, error) {
+	return g.genericToGraphQL(in, `[
+		{{- range . }}
+		{
+			name: "{{ .Name }}",
+			server: "{{ .Server }}",
+			email: "{{ .Email }}",
+			{{- if .PrivateKeySecretName }}
+			privateKeySecretName: "{{ .PrivateKeySecretName }}",
+			{{- end }}
+			{{- if .Domains }}
+			domains: {{ .Domains | marshal }},
+			{{- end }}
+		}
+		{{- end }}
+	]`)
+}
+
+func (g *Graphqlizer) WorkerNodePoolsInputToGraphQL(in []*gqlschema.WorkerNodePoolInput) (string, error) {
+	return g.genericToGraphQL(in, `[
+		{{- range . }}
+		{
+			name: "{{ .Name }}",
+			machineType: "{{ .MachineType }}",
+			{{- if .VolumeSizeGb }}
+			volumeSizeGB: {{ .VolumeSizeGb }},
+			{{- end }}
+			autoScalerMin: {{ .AutoScalerMin }},
+			autoScalerMax: {{ .AutoScalerMax }},
+		}
+		{{- end }}
+	]`)
+}
+
 func (g *Graphqlizer) DNSConfigInputToGraphQL(in gqlschema.DNSConfigInput) (string, error) {
 	return g.genericToGraphQL(in, `{
 			domain: "{{ .Domain }}",
@@ -277,6 +344,9 @@ func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgrade
 		{{- if .MachineImageVersion }}
 		machineImageVersion: "{{.MachineImageVersion}}",
 		{{- end }}
+		{{- if .VolumeSizeGb }}
+		volumeSizeGB: {{ .VolumeSizeGb }},
+		{{- end }}
 		{{- if .AutoScalerMin }}
 		autoScalerMin: {{ .AutoScalerMin }},
 		{{- end }}
@@ -308,6 +378,12 @@ func (g *Graphqlizer) GardenerUpgradeInputToGraphQL(in gqlschema.GardenerUpgrade
 			usernamePrefix: "{{ .OidcConfig.UsernamePrefix }}",
 		},
 		{{- end }}
+		{{- if ne .AdditionalWorkerNodePools nil }}
+		additionalWorkerNodePools: {{ WorkerNodePoolsInputToGraphQL .AdditionalWorkerNodePools }},
+		{{- end }}
+		{{- if ne .HibernationSchedules nil }}
+		hibernationSchedules: {{ HibernationSchedulesInputToGraphQL .HibernationSchedules }},
+		{{- end }}
 	}`)
 }
 
@@ -371,6 +447,9 @@ func (g *Graphqlizer) genericToGraphQL(obj interface{}, tmpl string) (string, er
 	fm["AWSProviderConfigInputToGraphQL"] = g.AWSProviderConfigInputToGraphQL
 	fm["OpenStackProviderConfigInputToGraphQL"] = g.OpenStackProviderConfigInputToGraphQL
 	fm["DNSConfigInputToGraphQL"] = g.DNSConfigInputToGraphQL
+	fm["WorkerNodePoolsInputToGraphQL"] = g.WorkerNodePoolsInputToGraphQL
+	fm["CertIssuersInputToGraphQL"] = g.CertIssuersInputToGraphQL
+	fm["HibernationSchedulesInputToGraphQL"] = g.HibernationSchedulesInputToGraphQL
 	fm["LabelsToGQL"] = g.LabelsToGQL
 	fm["strQuote"] = strconv.Quote
 
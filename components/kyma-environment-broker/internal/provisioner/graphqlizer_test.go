@@ -356,6 +356,98 @@ func Test_GardenerConfigInputToGraphQLWithMachineImage(t *testing.T) {
 	assert.Equal(t, exp, got)
 }
 
+func Test_GardenerConfigInputToGraphQLWithNetworking(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		name: "c-90a3016",
+		kubernetesVersion: "1.18",
+		volumeSizeGB: 50,
+		machineType: "Standard_D4_v3",
+		region: "europe",
+		provider: "Azure",
+		diskType: "Standard_LRS",
+		targetSecret: "scr",
+		workerCidr: "10.180.0.0/20",
+		podsCidr: "10.96.0.0/13",
+		servicesCidr: "10.104.0.0/16",
+		autoScalerMin: 0,
+		autoScalerMax: 0,
+		maxSurge: 0,
+		maxUnavailable: 0,
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		Name:              "c-90a3016",
+		Region:            "europe",
+		VolumeSizeGb:      ptr.Integer(50),
+		WorkerCidr:        "10.180.0.0/20",
+		PodsCidr:          ptr.String("10.96.0.0/13"),
+		ServicesCidr:      ptr.String("10.104.0.0/16"),
+		Provider:          "Azure",
+		DiskType:          ptr.String("Standard_LRS"),
+		TargetSecret:      "scr",
+		MachineType:       "Standard_D4_v3",
+		KubernetesVersion: "1.18",
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_GardenerConfigInputToGraphQLWithCertIssuers(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+		name: "c-90a3016",
+		kubernetesVersion: "1.18",
+		machineType: "Standard_D4_v3",
+		region: "europe",
+		provider: "Azure",
+		targetSecret: "scr",
+		workerCidr: "10.250.0.0/16",
+		autoScalerMin: 0,
+		autoScalerMax: 0,
+		maxSurge: 0,
+		maxUnavailable: 0,
+		certIssuers: [
+		{
+			name: "custom-domain",
+			server: "https://acme-v02.api.letsencrypt.org/directory",
+			email: "admin@example.com",
+			privateKeySecretName: "acme-account-key",
+			domains: ["kyma.example.com"],
+		}
+	],
+	}`
+
+	// when
+	got, err := sut.GardenerConfigInputToGraphQL(gqlschema.GardenerConfigInput{
+		Name:              "c-90a3016",
+		Region:            "europe",
+		WorkerCidr:        "10.250.0.0/16",
+		Provider:          "Azure",
+		TargetSecret:      "scr",
+		MachineType:       "Standard_D4_v3",
+		KubernetesVersion: "1.18",
+		CertIssuers: []*gqlschema.CertIssuerInput{
+			{
+				Name:                 "custom-domain",
+				Server:               "https://acme-v02.api.letsencrypt.org/directory",
+				Email:                "admin@example.com",
+				PrivateKeySecretName: ptr.String("acme-account-key"),
+				Domains:              []string{"kyma.example.com"},
+			},
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
 func Test_LabelsToGQL(t *testing.T) {
 
 	sut := Graphqlizer{}
@@ -692,3 +784,148 @@ func strPrt(s string) *string {
 func boolPtr(b bool) *bool {
 	return &b
 }
+
+func Test_UpgradeShootInputToGraphQLWithAdditionalWorkerNodePools(t *testing.T) {
+	sut := Graphqlizer{}
+
+	for name, tc := range map[string]struct {
+		pools []*gqlschema.WorkerNodePoolInput
+		exp   string
+	}{
+		"not changed pools": {
+			pools: nil,
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+	},
+}`,
+		},
+		"removed pools": {
+			pools: []*gqlschema.WorkerNodePoolInput{},
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+		additionalWorkerNodePools: [
+	],
+	},
+}`,
+		},
+		"replaced pools": {
+			pools: []*gqlschema.WorkerNodePoolInput{{Name: "gpu", MachineType: "g4dn.xlarge", VolumeSizeGb: ptr.Integer(80), AutoScalerMin: 1, AutoScalerMax: 3}},
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+		additionalWorkerNodePools: [
+		{
+			name: "gpu",
+			machineType: "g4dn.xlarge",
+			volumeSizeGB: 80,
+			autoScalerMin: 1,
+			autoScalerMax: 3,
+		}
+	],
+	},
+}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					MachineType:               strPrt("m5.xlarge"),
+					AdditionalWorkerNodePools: tc.pools,
+				},
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, got)
+		})
+	}
+}
+
+func Test_UpgradeShootInputToGraphQLWithVolumeSize(t *testing.T) {
+	// given
+	sut := Graphqlizer{}
+	exp := `{
+	gardenerConfig: {
+		machineType: "m5.2xlarge",
+		volumeSizeGB: 80,
+	},
+}`
+
+	// when
+	got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+		GardenerConfig: &gqlschema.GardenerUpgradeInput{
+			MachineType:  strPrt("m5.2xlarge"),
+			VolumeSizeGb: ptr.Integer(80),
+		},
+	})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exp, got)
+}
+
+func Test_UpgradeShootInputToGraphQLWithHibernationSchedules(t *testing.T) {
+	sut := Graphqlizer{}
+
+	for name, tc := range map[string]struct {
+		schedules []*gqlschema.HibernationScheduleInput
+		exp       string
+	}{
+		"not changed schedules": {
+			schedules: nil,
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+	},
+}`,
+		},
+		"removed schedules": {
+			schedules: []*gqlschema.HibernationScheduleInput{},
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+		hibernationSchedules: [
+	],
+	},
+}`,
+		},
+		"replaced schedules": {
+			schedules: []*gqlschema.HibernationScheduleInput{
+				{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+				{Start: ptr.String("00 18 * * 5")},
+			},
+			exp: `{
+	gardenerConfig: {
+		machineType: "m5.xlarge",
+		hibernationSchedules: [
+		{
+			start: "00 20 * * 1,2,3,4,5",
+			end: "00 08 * * 1,2,3,4,5",
+			location: "Europe/Berlin",
+		}
+		{
+			start: "00 18 * * 5",
+		}
+	],
+	},
+}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			got, err := sut.UpgradeShootInputToGraphQL(gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					MachineType:          strPrt("m5.xlarge"),
+					HibernationSchedules: tc.schedules,
+				},
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.exp, got)
+		})
+	}
+}
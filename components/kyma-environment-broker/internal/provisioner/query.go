@@ -1,6 +1,10 @@
 package provisioner
 
-import "fmt"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 type queryProvider struct{}
 
@@ -56,6 +60,23 @@ func (qp queryProvider) runtimeOperationStatus(operationID string) string {
 }`, operationID, operationStatusData())
 }
 
+func (qp queryProvider) runtimesHibernationStatus(runtimeIDs []string) string {
+	quoted := make([]string, 0, len(runtimeIDs))
+	for _, id := range runtimeIDs {
+		quoted = append(quoted, strconv.Quote(id))
+	}
+	return fmt.Sprintf(`query {
+	result: runtimes(filter: { runtimeIDs: [%s] }, first: %d) {
+		edges {
+			node {
+				lastOperationStatus { runtimeID }
+				hibernationStatus { hibernated }
+			}
+		}
+	}
+}`, strings.Join(quoted, ", "), len(runtimeIDs))
+}
+
 func runtimeStatusData() string {
 	return fmt.Sprintf(`lastOperationStatus {
 				operation
@@ -31,6 +31,10 @@ func Integer(in int) *int {
 	return &in
 }
 
+func Float64(in float64) *float64 {
+	return &in
+}
+
 func Time(in time.Time) *time.Time {
 	return &in
 }
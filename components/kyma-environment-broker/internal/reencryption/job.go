@@ -0,0 +1,115 @@
+package reencryption
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// Interval defines how often all instances, operations and bindings are checked
+	Interval  time.Duration `envconfig:"default=24h"`
+	BatchSize int           `envconfig:"default=100"`
+	// BatchInterval is the pause between the batches which limits the load of the database
+	BatchInterval time.Duration `envconfig:"default=1s"`
+}
+
+// Job encrypts the provisioning parameters of the instances and the operations, and the kubeconfigs of the bindings
+// with the primary encryption key, so the previous keys can be removed after the key rotation.
+// It reports the rows which cannot be decrypted.
+type Job struct {
+	cfg        Config
+	instances  storage.ReEncryption
+	operations storage.ReEncryption
+	bindings   storage.ReEncryption
+	log        logrus.FieldLogger
+}
+
+// Summary describes the rows processed in one run of the job
+type Summary struct {
+	Processed   int
+	ReEncrypted int
+	Failed      int
+	Tampered    int
+}
+
+func NewJob(cfg Config, instances, operations, bindings storage.ReEncryption, log logrus.FieldLogger) *Job {
+	return &Job{
+		cfg:        cfg,
+		instances:  instances,
+		operations: operations,
+		bindings:   bindings,
+		log:        log,
+	}
+}
+
+// Run re-encrypts the data immediately and then every interval until the context is done
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		j.ReEncrypt(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReEncrypt processes all instances, operations and bindings
+func (j *Job) ReEncrypt(ctx context.Context) map[string]Summary {
+	summaries := map[string]Summary{}
+	for _, table := range []struct {
+		name    string
+		storage storage.ReEncryption
+	}{
+		{name: "instances", storage: j.instances},
+		{name: "operations", storage: j.operations},
+		{name: "bindings", storage: j.bindings},
+	} {
+		log := j.log.WithField("table", table.name)
+		summary := j.reEncryptTable(ctx, table.storage, log)
+		summaries[table.name] = summary
+		log.Infof("re-encryption finished: processed %d, re-encrypted %d, failed %d, tampered %d",
+			summary.Processed, summary.ReEncrypted, summary.Failed, summary.Tampered)
+	}
+	return summaries
+}
+
+func (j *Job) reEncryptTable(ctx context.Context, rows storage.ReEncryption, log logrus.FieldLogger) Summary {
+	summary := Summary{}
+	lastID := ""
+	for {
+		result, err := rows.ReEncrypt(lastID, j.cfg.BatchSize)
+		if err != nil {
+			log.Errorf("while re-encrypting rows after %q: %s", lastID, err)
+			return summary
+		}
+		summary.Processed += result.Processed
+		summary.ReEncrypted += result.ReEncrypted
+		for _, failure := range result.Failures {
+			if failure.Tampered {
+				summary.Tampered++
+				log.Errorf("the encrypted data of %s failed the authentication, it was modified or encrypted with an unknown key: %s", failure.ID, failure.Err)
+				continue
+			}
+			summary.Failed++
+			log.Warnf("while re-encrypting %s: %s", failure.ID, failure.Err)
+		}
+		if result.LastID == "" || result.Processed < j.cfg.BatchSize {
+			return summary
+		}
+		lastID = result.LastID
+
+		select {
+		case <-ctx.Done():
+			return summary
+		case <-time.After(j.cfg.BatchInterval):
+		}
+	}
+}
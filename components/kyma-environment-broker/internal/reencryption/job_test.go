@@ -0,0 +1,68 @@
+package reencryption_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/reencryption"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJob_ReEncrypt(t *testing.T) {
+	// given
+	instances := newFakeRows(5, "i-2")
+	operations := newFakeRows(3, "")
+	bindings := newFakeRows(1, "")
+	job := reencryption.NewJob(reencryption.Config{BatchSize: 2}, instances, operations, bindings, logrus.New())
+
+	// when
+	summaries := job.ReEncrypt(context.Background())
+
+	// then
+	assert.Equal(t, reencryption.Summary{Processed: 5, ReEncrypted: 4, Tampered: 1}, summaries["instances"])
+	assert.Equal(t, reencryption.Summary{Processed: 3, ReEncrypted: 3}, summaries["operations"])
+	assert.Equal(t, []string{"", "i-1", "i-3"}, instances.calls)
+	assert.Equal(t, reencryption.Summary{Processed: 1, ReEncrypted: 1}, summaries["bindings"])
+	assert.Equal(t, []string{"", "i-1"}, operations.calls)
+	assert.Equal(t, []string{""}, bindings.calls)
+}
+
+type fakeRows struct {
+	ids      []string
+	tampered string
+	calls    []string
+}
+
+func newFakeRows(count int, tampered string) *fakeRows {
+	rows := &fakeRows{tampered: tampered}
+	for i := 0; i < count; i++ {
+		rows.ids = append(rows.ids, fmt.Sprintf("i-%d", i))
+	}
+	sort.Strings(rows.ids)
+	return rows
+}
+
+func (f *fakeRows) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	f.calls = append(f.calls, afterID)
+	result := dbmodel.ReEncryptionResult{}
+	for _, id := range f.ids {
+		if id <= afterID && afterID != "" {
+			continue
+		}
+		if result.Processed == limit {
+			break
+		}
+		result.Processed++
+		result.LastID = id
+		if id == f.tampered {
+			result.Failures = append(result.Failures, dbmodel.ReEncryptionFailure{ID: id, Tampered: true, Err: fmt.Errorf("tampered")})
+			continue
+		}
+		result.ReEncrypted++
+	}
+	return result, nil
+}
@@ -87,10 +87,28 @@ func (c *converter) NewDTO(instance internal.Instance) (pkg.RuntimeDTO, error) {
 	}
 
 	c.setRegionOrDefault(instance, &toReturn)
+	c.setHibernation(instance, &toReturn)
 
 	return toReturn, nil
 }
 
+func (c *converter) setHibernation(instance internal.Instance, runtime *pkg.RuntimeDTO) {
+	schedules := instance.Parameters.Parameters.HibernationSchedules
+	if len(schedules) == 0 {
+		return
+	}
+	runtime.Hibernation = &pkg.HibernationStatus{
+		Schedules: make([]pkg.HibernationSchedule, 0, len(schedules)),
+	}
+	for _, schedule := range schedules {
+		runtime.Hibernation.Schedules = append(runtime.Hibernation.Schedules, pkg.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+}
+
 func (c *converter) ApplyUpgradingKymaOperations(dto *pkg.RuntimeDTO, oprs []internal.UpgradeKymaOperation, totalCount int) {
 	if len(oprs) <= 0 {
 		return
@@ -7,8 +7,10 @@ import (
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConverting_Provisioning(t *testing.T) {
@@ -237,6 +239,39 @@ func fixDeprovisionOperation(state domain.LastOperationState, createdAt time.Tim
 	}
 }
 
+func TestConverting_HibernationSchedules(t *testing.T) {
+	// given
+	instance := fixInstance()
+	instance.Parameters.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+		{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+	}
+	svc := &converter{defaultSubaccountRegion: "eu"}
+	dto := runtime.RuntimeDTO{}
+
+	// when
+	svc.setHibernation(instance, &dto)
+
+	// then
+	assert.Equal(t, &runtime.HibernationStatus{
+		Schedules: []runtime.HibernationSchedule{
+			{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+		},
+	}, dto.Hibernation)
+}
+
+func TestConverting_NoHibernationSchedules(t *testing.T) {
+	// given
+	instance := fixInstance()
+	svc := NewConverter("eu")
+
+	// when
+	dto, err := svc.NewDTO(instance)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, dto.Hibernation)
+}
+
 func fixInstance() internal.Instance {
 	return internal.Instance{
 		InstanceID:                  "instance-id",
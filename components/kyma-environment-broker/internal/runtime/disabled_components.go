@@ -12,7 +12,7 @@ import (
 // more specifically it's map[PLAN_ID or SELECTOR][COMPONENT_NAME]
 //
 // Components located under the AllPlansSelector will be removed from every plan
-// All plans must be specified
+// All plans must be specified, the plans from the catalog use the components of their base plan
 //
 
 type DisabledComponentsProvider map[string]map[string]struct{}
@@ -59,6 +59,7 @@ func NewDisabledComponentsProvider() DisabledComponentsProvider {
 }
 
 func (p DisabledComponentsProvider) DisabledComponentsPerPlan(planID string) (map[string]struct{}, error) {
+	planID = broker.BasePlanID(planID)
 	if _, ok := p[planID]; !ok {
 		return nil, fmt.Errorf("unknown plan %s", planID)
 	}
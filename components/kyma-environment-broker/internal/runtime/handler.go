@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -13,29 +14,38 @@ import (
 	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 )
 
 const numberOfUpgradeOperationsToReturn = 2
 
+// hibernationStatusTimeout limits the time of getting the hibernation state of the listed runtimes from the provisioner
+const hibernationStatusTimeout = 5 * time.Second
+
 type Handler struct {
 	instancesDb     storage.Instances
 	operationsDb    storage.Operations
 	runtimeStatesDb storage.RuntimeStates
+	provisioner     provisioner.Client
 	converter       Converter
+	log             logrus.FieldLogger
 
 	defaultMaxPage int
 }
 
-func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, runtimeStatesDb storage.RuntimeStates, defaultMaxPage int, defaultRequestRegion string) *Handler {
+func NewHandler(instanceDb storage.Instances, operationDb storage.Operations, runtimeStatesDb storage.RuntimeStates, provisionerClient provisioner.Client, defaultMaxPage int, defaultRequestRegion string, log logrus.FieldLogger) *Handler {
 	return &Handler{
 		instancesDb:     instanceDb,
 		operationsDb:    operationDb,
 		runtimeStatesDb: runtimeStatesDb,
+		provisioner:     provisionerClient,
 		converter:       NewConverter(defaultRequestRegion),
+		log:             log,
 		defaultMaxPage:  defaultMaxPage,
 	}
 }
@@ -151,6 +161,7 @@ func (h *Handler) getRuntimes(w http.ResponseWriter, req *http.Request) {
 
 		toReturn = append(toReturn, dto)
 	}
+	h.setHibernated(req.Context(), toReturn)
 
 	runtimePage := pkg.RuntimesPage{
 		Data:       toReturn,
@@ -347,6 +358,39 @@ func (h *Handler) setRuntimeOptionalAttributes(instance internal.Instance, dto *
 	return nil
 }
 
+// setHibernated sets the hibernation state reported by the provisioner for the runtimes with the hibernation schedules,
+// the states of all runtimes are fetched in one call, the state is not set if the provisioner cannot provide it in time
+func (h *Handler) setHibernated(ctx context.Context, dtos []pkg.RuntimeDTO) {
+	accountID := ""
+	var runtimeIDs []string
+	for _, dto := range dtos {
+		if dto.Hibernation == nil || dto.RuntimeID == "" {
+			continue
+		}
+		accountID = dto.GlobalAccountID
+		runtimeIDs = append(runtimeIDs, dto.RuntimeID)
+	}
+	if len(runtimeIDs) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, hibernationStatusTimeout)
+	defer cancel()
+	hibernated, err := h.provisioner.HibernatedRuntimes(ctx, accountID, runtimeIDs)
+	if err != nil {
+		h.log.Warnf("unable to get the hibernation status of %d runtimes: %s", len(runtimeIDs), err)
+		return
+	}
+	for i := range dtos {
+		if dtos[i].Hibernation == nil {
+			continue
+		}
+		if state, found := hibernated[dtos[i].RuntimeID]; found {
+			dtos[i].Hibernation.Hibernated = ptr.Bool(state)
+		}
+	}
+}
+
 func determineKymaVersion(pOprs []internal.ProvisioningOperation, uOprs []internal.UpgradeKymaOperation) string {
 	kymaVersion := ""
 	kymaVersionSetAt := time.Time{}
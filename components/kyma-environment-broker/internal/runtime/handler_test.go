@@ -1,6 +1,7 @@
 package runtime_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -12,6 +13,8 @@ import (
 	pkg "github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner"
+	provisionerAutomock "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/provisioner/automock"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/ptr"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/runtime"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/memory"
@@ -19,6 +22,7 @@ import (
 	"github.com/pivotal-cf/brokerapi/v8/domain"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
@@ -49,7 +53,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = instances.Insert(testInstance2)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes?page_size=1", nil)
 		require.NoError(t, err)
@@ -100,7 +104,7 @@ func TestRuntimeHandler(t *testing.T) {
 		instances := memory.NewInstance(operations)
 		states := memory.NewRuntimeStates()
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "region")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "region", logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes?page_size=a", nil)
 		require.NoError(t, err)
@@ -155,7 +159,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertOperation(testOp2)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		req, err := http.NewRequest("GET", fmt.Sprintf("/runtimes?account=%s&subaccount=%s&instance_id=%s&runtime_id=%s&region=%s&shoot=%s", testID1, testID1, testID1, testID1, testID1, fmt.Sprintf("Shoot-%s", testID1)), nil)
 		require.NoError(t, err)
@@ -228,7 +232,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertDeprovisioningOperation(deprovOp3)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -334,7 +338,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -404,7 +408,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -473,7 +477,7 @@ func TestRuntimeHandler(t *testing.T) {
 		})
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		req, err := http.NewRequest("GET", "/runtimes", nil)
 		require.NoError(t, err)
@@ -525,7 +529,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = operations.InsertUpgradeKymaOperation(upgOp)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -656,7 +660,7 @@ func TestRuntimeHandler(t *testing.T) {
 		err = states.Insert(fixOpgClusterState)
 		require.NoError(t, err)
 
-		runtimeHandler := runtime.NewHandler(instances, operations, states, 2, "")
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisioner.NewFakeClient(), 2, "", logrus.New())
 
 		rr := httptest.NewRecorder()
 		router := mux.NewRouter()
@@ -683,6 +687,92 @@ func TestRuntimeHandler(t *testing.T) {
 		require.NotNil(t, out.Data[0].ClusterConfig)
 		assert.Equal(t, "1.19.19", out.Data[0].ClusterConfig.KubernetesVersion)
 	})
+
+	t.Run("should return the hibernation state reported by the provisioner", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		testInstance := fixInstance("instance-id", time.Now())
+		testInstance.Parameters.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+			{Start: ptr.String("00 20 * * 1,2,3,4,5"), End: ptr.String("00 08 * * 1,2,3,4,5"), Location: ptr.String("Europe/Berlin")},
+		}
+		require.NoError(t, instances.Insert(testInstance))
+
+		// the runtime without the schedules is not checked
+		otherInstance := fixInstance("other-instance-id", time.Now())
+		require.NoError(t, instances.Insert(otherInstance))
+
+		provisionerClient := &provisionerAutomock.Client{}
+		provisionerClient.On("HibernatedRuntimes", mock.Anything, testInstance.GlobalAccountID, []string{testInstance.RuntimeID}).
+			Return(map[string]bool{testInstance.RuntimeID: true}, nil).Once()
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisionerClient, 2, "", logrus.New())
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		// when
+		req, err := http.NewRequest("GET", "/runtimes", nil)
+		require.NoError(t, err)
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimesPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Len(t, out.Data, 2)
+		for _, dto := range out.Data {
+			if dto.InstanceID != testInstance.InstanceID {
+				assert.Nil(t, dto.Hibernation)
+				continue
+			}
+			require.NotNil(t, dto.Hibernation)
+			assert.Len(t, dto.Hibernation.Schedules, 1)
+			assert.Equal(t, ptr.Bool(true), dto.Hibernation.Hibernated)
+		}
+		provisionerClient.AssertExpectations(t)
+	})
+
+	t.Run("should not set the hibernation state if the provisioner cannot provide it in time", func(t *testing.T) {
+		// given
+		operations := memory.NewOperation()
+		instances := memory.NewInstance(operations)
+		states := memory.NewRuntimeStates()
+		testInstance := fixInstance("instance-id", time.Now())
+		testInstance.Parameters.Parameters.HibernationSchedules = []internal.HibernationScheduleDTO{
+			{Start: ptr.String("00 20 * * *")},
+		}
+		require.NoError(t, instances.Insert(testInstance))
+
+		provisionerClient := &provisionerAutomock.Client{}
+		provisionerClient.On("HibernatedRuntimes", mock.Anything, testInstance.GlobalAccountID, []string{testInstance.RuntimeID}).
+			Return(nil, context.DeadlineExceeded)
+		runtimeHandler := runtime.NewHandler(instances, operations, states, provisionerClient, 2, "", logrus.New())
+
+		rr := httptest.NewRecorder()
+		router := mux.NewRouter()
+		runtimeHandler.AttachRoutes(router)
+
+		// when
+		req, err := http.NewRequest("GET", "/runtimes", nil)
+		require.NoError(t, err)
+		router.ServeHTTP(rr, req)
+
+		// then
+		require.Equal(t, http.StatusOK, rr.Code)
+
+		var out pkg.RuntimesPage
+		err = json.Unmarshal(rr.Body.Bytes(), &out)
+		require.NoError(t, err)
+
+		require.Len(t, out.Data, 1)
+		require.NotNil(t, out.Data[0].Hibernation)
+		assert.Nil(t, out.Data[0].Hibernation.Hibernated)
+	})
 }
 
 func fixInstance(id string, t time.Time) internal.Instance {
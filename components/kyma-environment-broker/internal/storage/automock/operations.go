@@ -800,6 +800,27 @@ func (_m *Operations) ListUpgradeKymaOperationsByOrchestrationID(orchestrationID
 	return r0, r1, r2, r3
 }
 
+// ReEncrypt provides a mock function with given fields: afterID, limit
+func (_m *Operations) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	ret := _m.Called(afterID, limit)
+
+	var r0 dbmodel.ReEncryptionResult
+	if rf, ok := ret.Get(0).(func(string, int) dbmodel.ReEncryptionResult); ok {
+		r0 = rf(afterID, limit)
+	} else {
+		r0 = ret.Get(0).(dbmodel.ReEncryptionResult)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateDeprovisioningOperation provides a mock function with given fields: operation
 func (_m *Operations) UpdateDeprovisioningOperation(operation internal.DeprovisioningOperation) (*internal.DeprovisioningOperation, error) {
 	ret := _m.Called(operation)
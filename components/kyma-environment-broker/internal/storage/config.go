@@ -19,6 +19,10 @@ type Config struct {
 	SSLRootCert string `envconfig:"optional"`
 
 	SecretKey string `envconfig:"optional"`
+	// EncryptionKeys are the additional encryption keys in the "keyID1=key1,keyID2=key2" format
+	EncryptionKeys string `envconfig:"optional"`
+	// PrimaryEncryptionKeyID is the ID of the key used to encrypt the data, the SecretKey is used if empty
+	PrimaryEncryptionKeyID string `envconfig:"optional"`
 
 	MaxOpenConns    int           `envconfig:"default=8"`
 	MaxIdleConns    int           `envconfig:"default=2"`
@@ -0,0 +1,17 @@
+package dbmodel
+
+// ReEncryptionResult describes a batch of rows processed by the re-encryption
+type ReEncryptionResult struct {
+	// LastID is the ID of the last row in the batch, the next batch starts after it. It is empty when there are no more rows.
+	LastID      string
+	Processed   int
+	ReEncrypted int
+	Failures    []ReEncryptionFailure
+}
+
+type ReEncryptionFailure struct {
+	ID string
+	// Tampered is set when the authentication of the encrypted data failed
+	Tampered bool
+	Err      error
+}
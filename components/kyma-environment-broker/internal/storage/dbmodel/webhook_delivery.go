@@ -0,0 +1,22 @@
+package dbmodel
+
+import (
+	"time"
+)
+
+type WebhookDeliveryDTO struct {
+	ID          string
+	Webhook     string
+	Event       string
+	OperationID string
+	InstanceID  string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	Payload      string
+	State        string
+	Attempts     int
+	ResponseCode int
+	LastError    string
+}
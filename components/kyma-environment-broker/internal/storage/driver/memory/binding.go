@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+)
+
+type bindings struct {
+	mu sync.Mutex
+
+	data map[string]map[string]internal.Binding
+}
+
+func NewBindings() *bindings {
+	return &bindings{
+		data: make(map[string]map[string]internal.Binding),
+	}
+}
+
+func (s *bindings) Insert(binding internal.Binding) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.data[binding.InstanceID][binding.ID]; found {
+		return dberr.AlreadyExists("binding with id %s already exist", binding.ID)
+	}
+	if s.data[binding.InstanceID] == nil {
+		s.data[binding.InstanceID] = make(map[string]internal.Binding)
+	}
+	s.data[binding.InstanceID][binding.ID] = binding
+
+	return nil
+}
+
+func (s *bindings) GetByID(instanceID, bindingID string) (*internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	binding, found := s.data[instanceID][bindingID]
+	if !found {
+		return nil, dberr.NotFound("binding with id %s not exist", bindingID)
+	}
+
+	return &binding, nil
+}
+
+func (s *bindings) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.Binding, 0)
+	for _, binding := range s.data[instanceID] {
+		result = append(result, binding)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result, nil
+}
+
+func (s *bindings) Delete(instanceID, bindingID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.data[instanceID], bindingID)
+
+	return nil
+}
+
+// ReEncrypt does nothing, the memory storage does not encrypt the data
+func (s *bindings) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	return dbmodel.ReEncryptionResult{}, nil
+}
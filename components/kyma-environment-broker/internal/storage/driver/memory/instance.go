@@ -3,7 +3,6 @@ package memory
 import (
 	"database/sql"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"sort"
 	"sync"
@@ -29,14 +28,9 @@ func NewInstance(operations *operations) *instances {
 	}
 }
 
-func (s *instances) InsertWithoutEncryption(instance internal.Instance) error {
-	return errors.New("not implemented")
-}
-func (s *instances) UpdateWithoutEncryption(instance internal.Instance) (*internal.Instance, error) {
-	return nil, errors.New("not implemented")
-}
-func (s *instances) ListWithoutDecryption(dbmodel.InstanceFilter) ([]internal.Instance, int, int, error) {
-	return nil, 0, 0, errors.New("not implemented")
+// ReEncrypt does nothing, the memory storage does not encrypt the data
+func (s *instances) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	return dbmodel.ReEncryptionResult{}, nil
 }
 
 func (s *instances) FindAllJoinedWithOperations(prct ...predicate.Predicate) ([]internal.InstanceWithOperation, error) {
@@ -148,6 +142,21 @@ func (s *instances) GetNumberOfInstancesForGlobalAccountID(globalAccountID strin
 	return numberOfInstances, nil
 }
 
+func (s *instances) GetPlanIDs() ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := map[string]bool{}
+	planIDs := make([]string, 0)
+	for _, inst := range s.instances {
+		if !found[inst.ServicePlanID] {
+			found[inst.ServicePlanID] = true
+			planIDs = append(planIDs, inst.ServicePlanID)
+		}
+	}
+	return planIDs, nil
+}
+
 func (s *instances) GetByID(instanceID string) (*internal.Instance, error) {
 	inst, ok := s.instances[instanceID]
 	if !ok {
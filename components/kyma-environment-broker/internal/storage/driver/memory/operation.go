@@ -186,6 +186,11 @@ func (s *operations) ListOperationsByInstanceID(instanceID string) ([]internal.O
 	return nil, dberr.NotFound("instance provisioning operations with instanceID %s not found", instanceID)
 }
 
+// ReEncrypt does nothing, the memory storage does not encrypt the data
+func (s *operations) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	return dbmodel.ReEncryptionResult{}, nil
+}
+
 func (s *operations) ListOperationsInTimeRange(from, to time.Time) ([]internal.Operation, error) {
 	panic("not implemented") //also not used in any tests
 	return nil, nil
@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+)
+
+type webhookDeliveries struct {
+	mu sync.Mutex
+
+	data map[string]internal.WebhookDelivery
+}
+
+func NewWebhookDeliveries() *webhookDeliveries {
+	return &webhookDeliveries{
+		data: make(map[string]internal.WebhookDelivery),
+	}
+}
+
+func (s *webhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.data[delivery.ID]; found {
+		return dberr.AlreadyExists("webhook delivery with id %s already exist", delivery.ID)
+	}
+	s.data[delivery.ID] = delivery
+
+	return nil
+}
+
+func (s *webhookDeliveries) Update(delivery internal.WebhookDelivery) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.data[delivery.ID]; !found {
+		return dberr.NotFound("webhook delivery with id %s not exist", delivery.ID)
+	}
+	s.data[delivery.ID] = delivery
+
+	return nil
+}
+
+func (s *webhookDeliveries) ListByOperationID(operationID string) ([]internal.WebhookDelivery, error) {
+	return s.list(func(delivery internal.WebhookDelivery) bool {
+		return delivery.OperationID == operationID
+	}), nil
+}
+
+func (s *webhookDeliveries) ListByState(state string) ([]internal.WebhookDelivery, error) {
+	return s.list(func(delivery internal.WebhookDelivery) bool {
+		return delivery.State == state
+	}), nil
+}
+
+func (s *webhookDeliveries) DeleteFinishedBefore(until time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for id, delivery := range s.data {
+		if delivery.State != internal.WebhookDeliveryPending && delivery.CreatedAt.Before(until) {
+			delete(s.data, id)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func (s *webhookDeliveries) list(match func(internal.WebhookDelivery) bool) []internal.WebhookDelivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]internal.WebhookDelivery, 0)
+	for _, delivery := range s.data {
+		if match(delivery) {
+			result = append(result, delivery)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return result
+}
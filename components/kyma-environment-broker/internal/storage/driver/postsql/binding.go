@@ -0,0 +1,128 @@
+package postsql
+
+import (
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type Binding struct {
+	postsql.Factory
+
+	cipher Cipher
+}
+
+func NewBinding(sess postsql.Factory, cipher Cipher) *Binding {
+	return &Binding{
+		Factory: sess,
+		cipher:  cipher,
+	}
+}
+
+func (s *Binding) Insert(binding internal.Binding) error {
+	dto, err := s.toBindingDTO(binding)
+	if err != nil {
+		return err
+	}
+	sess := s.NewWriteSession()
+	return sess.InsertBinding(dto)
+}
+
+func (s *Binding) GetByID(instanceID, bindingID string) (*internal.Binding, error) {
+	sess := s.NewReadSession()
+	bindingDTO := dbmodel.BindingDTO{}
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		bindingDTO, lastErr = sess.GetBindingByID(instanceID, bindingID)
+		if lastErr != nil {
+			if dberr.IsNotFound(lastErr) {
+				return false, dberr.NotFound("Binding with id %s not exist", bindingID)
+			}
+			log.Errorf("while getting binding %s for instance %s: %v", bindingID, instanceID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+	binding, err := s.toBinding(bindingDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return &binding, nil
+}
+
+func (s *Binding) ListByInstanceID(instanceID string) ([]internal.Binding, error) {
+	sess := s.NewReadSession()
+	var dtos []dbmodel.BindingDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = sess.ListBindingsByInstanceID(instanceID)
+		if lastErr != nil {
+			log.Errorf("while listing bindings for instance %s: %v", instanceID, lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	result := make([]internal.Binding, 0, len(dtos))
+	for _, dto := range dtos {
+		binding, err := s.toBinding(dto)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, binding)
+	}
+
+	return result, nil
+}
+
+func (s *Binding) Delete(instanceID, bindingID string) error {
+	sess := s.NewWriteSession()
+	return sess.DeleteBinding(instanceID, bindingID)
+}
+
+func (s *Binding) toBindingDTO(binding internal.Binding) (dbmodel.BindingDTO, error) {
+	encrypted, err := s.cipher.Encrypt([]byte(binding.Kubeconfig))
+	if err != nil {
+		return dbmodel.BindingDTO{}, errors.Wrap(err, "while encrypting kubeconfig")
+	}
+
+	return dbmodel.BindingDTO{
+		ID:                 binding.ID,
+		InstanceID:         binding.InstanceID,
+		CreatedAt:          binding.CreatedAt,
+		UpdatedAt:          binding.UpdatedAt,
+		ExpiresAt:          binding.ExpiresAt,
+		Role:               binding.Role,
+		ServiceAccountName: binding.ServiceAccountName,
+		Kubeconfig:         string(encrypted),
+	}, nil
+}
+
+func (s *Binding) toBinding(dto dbmodel.BindingDTO) (internal.Binding, error) {
+	decrypted, err := s.cipher.Decrypt([]byte(dto.Kubeconfig))
+	if err != nil {
+		return internal.Binding{}, errors.Wrap(err, "while decrypting kubeconfig")
+	}
+
+	return internal.Binding{
+		ID:                 dto.ID,
+		InstanceID:         dto.InstanceID,
+		CreatedAt:          dto.CreatedAt,
+		UpdatedAt:          dto.UpdatedAt,
+		ExpiresAt:          dto.ExpiresAt,
+		Role:               dto.Role,
+		ServiceAccountName: dto.ServiceAccountName,
+		Kubeconfig:         string(decrypted),
+	}, nil
+}
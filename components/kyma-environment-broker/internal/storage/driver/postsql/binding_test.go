@@ -0,0 +1,71 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinding(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should insert, fetch and delete Binding", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		givenBinding := internal.Binding{
+			ID:                 "binding-id",
+			InstanceID:         "instance-id",
+			CreatedAt:          now,
+			UpdatedAt:          now,
+			ExpiresAt:          now.Add(time.Hour),
+			Role:               "view",
+			ServiceAccountName: "kyma-binding-binding-id",
+			Kubeconfig:         "kubeconfig-content",
+		}
+
+		svc := brokerStorage.Bindings()
+
+		err = svc.Insert(givenBinding)
+		require.NoError(t, err)
+
+		err = svc.Insert(givenBinding)
+		assert.Error(t, err)
+
+		binding, err := svc.GetByID("instance-id", "binding-id")
+		require.NoError(t, err)
+		assert.Equal(t, "kubeconfig-content", binding.Kubeconfig)
+		assert.Equal(t, "view", binding.Role)
+		assert.True(t, givenBinding.ExpiresAt.Equal(binding.ExpiresAt))
+
+		bindings, err := svc.ListByInstanceID("instance-id")
+		require.NoError(t, err)
+		assert.Len(t, bindings, 1)
+
+		err = svc.Delete("instance-id", "binding-id")
+		require.NoError(t, err)
+
+		_, err = svc.GetByID("instance-id", "binding-id")
+		assert.True(t, dberr.IsNotFound(err))
+	})
+}
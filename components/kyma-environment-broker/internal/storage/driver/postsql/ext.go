@@ -1,10 +1,27 @@
 package postsql
 
-import "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+import (
+	"errors"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+)
+
+// ErrCiphertextTampered is returned when the authentication of the encrypted data fails,
+// the data was modified in the database or encrypted with a different key with the same ID
+var ErrCiphertextTampered = errors.New("cipher text authentication failed")
+
+// ErrUnknownEncryptionKey is returned when the data is encrypted with a key which is not configured
+var ErrUnknownEncryptionKey = errors.New("unknown encryption key")
+
+// ErrNotEncrypted is returned when the data is neither the cipher text nor the legacy base64 encoded cipher text,
+// the kubeconfig was stored in a plain text by the previous KEB versions
+var ErrNotEncrypted = errors.New("the data is not encrypted")
 
 type Cipher interface {
 	Encrypt(text []byte) ([]byte, error)
 	Decrypt(text []byte) ([]byte, error)
+	// NeedsReEncryption checks if the text is not encrypted with the primary key
+	NeedsReEncryption(text []byte) bool
 
 	// methods used to encrypt/decrypt SM credentials
 	EncryptSMCreds(pp *internal.ProvisioningParameters) error
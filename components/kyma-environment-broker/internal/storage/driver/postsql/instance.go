@@ -28,135 +28,6 @@ func NewInstance(sess postsql.Factory, operations *operations, cipher Cipher) *I
 	}
 }
 
-func (s *Instance) InsertWithoutEncryption(instance internal.Instance) error {
-	_, err := s.GetByID(instance.InstanceID)
-	if err == nil {
-		return dberr.AlreadyExists("instance with id %s already exist", instance.InstanceID)
-	}
-	params, err := json.Marshal(instance.Parameters)
-	if err != nil {
-		return errors.Wrap(err, "while marshaling parameters")
-	}
-	dto := dbmodel.InstanceDTO{
-		InstanceID:             instance.InstanceID,
-		RuntimeID:              instance.RuntimeID,
-		GlobalAccountID:        instance.GlobalAccountID,
-		SubAccountID:           instance.SubAccountID,
-		ServiceID:              instance.ServiceID,
-		ServiceName:            instance.ServiceName,
-		ServicePlanID:          instance.ServicePlanID,
-		ServicePlanName:        instance.ServicePlanName,
-		DashboardURL:           instance.DashboardURL,
-		ProvisioningParameters: string(params),
-		ProviderRegion:         instance.ProviderRegion,
-		CreatedAt:              instance.CreatedAt,
-		UpdatedAt:              instance.UpdatedAt,
-		DeletedAt:              instance.DeletedAt,
-		Version:                instance.Version,
-		Provider:               string(instance.Provider),
-	}
-
-	sess := s.NewWriteSession()
-	return wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		err := sess.InsertInstance(dto)
-		if err != nil {
-			log.Errorf("while saving instance ID %s: %v", instance.InstanceID, err)
-			return false, nil
-		}
-		return true, nil
-	})
-}
-
-func (s *Instance) ListWithoutDecryption(filter dbmodel.InstanceFilter) ([]internal.Instance, int, int, error) {
-	dtos, count, totalCount, err := s.NewReadSession().ListInstances(filter)
-	if err != nil {
-		return []internal.Instance{}, 0, 0, err
-	}
-	var instances []internal.Instance
-	for _, dto := range dtos {
-		var params internal.ProvisioningParameters
-		err := json.Unmarshal([]byte(dto.ProvisioningParameters), &params)
-		if err != nil {
-			return nil, 0, 0, errors.Wrap(err, "while unmarshal parameters")
-		}
-		instance := internal.Instance{
-			InstanceID:      dto.InstanceID,
-			RuntimeID:       dto.RuntimeID,
-			GlobalAccountID: dto.GlobalAccountID,
-			SubAccountID:    dto.SubAccountID,
-			ServiceID:       dto.ServiceID,
-			ServiceName:     dto.ServiceName,
-			ServicePlanID:   dto.ServicePlanID,
-			ServicePlanName: dto.ServicePlanName,
-			DashboardURL:    dto.DashboardURL,
-			Parameters:      params,
-			ProviderRegion:  dto.ProviderRegion,
-			CreatedAt:       dto.CreatedAt,
-			UpdatedAt:       dto.UpdatedAt,
-			DeletedAt:       dto.DeletedAt,
-			Version:         dto.Version,
-			Provider:        internal.CloudProvider(dto.Provider),
-		}
-		instances = append(instances, instance)
-	}
-	return instances, count, totalCount, err
-}
-
-func (s *Instance) UpdateWithoutEncryption(instance internal.Instance) (*internal.Instance, error) {
-	sess := s.NewWriteSession()
-	params, err := json.Marshal(instance.Parameters)
-	if err != nil {
-		return nil, errors.Wrap(err, "while marshaling parameters")
-	}
-	dto := dbmodel.InstanceDTO{
-		InstanceID:             instance.InstanceID,
-		RuntimeID:              instance.RuntimeID,
-		GlobalAccountID:        instance.GlobalAccountID,
-		SubAccountID:           instance.SubAccountID,
-		ServiceID:              instance.ServiceID,
-		ServiceName:            instance.ServiceName,
-		ServicePlanID:          instance.ServicePlanID,
-		ServicePlanName:        instance.ServicePlanName,
-		DashboardURL:           instance.DashboardURL,
-		ProvisioningParameters: string(params),
-		ProviderRegion:         instance.ProviderRegion,
-		CreatedAt:              instance.CreatedAt,
-		UpdatedAt:              instance.UpdatedAt,
-		DeletedAt:              instance.DeletedAt,
-		Version:                instance.Version,
-		Provider:               string(instance.Provider),
-	}
-	var lastErr dberr.Error
-	err = wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
-		lastErr = sess.UpdateInstance(dto)
-
-		switch {
-		case dberr.IsNotFound(lastErr):
-			_, lastErr = s.NewReadSession().GetInstanceByID(instance.InstanceID)
-			if dberr.IsNotFound(lastErr) {
-				return false, dberr.NotFound("Instance with id %s not exist", instance.InstanceID)
-			}
-			if lastErr != nil {
-				log.Warn(errors.Wrapf(lastErr, "while getting Operation").Error())
-				return false, nil
-			}
-
-			// the operation exists but the version is different
-			lastErr = dberr.Conflict("operation update conflict, operation ID: %s", instance.InstanceID)
-			return false, lastErr
-		case lastErr != nil:
-			log.Errorf("while updating instance ID %s: %v", instance.InstanceID, lastErr)
-			return false, nil
-		}
-		return true, nil
-	})
-	if err != nil {
-		return nil, lastErr
-	}
-	instance.Version = instance.Version + 1
-	return &instance, nil
-}
-
 func (s *Instance) FindAllJoinedWithOperations(prct ...predicate.Predicate) ([]internal.InstanceWithOperation, error) {
 	sess := s.NewReadSession()
 	var (
@@ -300,6 +171,17 @@ func (s *Instance) GetNumberOfInstancesForGlobalAccountID(globalAccountID string
 	return result, err
 }
 
+func (s *Instance) GetPlanIDs() ([]string, error) {
+	sess := s.NewReadSession()
+	var result []string
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		planIDs, err := sess.GetInstancesPlanIDs()
+		result = planIDs
+		return err == nil, nil
+	})
+	return result, err
+}
+
 // TODO: Wrap retries in single method WithRetries
 func (s *Instance) GetByID(instanceID string) (*internal.Instance, error) {
 	sess := s.NewReadSession()
@@ -347,8 +229,11 @@ func (s *Instance) toInstance(dto dbmodel.InstanceDTO) (internal.Instance, error
 	}
 
 	err = s.cipher.DecryptKubeconfig(&params)
-	if err != nil {
+	switch {
+	case errors.Is(err, ErrNotEncrypted):
 		log.Warn("decrypting skipped because kubeconfig is in a plain text")
+	case err != nil:
+		return internal.Instance{}, errors.Wrapf(err, "while decrypting kubeconfig of instance %s", dto.InstanceID)
 	}
 
 	return internal.Instance{
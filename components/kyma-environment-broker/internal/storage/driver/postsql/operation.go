@@ -942,8 +942,11 @@ func (s *operations) toOperation(dto *dbmodel.OperationDTO, existingOp internal.
 	}
 
 	err = s.cipher.DecryptKubeconfig(&provisioningParameters)
-	if err != nil {
+	switch {
+	case errors.Is(err, ErrNotEncrypted):
 		log.Warn("decrypting skipped because kubeconfig is in a plain text")
+	case err != nil:
+		return internal.Operation{}, errors.Wrapf(err, "while decrypting kubeconfig of operation %s", dto.ID)
 	}
 
 	stages := make([]string, 0)
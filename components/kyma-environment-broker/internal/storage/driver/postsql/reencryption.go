@@ -0,0 +1,182 @@
+package postsql
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/pkg/errors"
+)
+
+// ReEncrypt encrypts the provisioning parameters of the instances following the given instance ID with the primary key.
+// The instances modified in the meantime are skipped, they are re-encrypted in the next run if needed.
+func (s *Instance) ReEncrypt(afterInstanceID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	dtos, dbErr := s.NewReadSession().ListInstancesParametersAfterID(afterInstanceID, limit)
+	if dbErr != nil {
+		return dbmodel.ReEncryptionResult{}, dbErr
+	}
+
+	result := dbmodel.ReEncryptionResult{}
+	sess := s.NewWriteSession()
+	for _, dto := range dtos {
+		result.LastID = dto.InstanceID
+		result.Processed++
+		params, changed, err := reEncryptParameters(s.cipher, dto.ProvisioningParameters)
+		if err != nil {
+			result.Failures = append(result.Failures, newReEncryptionFailure(dto.InstanceID, err))
+			continue
+		}
+		if !changed {
+			continue
+		}
+		dbErr = sess.ReplaceInstanceParameters(dto.InstanceID, dto.ProvisioningParameters, params)
+		switch {
+		case dbErr == nil:
+			result.ReEncrypted++
+		case dbErr.Code() != dberr.CodeConflict:
+			result.Failures = append(result.Failures, newReEncryptionFailure(dto.InstanceID, dbErr))
+		}
+	}
+	return result, nil
+}
+
+// ReEncrypt encrypts the provisioning parameters of the operations following the given operation ID with the primary key.
+// The operations modified in the meantime are skipped, they are re-encrypted in the next run if needed.
+func (s *operations) ReEncrypt(afterOperationID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	dtos, dbErr := s.NewReadSession().ListOperationsParametersAfterID(afterOperationID, limit)
+	if dbErr != nil {
+		return dbmodel.ReEncryptionResult{}, dbErr
+	}
+
+	result := dbmodel.ReEncryptionResult{}
+	sess := s.NewWriteSession()
+	for _, dto := range dtos {
+		result.LastID = dto.ID
+		result.Processed++
+		if !dto.ProvisioningParameters.Valid {
+			continue
+		}
+		params, changed, err := reEncryptParameters(s.cipher, dto.ProvisioningParameters.String)
+		if err != nil {
+			result.Failures = append(result.Failures, newReEncryptionFailure(dto.ID, err))
+			continue
+		}
+		if !changed {
+			continue
+		}
+		dbErr = sess.ReplaceOperationParameters(dto.ID, dto.ProvisioningParameters.String, params)
+		switch {
+		case dbErr == nil:
+			result.ReEncrypted++
+		case dbErr.Code() != dberr.CodeConflict:
+			result.Failures = append(result.Failures, newReEncryptionFailure(dto.ID, dbErr))
+		}
+	}
+	return result, nil
+}
+
+// ReEncrypt encrypts the kubeconfigs of the bindings following the given binding with the primary key.
+// The binding is identified by the instance ID and the binding ID joined with the slash.
+// The bindings modified in the meantime are skipped, they are re-encrypted in the next run if needed.
+func (s *Binding) ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error) {
+	instanceID, bindingID := splitBindingKey(afterID)
+	dtos, dbErr := s.NewReadSession().ListBindingsKubeconfigsAfterID(instanceID, bindingID, limit)
+	if dbErr != nil {
+		return dbmodel.ReEncryptionResult{}, dbErr
+	}
+
+	result := dbmodel.ReEncryptionResult{}
+	sess := s.NewWriteSession()
+	for _, dto := range dtos {
+		key := bindingKey(dto.InstanceID, dto.ID)
+		result.LastID = key
+		result.Processed++
+		if !s.cipher.NeedsReEncryption([]byte(dto.Kubeconfig)) {
+			continue
+		}
+		kubeconfig, err := s.cipher.Decrypt([]byte(dto.Kubeconfig))
+		if err != nil {
+			result.Failures = append(result.Failures, newReEncryptionFailure(key, errors.Wrap(err, "while decrypting kubeconfig")))
+			continue
+		}
+		encrypted, err := s.cipher.Encrypt(kubeconfig)
+		if err != nil {
+			result.Failures = append(result.Failures, newReEncryptionFailure(key, errors.Wrap(err, "while encrypting kubeconfig")))
+			continue
+		}
+		dbErr = sess.ReplaceBindingKubeconfig(dto.InstanceID, dto.ID, dto.Kubeconfig, string(encrypted))
+		switch {
+		case dbErr == nil:
+			result.ReEncrypted++
+		case dbErr.Code() != dberr.CodeConflict:
+			result.Failures = append(result.Failures, newReEncryptionFailure(key, dbErr))
+		}
+	}
+	return result, nil
+}
+
+func bindingKey(instanceID, bindingID string) string {
+	return instanceID + "/" + bindingID
+}
+
+func splitBindingKey(key string) (string, string) {
+	instanceID, bindingID, _ := strings.Cut(key, "/")
+	return instanceID, bindingID
+}
+
+// reEncryptParameters decrypts the encrypted provisioning parameters and encrypts them with the primary key.
+// It returns false if the parameters do not need to be re-encrypted.
+func reEncryptParameters(cipher Cipher, raw string) (string, bool, error) {
+	var pp internal.ProvisioningParameters
+	if err := json.Unmarshal([]byte(raw), &pp); err != nil {
+		return "", false, errors.Wrap(err, "while unmarshal parameters")
+	}
+	if !parametersNeedReEncryption(cipher, pp) {
+		return raw, false, nil
+	}
+
+	if err := cipher.DecryptSMCreds(&pp); err != nil {
+		return "", false, errors.Wrap(err, "while decrypting parameters")
+	}
+	// the kubeconfig stored in a plain text by the previous KEB versions is encrypted now
+	if err := cipher.DecryptKubeconfig(&pp); err != nil && !errors.Is(err, ErrNotEncrypted) {
+		return "", false, errors.Wrap(err, "while decrypting kubeconfig")
+	}
+	if err := cipher.EncryptSMCreds(&pp); err != nil {
+		return "", false, errors.Wrap(err, "while encrypting parameters")
+	}
+	if err := cipher.EncryptKubeconfig(&pp); err != nil {
+		return "", false, errors.Wrap(err, "while encrypting kubeconfig")
+	}
+
+	params, err := json.Marshal(pp)
+	if err != nil {
+		return "", false, errors.Wrap(err, "while marshaling parameters")
+	}
+	return string(params), true, nil
+}
+
+func parametersNeedReEncryption(cipher Cipher, pp internal.ProvisioningParameters) bool {
+	var encrypted []string
+	if creds := pp.ErsContext.SMOperatorCredentials; creds != nil {
+		encrypted = append(encrypted, creds.ClientID, creds.ClientSecret)
+	}
+	encrypted = append(encrypted, pp.Parameters.Kubeconfig)
+
+	for _, value := range encrypted {
+		if value != "" && cipher.NeedsReEncryption([]byte(value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func newReEncryptionFailure(id string, err error) dbmodel.ReEncryptionFailure {
+	return dbmodel.ReEncryptionFailure{
+		ID:       id,
+		Tampered: errors.Is(err, ErrCiphertextTampered),
+		Err:      err,
+	}
+}
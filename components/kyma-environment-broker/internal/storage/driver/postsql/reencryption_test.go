@@ -0,0 +1,184 @@
+package postsql_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	postgres "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/postsql"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReEncryption(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("Should re-encrypt instances and operations with the primary key", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		oldStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		instance := fixture.FixInstance("instance-id")
+		instance.Parameters.ErsContext.SMOperatorCredentials = &internal.ServiceManagerOperatorCredentials{ClientID: "client-id", ClientSecret: "client-secret"}
+		require.NoError(t, oldStorage.Instances().Insert(instance))
+		operation := fixture.FixProvisioningOperation("operation-id", "instance-id")
+		operation.ProvisioningParameters = instance.Parameters
+		require.NoError(t, oldStorage.Operations().InsertOperation(operation))
+
+		// when the new primary key is configured
+		cfg.EncryptionKeys = "next=" + "0123456789abcdef0123456789abcdef"
+		cfg.PrimaryEncryptionKeyID = "next"
+		cipher, err = storage.NewEncrypterFromConfig(cfg)
+		require.NoError(t, err)
+		rotatedStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		instancesResult, err := rotatedStorage.Instances().ReEncrypt("", 10)
+		require.NoError(t, err)
+		operationsResult, err := rotatedStorage.Operations().ReEncrypt("", 10)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, 1, instancesResult.ReEncrypted)
+		assert.Empty(t, instancesResult.Failures)
+		assert.Equal(t, 1, operationsResult.ReEncrypted)
+		assert.Empty(t, operationsResult.Failures)
+
+		// the data can be read without the previous key
+		cfg.SecretKey = ""
+		cipher, err = storage.NewEncrypterFromConfig(cfg)
+		require.NoError(t, err)
+		newStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		gotInstance, err := newStorage.Instances().GetByID("instance-id")
+		require.NoError(t, err)
+		assert.Equal(t, "client-secret", gotInstance.Parameters.ErsContext.SMOperatorCredentials.ClientSecret)
+		gotOperation, err := newStorage.Operations().GetOperationByID("operation-id")
+		require.NoError(t, err)
+		assert.Equal(t, "client-id", gotOperation.ProvisioningParameters.ErsContext.SMOperatorCredentials.ClientID)
+
+		// the second run does not change anything
+		instancesResult, err = newStorage.Instances().ReEncrypt("", 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, instancesResult.Processed)
+		assert.Equal(t, 0, instancesResult.ReEncrypted)
+	})
+
+	t.Run("Should report the operations which cannot be decrypted and encrypt the plain text kubeconfig", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		unknownKeyCipher, err := storage.NewEncrypterFromConfig(storage.Config{EncryptionKeys: "unknown=0123456789abcdef0123456789abcdef", PrimaryEncryptionKeyID: "unknown"})
+		require.NoError(t, err)
+		unknownKeyStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, unknownKeyCipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, storage.NewEncrypter(cfg.SecretKey), logrus.StandardLogger())
+		require.NoError(t, err)
+
+		unknownKeyOperation := fixture.FixProvisioningOperation("operation-1", "instance-id")
+		unknownKeyOperation.ProvisioningParameters.Parameters.Kubeconfig = "apiVersion: v1"
+		require.NoError(t, unknownKeyStorage.Operations().InsertOperation(unknownKeyOperation))
+
+		// the kubeconfig was stored in a plain text by the previous KEB versions
+		plainTextOperation := fixture.FixProvisioningOperation("operation-2", "instance-id")
+		require.NoError(t, brokerStorage.Operations().InsertOperation(plainTextOperation))
+		plainTextOperation.ProvisioningParameters.Parameters.Kubeconfig = "apiVersion: v1"
+		params, err := json.Marshal(plainTextOperation.ProvisioningParameters)
+		require.NoError(t, err)
+		connection, err := postsql.InitializeDatabase(cfg.ConnectionURL(), 1, logrus.New())
+		require.NoError(t, err)
+		defer connection.Close()
+		_, err = connection.Exec("UPDATE operations SET provisioning_parameters = $1 WHERE id = $2", string(params), "operation-2")
+		require.NoError(t, err)
+
+		// when
+		result, err := brokerStorage.Operations().ReEncrypt("", 10)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, 2, result.Processed)
+		assert.Equal(t, 1, result.ReEncrypted)
+		require.Len(t, result.Failures, 1)
+		assert.Equal(t, "operation-1", result.Failures[0].ID)
+		assert.ErrorIs(t, result.Failures[0].Err, postgres.ErrUnknownEncryptionKey)
+
+		gotOperation, err := brokerStorage.Operations().GetOperationByID("operation-2")
+		require.NoError(t, err)
+		assert.Equal(t, "apiVersion: v1", gotOperation.ProvisioningParameters.Parameters.Kubeconfig)
+		_, err = brokerStorage.Operations().GetOperationByID("operation-1")
+		assert.ErrorIs(t, err, postgres.ErrUnknownEncryptionKey)
+	})
+
+	t.Run("Should re-encrypt the kubeconfigs of the bindings in batches", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		oldStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, storage.NewEncrypter(cfg.SecretKey), logrus.StandardLogger())
+		require.NoError(t, err)
+		// the same binding ID is used in both instances
+		for _, binding := range []internal.Binding{
+			{ID: "binding-1", InstanceID: "instance-1", Kubeconfig: "kubeconfig-1"},
+			{ID: "binding-1", InstanceID: "instance-2", Kubeconfig: "kubeconfig-2"},
+			{ID: "binding-2", InstanceID: "instance-2", Kubeconfig: "kubeconfig-3"},
+		} {
+			require.NoError(t, oldStorage.Bindings().Insert(binding))
+		}
+
+		// when the new primary key is configured
+		cfg.EncryptionKeys = "next=" + "0123456789abcdef0123456789abcdef"
+		cfg.PrimaryEncryptionKeyID = "next"
+		cipher, err := storage.NewEncrypterFromConfig(cfg)
+		require.NoError(t, err)
+		rotatedStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		firstBatch, err := rotatedStorage.Bindings().ReEncrypt("", 2)
+		require.NoError(t, err)
+		secondBatch, err := rotatedStorage.Bindings().ReEncrypt(firstBatch.LastID, 2)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, 2, firstBatch.ReEncrypted)
+		assert.Equal(t, "instance-2/binding-1", firstBatch.LastID)
+		assert.Equal(t, 1, secondBatch.Processed)
+		assert.Equal(t, 1, secondBatch.ReEncrypted)
+		assert.Empty(t, append(firstBatch.Failures, secondBatch.Failures...))
+
+		// the data can be read without the previous key
+		cfg.SecretKey = ""
+		cipher, err = storage.NewEncrypterFromConfig(cfg)
+		require.NoError(t, err)
+		newStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+
+		gotBinding, err := newStorage.Bindings().GetByID("instance-2", "binding-2")
+		require.NoError(t, err)
+		assert.Equal(t, "kubeconfig-3", gotBinding.Kubeconfig)
+	})
+}
@@ -0,0 +1,112 @@
+package postsql
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dbmodel"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/postsql"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+type WebhookDeliveries struct {
+	postsql.Factory
+}
+
+func NewWebhookDeliveries(sess postsql.Factory) *WebhookDeliveries {
+	return &WebhookDeliveries{
+		Factory: sess,
+	}
+}
+
+func (s *WebhookDeliveries) Insert(delivery internal.WebhookDelivery) error {
+	sess := s.NewWriteSession()
+	return sess.InsertWebhookDelivery(toWebhookDeliveryDTO(delivery))
+}
+
+func (s *WebhookDeliveries) Update(delivery internal.WebhookDelivery) error {
+	sess := s.NewWriteSession()
+	return sess.UpdateWebhookDelivery(toWebhookDeliveryDTO(delivery))
+}
+
+func (s *WebhookDeliveries) ListByOperationID(operationID string) ([]internal.WebhookDelivery, error) {
+	return s.list(func(sess postsql.ReadSession) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+		return sess.ListWebhookDeliveriesByOperationID(operationID)
+	})
+}
+
+func (s *WebhookDeliveries) ListByState(state string) ([]internal.WebhookDelivery, error) {
+	return s.list(func(sess postsql.ReadSession) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+		return sess.ListWebhookDeliveriesByState(state)
+	})
+}
+
+// DeleteFinishedBefore removes the succeeded and failed deliveries created before the given time
+func (s *WebhookDeliveries) DeleteFinishedBefore(until time.Time) (int, error) {
+	sess := s.NewWriteSession()
+	deleted, err := sess.DeleteWebhookDeliveries([]string{internal.WebhookDeliverySucceeded, internal.WebhookDeliveryFailed}, until)
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+func (s *WebhookDeliveries) list(query func(sess postsql.ReadSession) ([]dbmodel.WebhookDeliveryDTO, dberr.Error)) ([]internal.WebhookDelivery, error) {
+	sess := s.NewReadSession()
+	var dtos []dbmodel.WebhookDeliveryDTO
+	var lastErr dberr.Error
+	err := wait.PollImmediate(defaultRetryInterval, defaultRetryTimeout, func() (bool, error) {
+		dtos, lastErr = query(sess)
+		if lastErr != nil {
+			log.Errorf("while listing webhook deliveries: %v", lastErr)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, lastErr
+	}
+
+	result := make([]internal.WebhookDelivery, 0, len(dtos))
+	for _, dto := range dtos {
+		result = append(result, toWebhookDelivery(dto))
+	}
+
+	return result, nil
+}
+
+func toWebhookDeliveryDTO(delivery internal.WebhookDelivery) dbmodel.WebhookDeliveryDTO {
+	return dbmodel.WebhookDeliveryDTO{
+		ID:           delivery.ID,
+		Webhook:      delivery.Webhook,
+		Event:        delivery.Event,
+		OperationID:  delivery.OperationID,
+		InstanceID:   delivery.InstanceID,
+		CreatedAt:    delivery.CreatedAt,
+		UpdatedAt:    delivery.UpdatedAt,
+		Payload:      delivery.Payload,
+		State:        delivery.State,
+		Attempts:     delivery.Attempts,
+		ResponseCode: delivery.ResponseCode,
+		LastError:    delivery.LastError,
+	}
+}
+
+func toWebhookDelivery(dto dbmodel.WebhookDeliveryDTO) internal.WebhookDelivery {
+	return internal.WebhookDelivery{
+		ID:           dto.ID,
+		Webhook:      dto.Webhook,
+		Event:        dto.Event,
+		OperationID:  dto.OperationID,
+		InstanceID:   dto.InstanceID,
+		CreatedAt:    dto.CreatedAt,
+		UpdatedAt:    dto.UpdatedAt,
+		Payload:      dto.Payload,
+		State:        dto.State,
+		Attempts:     dto.Attempts,
+		ResponseCode: dto.ResponseCode,
+		LastError:    dto.LastError,
+	}
+}
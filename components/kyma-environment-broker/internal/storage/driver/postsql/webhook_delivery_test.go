@@ -0,0 +1,122 @@
+package postsql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDeliveries(t *testing.T) {
+
+	ctx := context.Background()
+
+	t.Run("should insert, update and list WebhookDeliveries", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		givenDelivery := internal.WebhookDelivery{
+			ID:          "delivery-id",
+			Webhook:     "chat-ops",
+			Event:       "operation.failed",
+			OperationID: "operation-id",
+			InstanceID:  "instance-id",
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Payload:     `{"event":"operation.failed"}`,
+			State:       internal.WebhookDeliveryPending,
+		}
+
+		svc := brokerStorage.WebhookDeliveries()
+
+		err = svc.Insert(givenDelivery)
+		require.NoError(t, err)
+
+		err = svc.Insert(givenDelivery)
+		assert.Error(t, err)
+
+		pending, err := svc.ListByState(internal.WebhookDeliveryPending)
+		require.NoError(t, err)
+		assert.Len(t, pending, 1)
+
+		givenDelivery.State = internal.WebhookDeliverySucceeded
+		givenDelivery.Attempts = 2
+		givenDelivery.ResponseCode = 200
+		err = svc.Update(givenDelivery)
+		require.NoError(t, err)
+
+		deliveries, err := svc.ListByOperationID("operation-id")
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, internal.WebhookDeliverySucceeded, deliveries[0].State)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+		assert.Equal(t, 200, deliveries[0].ResponseCode)
+		assert.Equal(t, givenDelivery.Payload, deliveries[0].Payload)
+
+		pending, err = svc.ListByState(internal.WebhookDeliveryPending)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+
+	t.Run("should delete the finished WebhookDeliveries created before the given time", func(t *testing.T) {
+		containerCleanupFunc, cfg, err := storage.InitTestDBContainer(t.Logf, ctx, "test_DB_1")
+		require.NoError(t, err)
+		defer containerCleanupFunc()
+
+		tablesCleanupFunc, err := storage.InitTestDBTables(t, cfg.ConnectionURL())
+		require.NoError(t, err)
+		defer tablesCleanupFunc()
+
+		cipher := storage.NewEncrypter(cfg.SecretKey)
+		brokerStorage, _, err := storage.NewFromConfig(cfg, events.Config{}, cipher, logrus.StandardLogger())
+		require.NoError(t, err)
+		require.NotNil(t, brokerStorage)
+
+		now := time.Now().UTC().Truncate(time.Millisecond)
+		old := now.Add(-48 * time.Hour)
+		svc := brokerStorage.WebhookDeliveries()
+		for _, delivery := range []internal.WebhookDelivery{
+			{ID: "old-succeeded", CreatedAt: old, State: internal.WebhookDeliverySucceeded},
+			{ID: "old-failed", CreatedAt: old, State: internal.WebhookDeliveryFailed},
+			{ID: "old-pending", CreatedAt: old, State: internal.WebhookDeliveryPending},
+			{ID: "new-succeeded", CreatedAt: now, State: internal.WebhookDeliverySucceeded},
+		} {
+			delivery.Webhook = "chat-ops"
+			delivery.Event = "operation.failed"
+			delivery.OperationID = "operation-id"
+			delivery.InstanceID = "instance-id"
+			delivery.UpdatedAt = delivery.CreatedAt
+			delivery.Payload = "{}"
+			require.NoError(t, svc.Insert(delivery))
+		}
+
+		// when
+		deleted, err := svc.DeleteFinishedBefore(now.Add(-24 * time.Hour))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 2, deleted)
+		deliveries, err := svc.ListByOperationID("operation-id")
+		require.NoError(t, err)
+		require.Len(t, deliveries, 2)
+		assert.Equal(t, "old-pending", deliveries[0].ID)
+		assert.Equal(t, "new-succeeded", deliveries[1].ID)
+	})
+}
@@ -6,42 +6,108 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"io"
+	"strings"
 
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	postgres "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/postsql"
 	"github.com/pkg/errors"
 )
 
+const (
+	// DefaultKeyID is the ID of the key configured with the SecretKey parameter
+	DefaultKeyID = "default"
+
+	gcmPrefix = "gcm:"
+)
+
+// NewEncrypter creates the Encrypter with the single key used for the encryption and the decryption
 func NewEncrypter(secretKey string) *Encrypter {
-	return &Encrypter{key: []byte(secretKey)}
+	return &Encrypter{keyring: NewKeyring(DefaultKeyID, map[string][]byte{DefaultKeyID: []byte(secretKey)})}
+}
+
+// NewEncrypterFromConfig creates the Encrypter with the keyring built from the database configuration
+func NewEncrypterFromConfig(cfg Config) (*Encrypter, error) {
+	keyring, err := NewKeyringFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Encrypter{keyring: keyring}, nil
 }
 
+// Encrypter encrypts the data with AES-GCM using the primary key of the keyring. The ciphertext is prefixed
+// with the ID of the key, so the data encrypted with the previous keys can be decrypted after the key rotation.
+// The data encrypted with AES-CFB by the previous KEB versions is decrypted with the default key.
 type Encrypter struct {
-	key []byte
+	keyring *Keyring
 }
 
 func (e *Encrypter) Encrypt(obj []byte) ([]byte, error) {
-	block, err := aes.NewCipher(e.key)
+	keyID, key := e.keyring.Primary()
+	aead, err := newGCM(key)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrapf(err, "while creating cipher for key %s", keyID)
 	}
-	b := base64.StdEncoding.EncodeToString(obj)
-	bytes := make([]byte, aes.BlockSize+len(b))
-	iv := bytes[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return nil, err
 	}
-	cfb := cipher.NewCFBEncrypter(block, iv)
-	cfb.XORKeyStream(bytes[aes.BlockSize:], []byte(b))
+	sealed := aead.Seal(nonce, nonce, obj, []byte(keyID))
 
-	return []byte(base64.StdEncoding.EncodeToString(bytes)), nil
+	return []byte(gcmPrefix + keyID + ":" + base64.StdEncoding.EncodeToString(sealed)), nil
 }
 
 func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
-	obj, err := base64.StdEncoding.DecodeString(string(obj))
+	if !strings.HasPrefix(string(obj), gcmPrefix) {
+		return e.decryptCFB(obj)
+	}
+	keyID, sealed, ok := parseGCMCiphertext(obj)
+	if !ok {
+		return nil, errors.New("malformed cipher text")
+	}
+	key, found := e.keyring.Key(keyID)
+	if !found {
+		return nil, errors.Wrapf(postgres.ErrUnknownEncryptionKey, "key %s", keyID)
+	}
+	aead, err := newGCM(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while creating cipher for key %s", keyID)
+	}
+	data, err := base64.StdEncoding.DecodeString(sealed)
 	if err != nil {
 		return nil, errors.Wrap(err, "while decoding object")
 	}
-	block, err := aes.NewCipher(e.key)
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("cipher text is too short")
+	}
+	plain, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return nil, errors.Wrapf(postgres.ErrCiphertextTampered, "key %s", keyID)
+	}
+	return plain, nil
+}
+
+// NeedsReEncryption checks if the ciphertext is not encrypted with the primary key
+func (e *Encrypter) NeedsReEncryption(obj []byte) bool {
+	keyID, _, ok := parseGCMCiphertext(obj)
+	if !ok {
+		return true
+	}
+	primaryKeyID, _ := e.keyring.Primary()
+	return keyID != primaryKeyID
+}
+
+// decryptCFB decrypts the data encrypted by the previous KEB versions, the data which is not base64 encoded
+// was not encrypted at all
+func (e *Encrypter) decryptCFB(obj []byte) ([]byte, error) {
+	obj, err := base64.StdEncoding.DecodeString(string(obj))
+	if err != nil {
+		return nil, postgres.ErrNotEncrypted
+	}
+	key, found := e.keyring.Key(DefaultKeyID)
+	if !found {
+		return nil, errors.Wrap(postgres.ErrUnknownEncryptionKey, "the default key required to decrypt the legacy cipher text is not configured")
+	}
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +125,27 @@ func (e *Encrypter) Decrypt(obj []byte) ([]byte, error) {
 	return data, nil
 }
 
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// parseGCMCiphertext splits the "gcm:<key ID>:<base64 data>" ciphertext
+func parseGCMCiphertext(obj []byte) (string, string, bool) {
+	text := string(obj)
+	if !strings.HasPrefix(text, gcmPrefix) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(text, gcmPrefix), ":", 2)
+	if len(parts) != 2 || !validKeyID(parts[0]) {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
 func (e *Encrypter) EncryptSMCreds(provisioningParameters *internal.ProvisioningParameters) error {
 	if provisioningParameters.ErsContext.SMOperatorCredentials == nil {
 		return nil
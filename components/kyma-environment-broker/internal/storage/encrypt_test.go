@@ -1,9 +1,15 @@
 package storage
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
 	"encoding/json"
+	"strings"
 	"testing"
 
+	postgres "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/driver/postsql"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/util/rand"
@@ -70,3 +76,167 @@ func TestNewEncrypter(t *testing.T) {
 	})
 
 }
+
+func TestEncrypterKeyRotation(t *testing.T) {
+	oldKey := rand.String(32)
+	newKey := rand.String(32)
+
+	t.Run("should prefix the cipher text with the primary key ID", func(t *testing.T) {
+		e, err := NewEncrypterFromConfig(Config{SecretKey: oldKey, EncryptionKeys: "next=" + newKey, PrimaryEncryptionKeyID: "next"})
+		require.NoError(t, err)
+
+		enc, err := e.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(string(enc), "gcm:next:"))
+		assert.False(t, e.NeedsReEncryption(enc))
+	})
+
+	t.Run("should decrypt the data encrypted with the previous key", func(t *testing.T) {
+		old := NewEncrypter(oldKey)
+		enc, err := old.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		e, err := NewEncrypterFromConfig(Config{SecretKey: oldKey, EncryptionKeys: "next=" + newKey, PrimaryEncryptionKeyID: "next"})
+		require.NoError(t, err)
+
+		dec, err := e.Decrypt(enc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("test"), dec)
+		assert.True(t, e.NeedsReEncryption(enc))
+	})
+
+	t.Run("should decrypt the legacy AES-CFB cipher text with the default key", func(t *testing.T) {
+		e := NewEncrypter(oldKey)
+		enc := encryptCFB(t, []byte(oldKey), []byte("test"))
+
+		dec, err := e.Decrypt(enc)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("test"), dec)
+		assert.True(t, e.NeedsReEncryption(enc))
+	})
+
+	t.Run("should detect the tampered cipher text", func(t *testing.T) {
+		e := NewEncrypter(oldKey)
+		enc, err := e.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(string(enc), "gcm:default:"))
+		require.NoError(t, err)
+		sealed[len(sealed)-1] ^= 0xff
+		tampered := []byte("gcm:default:" + base64.StdEncoding.EncodeToString(sealed))
+
+		_, err = e.Decrypt(tampered)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, postgres.ErrCiphertextTampered)
+	})
+
+	t.Run("should detect the cipher text moved to another key ID", func(t *testing.T) {
+		e, err := NewEncrypterFromConfig(Config{SecretKey: oldKey, EncryptionKeys: "next=" + oldKey})
+		require.NoError(t, err)
+		enc, err := e.Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		_, err = e.Decrypt([]byte(strings.Replace(string(enc), "gcm:default:", "gcm:next:", 1)))
+		assert.ErrorIs(t, err, postgres.ErrCiphertextTampered)
+	})
+
+	t.Run("should fail for the unknown key ID", func(t *testing.T) {
+		e, err := NewEncrypterFromConfig(Config{EncryptionKeys: "next=" + newKey, PrimaryEncryptionKeyID: "next"})
+		require.NoError(t, err)
+		enc, err := NewEncrypter(oldKey).Encrypt([]byte("test"))
+		require.NoError(t, err)
+
+		_, err = e.Decrypt(enc)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, postgres.ErrUnknownEncryptionKey)
+		assert.NotErrorIs(t, err, postgres.ErrCiphertextTampered)
+	})
+
+	t.Run("should fail for the legacy cipher text without the default key", func(t *testing.T) {
+		e, err := NewEncrypterFromConfig(Config{EncryptionKeys: "next=" + newKey, PrimaryEncryptionKeyID: "next"})
+		require.NoError(t, err)
+
+		_, err = e.Decrypt(encryptCFB(t, []byte(oldKey), []byte("test")))
+		assert.ErrorIs(t, err, postgres.ErrUnknownEncryptionKey)
+	})
+
+	t.Run("should fail for the malformed cipher text", func(t *testing.T) {
+		e := NewEncrypter(oldKey)
+
+		_, err := e.Decrypt([]byte("gcm:not a key:data"))
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, postgres.ErrNotEncrypted)
+	})
+
+	t.Run("should detect the data which is not encrypted", func(t *testing.T) {
+		e := NewEncrypter(oldKey)
+
+		_, err := e.Decrypt([]byte("apiVersion: v1\nkind: Config"))
+		assert.ErrorIs(t, err, postgres.ErrNotEncrypted)
+	})
+}
+
+func TestNewKeyringFromConfig(t *testing.T) {
+	key := rand.String(32)
+
+	for name, tc := range map[string]struct {
+		cfg Config
+		err string
+	}{
+		"missing primary key": {
+			cfg: Config{SecretKey: key, PrimaryEncryptionKeyID: "next"},
+			err: "the primary encryption key next is not configured",
+		},
+		"invalid format": {
+			cfg: Config{SecretKey: key, EncryptionKeys: "next:" + key},
+			err: "keyID=key format",
+		},
+		"invalid key ID": {
+			cfg: Config{SecretKey: key, EncryptionKeys: "ne:xt=" + key},
+			err: "keyID=key format",
+		},
+		"duplicated key": {
+			cfg: Config{SecretKey: key, EncryptionKeys: "next=" + key + ",next=" + key},
+			err: "duplicated encryption key next",
+		},
+		"default key configured twice": {
+			cfg: Config{SecretKey: key, EncryptionKeys: "default=" + key},
+			err: "the key default is configured with the secret key and the encryption keys",
+		},
+		"invalid key length": {
+			cfg: Config{SecretKey: key, EncryptionKeys: "next=short"},
+			err: "invalid encryption key next",
+		},
+	} {
+		t.Run("should reject "+name, func(t *testing.T) {
+			_, err := NewKeyringFromConfig(tc.cfg)
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.err)
+		})
+	}
+
+	t.Run("should use the secret key as the primary key by default", func(t *testing.T) {
+		keyring, err := NewKeyringFromConfig(Config{SecretKey: key, EncryptionKeys: " next=" + rand.String(16) + " "})
+		require.NoError(t, err)
+
+		id, primary := keyring.Primary()
+		assert.Equal(t, DefaultKeyID, id)
+		assert.Equal(t, []byte(key), primary)
+		_, found := keyring.Key("next")
+		assert.True(t, found)
+	})
+}
+
+// encryptCFB encrypts the data in the same way as the previous KEB versions
+func encryptCFB(t *testing.T, key, obj []byte) []byte {
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	b := base64.StdEncoding.EncodeToString(obj)
+	bytes := make([]byte, aes.BlockSize+len(b))
+	iv := bytes[:aes.BlockSize]
+	copy(iv, rand.String(aes.BlockSize))
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(bytes[aes.BlockSize:], []byte(b))
+	return []byte(base64.StdEncoding.EncodeToString(bytes))
+}
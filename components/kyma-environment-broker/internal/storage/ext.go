@@ -20,12 +20,16 @@ type Instances interface {
 	GetInstanceStats() (internal.InstanceStats, error)
 	GetERSContextStats() (internal.ERSContextStats, error)
 	GetNumberOfInstancesForGlobalAccountID(globalAccountID string) (int, error)
+	// GetPlanIDs returns the distinct IDs of the plans of the existing instances
+	GetPlanIDs() ([]string, error)
 	List(dbmodel.InstanceFilter) ([]internal.Instance, int, int, error)
 
-	// todo: remove after instances parameters migration is done
-	InsertWithoutEncryption(instance internal.Instance) error
-	UpdateWithoutEncryption(instance internal.Instance) (*internal.Instance, error)
-	ListWithoutDecryption(dbmodel.InstanceFilter) ([]internal.Instance, int, int, error)
+	ReEncryption
+}
+
+// ReEncryption encrypts the stored data with the primary encryption key, the rows are processed in batches ordered by the ID
+type ReEncryption interface {
+	ReEncrypt(afterID string, limit int) (dbmodel.ReEncryptionResult, error)
 }
 
 //go:generate mockery --name=Operations --output=automock --outpkg=mocks --case=underscore
@@ -50,6 +54,8 @@ type Operations interface {
 	ListOperationsByInstanceID(instanceID string) ([]internal.Operation, error)
 	ListOperationsByOrchestrationID(orchestrationID string, filter dbmodel.OperationFilter) ([]internal.Operation, int, int, error)
 	ListOperationsInTimeRange(from, to time.Time) ([]internal.Operation, error)
+
+	ReEncryption
 }
 
 type Provisioning interface {
@@ -111,6 +117,23 @@ type Updating interface {
 	UpdateUpdatingOperation(operation internal.UpdatingOperation) (*internal.UpdatingOperation, error)
 }
 
+type Bindings interface {
+	Insert(binding internal.Binding) error
+	GetByID(instanceID, bindingID string) (*internal.Binding, error)
+	ListByInstanceID(instanceID string) ([]internal.Binding, error)
+	Delete(instanceID, bindingID string) error
+
+	ReEncryption
+}
+
+type WebhookDeliveries interface {
+	Insert(delivery internal.WebhookDelivery) error
+	Update(delivery internal.WebhookDelivery) error
+	ListByOperationID(operationID string) ([]internal.WebhookDelivery, error)
+	ListByState(state string) ([]internal.WebhookDelivery, error)
+	DeleteFinishedBefore(until time.Time) (int, error)
+}
+
 type Events interface {
 	InsertEvent(level events.EventLevel, message, instanceID, operationID string)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"crypto/aes"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var keyIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Keyring holds the encryption keys by their IDs. The primary key is used for the encryption,
+// all keys are used for the decryption.
+type Keyring struct {
+	primaryKeyID string
+	keys         map[string][]byte
+}
+
+func NewKeyring(primaryKeyID string, keys map[string][]byte) *Keyring {
+	return &Keyring{primaryKeyID: primaryKeyID, keys: keys}
+}
+
+// NewKeyringFromConfig creates the keyring from the SecretKey, stored under the default key ID,
+// and the EncryptionKeys parameters
+func NewKeyringFromConfig(cfg Config) (*Keyring, error) {
+	keys, err := parseEncryptionKeys(cfg.EncryptionKeys)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SecretKey != "" {
+		if _, exists := keys[DefaultKeyID]; exists {
+			return nil, errors.Errorf("the key %s is configured with the secret key and the encryption keys", DefaultKeyID)
+		}
+		keys[DefaultKeyID] = []byte(cfg.SecretKey)
+	}
+
+	primaryKeyID := cfg.PrimaryEncryptionKeyID
+	if primaryKeyID == "" {
+		primaryKeyID = DefaultKeyID
+	}
+	// KEB can run without the encryption keys if it does not store any sensitive data, the encryption fails then
+	if len(keys) == 0 {
+		return NewKeyring(primaryKeyID, keys), nil
+	}
+	if _, exists := keys[primaryKeyID]; !exists {
+		return nil, errors.Errorf("the primary encryption key %s is not configured", primaryKeyID)
+	}
+	for id, key := range keys {
+		if _, err := aes.NewCipher(key); err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s", id)
+		}
+	}
+
+	return NewKeyring(primaryKeyID, keys), nil
+}
+
+// Primary returns the ID and the primary key
+func (k *Keyring) Primary() (string, []byte) {
+	return k.primaryKeyID, k.keys[k.primaryKeyID]
+}
+
+// Key returns the key with the given ID
+func (k *Keyring) Key(id string) ([]byte, bool) {
+	key, found := k.keys[id]
+	return key, found
+}
+
+// parseEncryptionKeys parses the keys in the "keyID1=key1,keyID2=key2" format
+func parseEncryptionKeys(in string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, entry := range strings.Split(in, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 || !validKeyID(parts[0]) {
+			return nil, errors.New("the encryption keys must be in the keyID=key format, the key ID can contain letters, digits, '-' and '_'")
+		}
+		if _, exists := keys[parts[0]]; exists {
+			return nil, errors.Errorf("duplicated encryption key %s", parts[0])
+		}
+		keys[parts[0]] = []byte(parts[1])
+	}
+	return keys, nil
+}
+
+func validKeyID(id string) bool {
+	return keyIDPattern.MatchString(id)
+}
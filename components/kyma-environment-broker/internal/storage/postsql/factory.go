@@ -39,6 +39,7 @@ type ReadSession interface {
 	GetInstanceStats() ([]dbmodel.InstanceByGlobalAccountIDStatEntry, error)
 	GetERSContextStats() ([]dbmodel.InstanceERSContextStatsEntry, error)
 	GetNumberOfInstancesForGlobalAccountID(globalAccountID string) (int, error)
+	GetInstancesPlanIDs() ([]string, error)
 	GetRuntimeStateByOperationID(operationID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListRuntimeStateByRuntimeID(runtimeID string) ([]dbmodel.RuntimeStateDTO, dberr.Error)
 	GetOrchestrationByID(oID string) (dbmodel.OrchestrationDTO, dberr.Error)
@@ -52,6 +53,13 @@ type ReadSession interface {
 	GetLatestRuntimeStateWithKymaVersionByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	GetLatestRuntimeStateWithOIDCConfigByRuntimeID(runtimeID string) (dbmodel.RuntimeStateDTO, dberr.Error)
 	ListEvents(filter events.EventFilter) ([]events.EventDTO, error)
+	GetBindingByID(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error)
+	ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error)
+	ListWebhookDeliveriesByOperationID(operationID string) ([]dbmodel.WebhookDeliveryDTO, dberr.Error)
+	ListWebhookDeliveriesByState(state string) ([]dbmodel.WebhookDeliveryDTO, dberr.Error)
+	ListInstancesParametersAfterID(instanceID string, limit int) ([]dbmodel.InstanceDTO, dberr.Error)
+	ListOperationsParametersAfterID(operationID string, limit int) ([]dbmodel.OperationDTO, dberr.Error)
+	ListBindingsKubeconfigsAfterID(instanceID, bindingID string, limit int) ([]dbmodel.BindingDTO, dberr.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -66,6 +74,14 @@ type WriteSession interface {
 	InsertRuntimeState(state dbmodel.RuntimeStateDTO) dberr.Error
 	InsertEvent(level events.EventLevel, message, instanceID, operationID string) dberr.Error
 	DeleteEvents(until time.Time) dberr.Error
+	InsertBinding(binding dbmodel.BindingDTO) dberr.Error
+	DeleteBinding(instanceID, bindingID string) dberr.Error
+	InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error
+	UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error
+	DeleteWebhookDeliveries(states []string, until time.Time) (int64, dberr.Error)
+	ReplaceInstanceParameters(instanceID, oldParameters, newParameters string) dberr.Error
+	ReplaceOperationParameters(operationID, oldParameters, newParameters string) dberr.Error
+	ReplaceBindingKubeconfig(instanceID, bindingID, oldKubeconfig, newKubeconfig string) dberr.Error
 }
 
 type Transaction interface {
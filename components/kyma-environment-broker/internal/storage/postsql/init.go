@@ -19,6 +19,8 @@ const (
 	OperationTableName     = "operations"
 	OrchestrationTableName = "orchestrations"
 	RuntimeStateTableName  = "runtime_states"
+	BindingsTableName      = "bindings"
+	WebhookDeliveriesTable = "webhook_deliveries"
 	CreatedAtField         = "created_at"
 )
 
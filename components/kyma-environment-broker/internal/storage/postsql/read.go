@@ -642,6 +642,16 @@ func (r readSession) GetNumberOfInstancesForGlobalAccountID(globalAccountID stri
 	return res.Total, err
 }
 
+func (r readSession) GetInstancesPlanIDs() ([]string, error) {
+	var planIDs []string
+	_, err := r.session.Select("service_plan_id").
+		Distinct().
+		From(InstancesTableName).
+		Load(&planIDs)
+
+	return planIDs, err
+}
+
 func (r readSession) ListInstances(filter dbmodel.InstanceFilter) ([]dbmodel.InstanceDTO, int, int, error) {
 	var instances []dbmodel.InstanceDTO
 
@@ -881,3 +891,123 @@ func (r readSession) getOrchestrationCount(filter dbmodel.OrchestrationFilter) (
 
 	return res.Total, err
 }
+
+func (r readSession) GetBindingByID(instanceID, bindingID string) (dbmodel.BindingDTO, dberr.Error) {
+	var binding dbmodel.BindingDTO
+
+	err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("id", bindingID)).
+		LoadOne(&binding)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return dbmodel.BindingDTO{}, dberr.NotFound("Cannot find Binding %s for instanceID:'%s'", bindingID, instanceID)
+		}
+		return dbmodel.BindingDTO{}, dberr.Internal("Failed to get Binding: %s", err)
+	}
+
+	return binding, nil
+}
+
+func (r readSession) ListBindingsByInstanceID(instanceID string) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+
+	_, err := r.session.
+		Select("*").
+		From(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		OrderBy(CreatedAtField).
+		Load(&bindings)
+
+	if err != nil {
+		return nil, dberr.Internal("Failed to get Bindings: %s", err)
+	}
+
+	return bindings, nil
+}
+
+func (r readSession) ListWebhookDeliveriesByOperationID(operationID string) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+	var deliveries []dbmodel.WebhookDeliveryDTO
+
+	_, err := r.session.
+		Select("*").
+		From(WebhookDeliveriesTable).
+		Where(dbr.Eq("operation_id", operationID)).
+		OrderBy(CreatedAtField).
+		Load(&deliveries)
+
+	if err != nil {
+		return nil, dberr.Internal("Failed to get WebhookDeliveries: %s", err)
+	}
+
+	return deliveries, nil
+}
+
+func (r readSession) ListWebhookDeliveriesByState(state string) ([]dbmodel.WebhookDeliveryDTO, dberr.Error) {
+	var deliveries []dbmodel.WebhookDeliveryDTO
+
+	_, err := r.session.
+		Select("*").
+		From(WebhookDeliveriesTable).
+		Where(dbr.Eq("state", state)).
+		OrderBy(CreatedAtField).
+		Load(&deliveries)
+
+	if err != nil {
+		return nil, dberr.Internal("Failed to get WebhookDeliveries: %s", err)
+	}
+
+	return deliveries, nil
+}
+
+// ListInstancesParametersAfterID returns the IDs and the provisioning parameters of the instances ordered by the ID
+func (r readSession) ListInstancesParametersAfterID(instanceID string, limit int) ([]dbmodel.InstanceDTO, dberr.Error) {
+	var instances []dbmodel.InstanceDTO
+	_, err := r.session.
+		Select("instance_id", "provisioning_parameters").
+		From(InstancesTableName).
+		Where(dbr.Gt("instance_id", instanceID)).
+		OrderBy("instance_id").
+		Limit(uint64(limit)).
+		Load(&instances)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get instances: %s", err)
+	}
+	return instances, nil
+}
+
+// ListOperationsParametersAfterID returns the IDs and the provisioning parameters of the operations ordered by the ID
+func (r readSession) ListOperationsParametersAfterID(operationID string, limit int) ([]dbmodel.OperationDTO, dberr.Error) {
+	var operations []dbmodel.OperationDTO
+	_, err := r.session.
+		Select("id", "provisioning_parameters").
+		From(OperationTableName).
+		Where(dbr.Gt("id", operationID)).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Load(&operations)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get operations: %s", err)
+	}
+	return operations, nil
+}
+
+// ListBindingsKubeconfigsAfterID returns the IDs and the kubeconfigs of the bindings ordered by the instance ID and the binding ID
+func (r readSession) ListBindingsKubeconfigsAfterID(instanceID, bindingID string, limit int) ([]dbmodel.BindingDTO, dberr.Error) {
+	var bindings []dbmodel.BindingDTO
+	_, err := r.session.
+		Select("instance_id", "id", "kubeconfig").
+		From(BindingsTableName).
+		Where(dbr.Expr("(instance_id, id) > (?, ?)", instanceID, bindingID)).
+		OrderBy("instance_id").
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Load(&bindings)
+	if err != nil {
+		return nil, dberr.Internal("Failed to get bindings: %s", err)
+	}
+	return bindings, nil
+}
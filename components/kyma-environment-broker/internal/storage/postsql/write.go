@@ -1,6 +1,7 @@
 package postsql
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -265,6 +266,164 @@ func (ws writeSession) DeleteEvents(until time.Time) dberr.Error {
 	return nil
 }
 
+func (ws writeSession) InsertBinding(binding dbmodel.BindingDTO) dberr.Error {
+	_, err := ws.insertInto(BindingsTableName).
+		Pair("id", binding.ID).
+		Pair("instance_id", binding.InstanceID).
+		Pair("created_at", binding.CreatedAt).
+		Pair("updated_at", binding.UpdatedAt).
+		Pair("expires_at", binding.ExpiresAt).
+		Pair("role", binding.Role).
+		Pair("service_account_name", binding.ServiceAccountName).
+		Pair("kubeconfig", binding.Kubeconfig).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("Binding with id %s already exist", binding.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to Binding table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) DeleteBinding(instanceID, bindingID string) dberr.Error {
+	_, err := ws.deleteFrom(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("id", bindingID)).
+		Exec()
+
+	if err != nil {
+		return dberr.Internal("Failed to delete record from Binding table: %s", err)
+	}
+	return nil
+}
+
+func (ws writeSession) InsertWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error {
+	_, err := ws.insertInto(WebhookDeliveriesTable).
+		Pair("id", delivery.ID).
+		Pair("webhook", delivery.Webhook).
+		Pair("event", delivery.Event).
+		Pair("operation_id", delivery.OperationID).
+		Pair("instance_id", delivery.InstanceID).
+		Pair("created_at", delivery.CreatedAt).
+		Pair("updated_at", delivery.UpdatedAt).
+		Pair("payload", delivery.Payload).
+		Pair("state", delivery.State).
+		Pair("attempts", delivery.Attempts).
+		Pair("response_code", delivery.ResponseCode).
+		Pair("last_error", delivery.LastError).
+		Exec()
+
+	if err != nil {
+		if err, ok := err.(*pq.Error); ok {
+			if err.Code == UniqueViolationErrorCode {
+				return dberr.AlreadyExists("WebhookDelivery with id %s already exist", delivery.ID)
+			}
+		}
+		return dberr.Internal("Failed to insert record to WebhookDelivery table: %s", err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) UpdateWebhookDelivery(delivery dbmodel.WebhookDeliveryDTO) dberr.Error {
+	res, err := ws.update(WebhookDeliveriesTable).
+		Where(dbr.Eq("id", delivery.ID)).
+		Set("updated_at", delivery.UpdatedAt).
+		Set("state", delivery.State).
+		Set("attempts", delivery.Attempts).
+		Set("response_code", delivery.ResponseCode).
+		Set("last_error", delivery.LastError).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update record in WebhookDelivery table: %s", err)
+	}
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.NotFound("Cannot find WebhookDelivery with ID:'%s'", delivery.ID)
+	}
+
+	return nil
+}
+
+// DeleteWebhookDeliveries removes the deliveries in the given states created before the given time
+func (ws writeSession) DeleteWebhookDeliveries(states []string, until time.Time) (int64, dberr.Error) {
+	res, err := ws.deleteFrom(WebhookDeliveriesTable).
+		Where(dbr.Eq("state", states)).
+		Where(dbr.Lt(CreatedAtField, until)).
+		Exec()
+	if err != nil {
+		return 0, dberr.Internal("failed to delete webhook deliveries created before %v: %v", until.Format(time.RFC1123Z), err)
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+
+	return deleted, nil
+}
+
+// ReplaceInstanceParameters sets the provisioning parameters of the instance if they were not changed in the meantime,
+// the version of the instance is not changed
+func (ws writeSession) ReplaceInstanceParameters(instanceID, oldParameters, newParameters string) dberr.Error {
+	res, err := ws.update(InstancesTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("provisioning_parameters", oldParameters)).
+		Set("provisioning_parameters", newParameters).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update provisioning parameters in Instance table: %s", err)
+	}
+	return conflictIfNotAffected(res, "Instance %s was modified", instanceID)
+}
+
+// ReplaceOperationParameters sets the provisioning parameters of the operation if they were not changed in the meantime,
+// the version of the operation is not changed
+func (ws writeSession) ReplaceOperationParameters(operationID, oldParameters, newParameters string) dberr.Error {
+	res, err := ws.update(OperationTableName).
+		Where(dbr.Eq("id", operationID)).
+		// the column has the json type which cannot be compared with a text parameter
+		Where(dbr.Expr("provisioning_parameters::text = ?", oldParameters)).
+		Set("provisioning_parameters", newParameters).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update provisioning parameters in Operation table: %s", err)
+	}
+	return conflictIfNotAffected(res, "Operation %s was modified", operationID)
+}
+
+// ReplaceBindingKubeconfig sets the kubeconfig of the binding if it was not changed in the meantime
+func (ws writeSession) ReplaceBindingKubeconfig(instanceID, bindingID, oldKubeconfig, newKubeconfig string) dberr.Error {
+	res, err := ws.update(BindingsTableName).
+		Where(dbr.Eq("instance_id", instanceID)).
+		Where(dbr.Eq("id", bindingID)).
+		Where(dbr.Eq("kubeconfig", oldKubeconfig)).
+		Set("kubeconfig", newKubeconfig).
+		Exec()
+	if err != nil {
+		return dberr.Internal("Failed to update kubeconfig in Binding table: %s", err)
+	}
+	return conflictIfNotAffected(res, "Binding %s was modified", bindingID)
+}
+
+func conflictIfNotAffected(res sql.Result, format string, a ...interface{}) dberr.Error {
+	rAffected, err := res.RowsAffected()
+	if err != nil {
+		return dberr.Internal("the DB driver does not support RowsAffected operation")
+	}
+	if rAffected == int64(0) {
+		return dberr.Conflict(format, a...)
+	}
+	return nil
+}
+
 func (ws writeSession) Commit() dberr.Error {
 	err := ws.transaction.Commit()
 	if err != nil {
@@ -23,6 +23,8 @@ type BrokerStorage interface {
 	Orchestrations() Orchestrations
 	RuntimeStates() RuntimeStates
 	Events() Events
+	Bindings() Bindings
+	WebhookDeliveries() WebhookDeliveries
 }
 
 const (
@@ -51,6 +53,8 @@ func NewFromConfig(cfg Config, evcfg events.Config, cipher postgres.Cipher, log
 		orchestrations: postgres.NewOrchestrations(fact),
 		runtimeStates:  postgres.NewRuntimeStates(fact, cipher),
 		events:         events.New(evcfg, eventstorage.New(fact, log)),
+		bindings:       postgres.NewBinding(fact, cipher),
+		webhooks:       postgres.NewWebhookDeliveries(fact),
 	}, connection, nil
 }
 
@@ -62,6 +66,8 @@ func NewMemoryStorage() BrokerStorage {
 		orchestrations: memory.NewOrchestrations(),
 		runtimeStates:  memory.NewRuntimeStates(),
 		events:         events.New(events.Config{}, NewInMemoryEvents()),
+		bindings:       memory.NewBindings(),
+		webhooks:       memory.NewWebhookDeliveries(),
 	}
 }
 
@@ -119,6 +125,8 @@ type storage struct {
 	orchestrations Orchestrations
 	runtimeStates  RuntimeStates
 	events         Events
+	bindings       Bindings
+	webhooks       WebhookDeliveries
 }
 
 func (s storage) Instances() Instances {
@@ -148,3 +156,11 @@ func (s storage) RuntimeStates() RuntimeStates {
 func (s storage) Events() Events {
 	return s.events
 }
+
+func (s storage) Bindings() Bindings {
+	return s.bindings
+}
+
+func (s storage) WebhookDeliveries() WebhookDeliveries {
+	return s.webhooks
+}
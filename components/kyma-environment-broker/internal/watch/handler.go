@@ -0,0 +1,183 @@
+package watch
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/httputil"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage/dberr"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// Handler streams the progress of operations as server-sent events. The operation is read from the storage when
+// the stream is opened and then every poll interval, so the state changes are delivered also when the operation
+// is processed by another KEB instance; in the meantime, the events published by the hub are streamed as they come.
+type Handler struct {
+	operations   storage.Operations
+	instances    storage.Instances
+	hub          *Hub
+	pollInterval time.Duration
+	log          logrus.FieldLogger
+}
+
+func NewHandler(operations storage.Operations, instances storage.Instances, hub *Hub, pollInterval time.Duration, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		operations:   operations,
+		instances:    instances,
+		hub:          hub,
+		pollInterval: pollInterval,
+		log:          log,
+	}
+}
+
+func (h *Handler) AttachRoutes(router *mux.Router) {
+	router.HandleFunc("/operations/{operation_id}/watch", h.watchOperation).Methods(http.MethodGet)
+	router.HandleFunc("/runtimes/{runtime_id}/watch", h.watchRuntime).Methods(http.MethodGet)
+}
+
+// watchOperation streams the events of the operation, the stream is closed when the operation is finished
+func (h *Handler) watchOperation(w http.ResponseWriter, req *http.Request) {
+	operationID := mux.Vars(req)["operation_id"]
+
+	// start watching before reading the operation to not miss any event in between
+	events, stop := h.hub.Watch(Filter{OperationID: operationID})
+	defer stop()
+
+	operation, err := h.operations.GetOperationByID(operationID)
+	if err != nil {
+		h.writeLookupError(w, errors.Wrapf(err, "while getting operation %s", operationID))
+		return
+	}
+
+	h.stream(w, req, events, func() (*internal.Operation, error) {
+		return h.operations.GetOperationByID(operationID)
+	}, operation, true)
+}
+
+// watchRuntime streams the events of all operations of the runtime until the client disconnects
+func (h *Handler) watchRuntime(w http.ResponseWriter, req *http.Request) {
+	runtimeID := mux.Vars(req)["runtime_id"]
+
+	instances, err := h.instances.FindAllInstancesForRuntimes([]string{runtimeID})
+	if err != nil {
+		h.writeLookupError(w, errors.Wrapf(err, "while getting instance for runtime %s", runtimeID))
+		return
+	}
+	if len(instances) == 0 {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, fmt.Errorf("runtime %s not found", runtimeID))
+		return
+	}
+	instanceID := instances[0].InstanceID
+
+	events, stop := h.hub.Watch(Filter{InstanceID: instanceID})
+	defer stop()
+
+	operation, err := h.operations.GetLastOperation(instanceID)
+	if err != nil {
+		h.writeLookupError(w, errors.Wrapf(err, "while getting last operation of runtime %s", runtimeID))
+		return
+	}
+
+	h.stream(w, req, events, func() (*internal.Operation, error) {
+		return h.operations.GetLastOperation(instanceID)
+	}, operation, false)
+}
+
+func (h *Handler) stream(w http.ResponseWriter, req *http.Request, events <-chan watch.Event, refresh func() (*internal.Operation, error), operation *internal.Operation, closeWhenFinished bool) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		httputil.WriteErrorResponse(w, http.StatusInternalServerError, errors.New("streaming is not supported"))
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// disables the response buffering in the nginx based proxies
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	log := h.log.WithField("operation", operation.ID)
+	last := OperationEvent(*operation)
+	if err := writeEvent(w, last); err != nil {
+		log.Warnf("while writing event: %s", err)
+		return
+	}
+	flusher.Flush()
+	if closeWhenFinished && last.IsFinished() {
+		return
+	}
+
+	ticker := time.NewTicker(h.pollInterval)
+	defer ticker.Stop()
+	for {
+		var toSend []watch.Event
+		select {
+		case <-req.Context().Done():
+			return
+		case e := <-events:
+			if e.Type == watch.OperationEventType {
+				if sameOperationState(last, e) {
+					continue
+				}
+				last = e
+			}
+			toSend = append(toSend, e)
+		case <-ticker.C:
+			op, err := refresh()
+			if err != nil {
+				log.Warnf("while refreshing operation: %s", err)
+				break
+			}
+			if current := OperationEvent(*op); !sameOperationState(last, current) {
+				last = current
+				toSend = append(toSend, current)
+			}
+		}
+
+		if len(toSend) == 0 {
+			// a comment keeps the connection open behind proxies with an idle timeout
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		}
+		for _, e := range toSend {
+			if err := writeEvent(w, e); err != nil {
+				log.Warnf("while writing event: %s", err)
+				return
+			}
+		}
+		flusher.Flush()
+
+		if closeWhenFinished && last.IsFinished() {
+			return
+		}
+	}
+}
+
+func (h *Handler) writeLookupError(w http.ResponseWriter, err error) {
+	if dberr.IsNotFound(errors.Cause(err)) {
+		httputil.WriteErrorResponse(w, http.StatusNotFound, err)
+		return
+	}
+	httputil.WriteErrorResponse(w, http.StatusInternalServerError, err)
+}
+
+func sameOperationState(a, b watch.Event) bool {
+	return a.OperationID == b.OperationID && *a.Operation == *b.Operation
+}
+
+func writeEvent(w http.ResponseWriter, e watch.Event) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "while encoding event")
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+	return err
+}
@@ -0,0 +1,155 @@
+package watch_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	kebwatch "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/watch"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_WatchOperation(t *testing.T) {
+	t.Run("should stream events until operation is finished", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Operations().InsertOperation(fixInProgressOperation()))
+		broker, client := fixWatchServer(t, st, 50*time.Millisecond)
+
+		received := make(chan watch.Event, 10)
+		done := make(chan error)
+		go func() {
+			done <- client.WatchOperation(context.Background(), operationID, func(e watch.Event) error {
+				received <- e
+				return nil
+			})
+		}()
+
+		// when
+		first := <-received
+		broker.Publish(context.TODO(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime", When: time.Minute},
+			OldOperation:  fixInProgressOperation(),
+			Operation:     fixInProgressOperation(),
+		})
+		step := <-received
+
+		op, err := st.Operations().GetOperationByID(operationID)
+		require.NoError(t, err)
+		op.State = domain.Succeeded
+		op.Description = "Operation succeeded"
+		_, err = st.Operations().UpdateOperation(*op)
+		require.NoError(t, err)
+
+		// then
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("stream was not closed after the operation was finished")
+		}
+		last := <-received
+
+		assert.Equal(t, watch.OperationEventType, first.Type)
+		assert.Equal(t, "in progress", first.Operation.State)
+		assert.Equal(t, watch.StepEventType, step.Type)
+		assert.Equal(t, time.Minute, step.Step.RetryIn)
+		assert.Equal(t, watch.OperationEventType, last.Type)
+		assert.Equal(t, "Operation succeeded", last.Operation.Description)
+		assert.True(t, last.IsFinished())
+	})
+
+	t.Run("should close stream of finished operation", func(t *testing.T) {
+		// given
+		st := storage.NewMemoryStorage()
+		require.NoError(t, st.Operations().InsertOperation(fixture.FixProvisioningOperation(operationID, instanceID)))
+		_, client := fixWatchServer(t, st, time.Minute)
+
+		// when
+		var received []watch.Event
+		err := client.WatchOperation(context.Background(), operationID, func(e watch.Event) error {
+			received = append(received, e)
+			return nil
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		assert.True(t, received[0].IsFinished())
+	})
+
+	t.Run("should return error when operation does not exist", func(t *testing.T) {
+		// given
+		_, client := fixWatchServer(t, storage.NewMemoryStorage(), time.Minute)
+
+		// when
+		err := client.WatchOperation(context.Background(), "not-existing", func(watch.Event) error { return nil })
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "404")
+	})
+}
+
+func TestHandler_WatchRuntime(t *testing.T) {
+	// given
+	st := storage.NewMemoryStorage()
+	instance := fixture.FixInstance(instanceID)
+	require.NoError(t, st.Instances().Insert(instance))
+	require.NoError(t, st.Operations().InsertOperation(fixture.FixProvisioningOperation(operationID, instanceID)))
+	broker, client := fixWatchServer(t, st, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	received := make(chan watch.Event, 10)
+	done := make(chan error)
+	go func() {
+		done <- client.WatchRuntime(ctx, instance.RuntimeID, func(e watch.Event) error {
+			received <- e
+			return nil
+		})
+	}()
+
+	// when
+	first := <-received
+	update := fixture.FixUpdatingOperation("update-op", instanceID)
+	update.State = domain.InProgress
+	broker.Publish(context.TODO(), process.UpdatingStepProcessed{
+		StepProcessed: process.StepProcessed{StepName: "Upgrade_Shoot"},
+		OldOperation:  internal.UpdatingOperation{Operation: internal.Operation{ID: "update-op", InstanceID: instanceID}},
+		Operation:     update,
+	})
+	next := []watch.Event{<-received, <-received}
+	cancel()
+
+	// then
+	require.NoError(t, <-done)
+	assert.True(t, first.IsFinished(), "the stream of the runtime is not closed when its operation is finished")
+	for _, e := range next {
+		assert.Equal(t, "update-op", e.OperationID)
+	}
+}
+
+func fixWatchServer(t *testing.T, st storage.BrokerStorage, pollInterval time.Duration) (*event.PubSub, watch.Client) {
+	broker := event.NewPubSub(logrus.New())
+	hub := kebwatch.NewHub(logrus.New())
+	hub.Subscribe(broker)
+
+	router := mux.NewRouter()
+	kebwatch.NewHandler(st.Operations(), st.Instances(), hub, pollInterval, logrus.New()).AttachRoutes(router)
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+
+	return broker, watch.NewClient(server.URL, http.DefaultClient)
+}
@@ -0,0 +1,211 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/sirupsen/logrus"
+)
+
+const watcherBufferSize = 100
+
+// Filter selects the events delivered to a watcher, empty fields match all events
+type Filter struct {
+	OperationID string
+	InstanceID  string
+}
+
+func (f Filter) matches(e watch.Event) bool {
+	if f.OperationID != "" && f.OperationID != e.OperationID {
+		return false
+	}
+	if f.InstanceID != "" && f.InstanceID != e.InstanceID {
+		return false
+	}
+	return true
+}
+
+type watcher struct {
+	filter Filter
+	events chan watch.Event
+}
+
+// Hub converts the events published by the operation managers into watch events and distributes them to the watchers.
+// A watcher which does not read its events fast enough loses them, the watch handler compensates it by reading
+// the operation from the storage periodically.
+type Hub struct {
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+	log      logrus.FieldLogger
+}
+
+func NewHub(log logrus.FieldLogger) *Hub {
+	return &Hub{
+		watchers: make(map[*watcher]struct{}),
+		log:      log,
+	}
+}
+
+// Subscribe registers the hub for the events published by the operation managers and for the written tracing events
+func (h *Hub) Subscribe(sub event.Subscriber) {
+	sub.Subscribe(process.OperationStepProcessed{}, h.OnOperationStepProcessed)
+	sub.Subscribe(process.OperationSucceeded{}, h.OnOperationSucceeded)
+	sub.Subscribe(process.DeprovisioningStepProcessed{}, h.OnDeprovisioningStepProcessed)
+	sub.Subscribe(process.UpdatingStepProcessed{}, h.OnUpdatingStepProcessed)
+	sub.Subscribe(process.UpgradeKymaStepProcessed{}, h.OnUpgradeKymaStepProcessed)
+	sub.Subscribe(process.UpgradeClusterStepProcessed{}, h.OnUpgradeClusterStepProcessed)
+	sub.Subscribe(events.EventInserted{}, h.OnEventInserted)
+}
+
+// Watch returns the channel with the events matching the filter and the function which stops the watch
+func (h *Hub) Watch(filter Filter) (<-chan watch.Event, func()) {
+	w := &watcher{
+		filter: filter,
+		events: make(chan watch.Event, watcherBufferSize),
+	}
+	h.mu.Lock()
+	h.watchers[w] = struct{}{}
+	h.mu.Unlock()
+
+	return w.events, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.watchers, w)
+	}
+}
+
+func (h *Hub) OnOperationStepProcessed(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.OperationStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected OperationStepProcessed but got %+v", ev)
+	}
+	h.stepProcessed(e.StepProcessed, e.OldOperation, e.Operation)
+	return nil
+}
+
+func (h *Hub) OnOperationSucceeded(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.OperationSucceeded)
+	if !ok {
+		return fmt.Errorf("expected OperationSucceeded but got %+v", ev)
+	}
+	h.publish(OperationEvent(e.Operation))
+	return nil
+}
+
+func (h *Hub) OnDeprovisioningStepProcessed(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.DeprovisioningStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected DeprovisioningStepProcessed but got %+v", ev)
+	}
+	h.stepProcessed(e.StepProcessed, e.OldOperation.Operation, e.Operation.Operation)
+	return nil
+}
+
+func (h *Hub) OnUpdatingStepProcessed(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpdatingStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpdatingStepProcessed but got %+v", ev)
+	}
+	h.stepProcessed(e.StepProcessed, e.OldOperation.Operation, e.Operation.Operation)
+	return nil
+}
+
+func (h *Hub) OnUpgradeKymaStepProcessed(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpgradeKymaStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpgradeKymaStepProcessed but got %+v", ev)
+	}
+	h.stepProcessed(e.StepProcessed, e.OldOperation.Operation, e.Operation.Operation)
+	return nil
+}
+
+func (h *Hub) OnUpgradeClusterStepProcessed(_ context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpgradeClusterStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpgradeClusterStepProcessed but got %+v", ev)
+	}
+	h.stepProcessed(e.StepProcessed, e.OldOperation.Operation, e.Operation.Operation)
+	return nil
+}
+
+func (h *Hub) OnEventInserted(_ context.Context, ev interface{}) error {
+	e, ok := ev.(events.EventInserted)
+	if !ok {
+		return fmt.Errorf("expected EventInserted but got %+v", ev)
+	}
+	dto := e.Event
+	watchEvent := watch.Event{
+		Type:  watch.TraceEventType,
+		Time:  dto.CreatedAt,
+		Event: &dto,
+	}
+	if dto.OperationID != nil {
+		watchEvent.OperationID = *dto.OperationID
+	}
+	if dto.InstanceID != nil {
+		watchEvent.InstanceID = *dto.InstanceID
+	}
+	h.publish(watchEvent)
+	return nil
+}
+
+func (h *Hub) stepProcessed(step process.StepProcessed, old, op internal.Operation) {
+	// an event without the step name is published when the operation fails outside steps
+	if step.StepName != "" {
+		progress := &watch.StepProgress{
+			Name:     step.StepName,
+			Duration: step.Duration,
+			RetryIn:  step.When,
+		}
+		if step.Error != nil {
+			progress.Error = step.Error.Error()
+		}
+		h.publish(watch.Event{
+			Type:        watch.StepEventType,
+			Time:        time.Now(),
+			OperationID: op.ID,
+			InstanceID:  op.InstanceID,
+			Step:        progress,
+		})
+	}
+	if old.State != op.State || old.Description != op.Description || step.StepName == "" {
+		h.publish(OperationEvent(op))
+	}
+}
+
+func (h *Hub) publish(e watch.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for w := range h.watchers {
+		if !w.filter.matches(e) {
+			continue
+		}
+		select {
+		case w.events <- e:
+		default:
+			h.log.Warnf("watcher of operation %q instance %q is too slow, dropping %s event", w.filter.OperationID, w.filter.InstanceID, e.Type)
+		}
+	}
+}
+
+// OperationEvent returns the watch event with the current state of the operation
+func OperationEvent(op internal.Operation) watch.Event {
+	return watch.Event{
+		Type:        watch.OperationEventType,
+		Time:        time.Now(),
+		OperationID: op.ID,
+		InstanceID:  op.InstanceID,
+		Operation: &watch.OperationState{
+			Type:        string(op.Type),
+			State:       string(op.State),
+			Description: op.Description,
+		},
+	}
+}
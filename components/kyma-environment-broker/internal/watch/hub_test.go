@@ -0,0 +1,124 @@
+package watch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	kebwatch "github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/watch"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationID = "op-id"
+	instanceID  = "instance-id"
+)
+
+func TestHub(t *testing.T) {
+	t.Run("should stream step progress and state change of watched operation", func(t *testing.T) {
+		// given
+		broker := event.NewPubSub(logrus.New())
+		hub := kebwatch.NewHub(logrus.New())
+		hub.Subscribe(broker)
+		ch, stop := hub.Watch(kebwatch.Filter{OperationID: operationID})
+		defer stop()
+
+		old := fixInProgressOperation()
+		op := old
+		op.State = domain.Failed
+		op.Description = "Operation failed"
+
+		// when
+		broker.Publish(context.TODO(), process.OperationStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Create_Runtime", Duration: time.Second, Error: errors.New("provisioner error")},
+			OldOperation:  old,
+			Operation:     op,
+		})
+
+		// then
+		received := receive(t, ch, 2)
+		byType := map[watch.EventType]watch.Event{}
+		for _, e := range received {
+			byType[e.Type] = e
+		}
+		require.NotNil(t, byType[watch.StepEventType].Step)
+		assert.Equal(t, "Create_Runtime", byType[watch.StepEventType].Step.Name)
+		assert.Equal(t, "provisioner error", byType[watch.StepEventType].Step.Error)
+		require.NotNil(t, byType[watch.OperationEventType].Operation)
+		assert.Equal(t, "failed", byType[watch.OperationEventType].Operation.State)
+		assert.True(t, byType[watch.OperationEventType].IsFinished())
+	})
+
+	t.Run("should stream written tracing events of watched instance", func(t *testing.T) {
+		// given
+		broker := event.NewPubSub(logrus.New())
+		hub := kebwatch.NewHub(logrus.New())
+		hub.Subscribe(broker)
+		events.SetPublisher(broker)
+		defer events.SetPublisher(nil)
+		ch, stop := hub.Watch(kebwatch.Filter{InstanceID: instanceID})
+		defer stop()
+
+		// when
+		events.Infof("other-instance", "other-op", "not watched")
+		events.Infof(instanceID, operationID, "step %s sleeping", "Create_Runtime")
+
+		// then
+		received := receive(t, ch, 1)
+		assert.Equal(t, watch.TraceEventType, received[0].Type)
+		assert.Equal(t, operationID, received[0].OperationID)
+		assert.Equal(t, "step Create_Runtime sleeping", received[0].Event.Message)
+	})
+
+	t.Run("should not stream events of other operations", func(t *testing.T) {
+		// given
+		broker := event.NewPubSub(logrus.New())
+		hub := kebwatch.NewHub(logrus.New())
+		hub.Subscribe(broker)
+		ch, stop := hub.Watch(kebwatch.Filter{OperationID: "other-op"})
+		defer stop()
+
+		// when
+		broker.Publish(context.TODO(), process.UpdatingStepProcessed{
+			StepProcessed: process.StepProcessed{StepName: "Upgrade_Shoot"},
+			Operation:     internal.UpdatingOperation{Operation: fixInProgressOperation()},
+		})
+
+		// then
+		select {
+		case e := <-ch:
+			t.Fatalf("unexpected event %+v", e)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+func fixInProgressOperation() internal.Operation {
+	op := fixture.FixProvisioningOperation(operationID, instanceID)
+	op.State = domain.InProgress
+	op.Description = "Operation created"
+	return op
+}
+
+func receive(t *testing.T, ch <-chan watch.Event, n int) []watch.Event {
+	var received []watch.Event
+	for len(received) < n {
+		select {
+		case e := <-ch:
+			received = append(received, e)
+		case <-time.After(time.Second):
+			t.Fatalf("received %d of %d expected events", len(received), n)
+		}
+	}
+	return received
+}
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	EventOperationSucceeded = "operation.succeeded"
+	EventOperationFailed    = "operation.failed"
+)
+
+type Config struct {
+	Enabled bool `envconfig:"default=false"`
+	// WebhooksFilePath points to the YAML file with the list of webhooks, the file contains the signing secrets
+	WebhooksFilePath string        `envconfig:"optional"`
+	Timeout          time.Duration `envconfig:"default=10s"`
+	MaxAttempts      int           `envconfig:"default=5"`
+	RetryInterval    time.Duration `envconfig:"default=30s"`
+	// DeliveriesRetention is the age after which the finished deliveries are removed, zero keeps them forever
+	DeliveriesRetention time.Duration `envconfig:"default=720h"`
+	CleanupInterval     time.Duration `envconfig:"default=1h"`
+}
+
+// Webhook is a receiver of the operation lifecycle events. Empty filters match everything.
+type Webhook struct {
+	Name   string `yaml:"name"`
+	URL    string `yaml:"url"`
+	Secret string `yaml:"secret"`
+	// Events is the list of the events sent to the webhook, all events are sent if empty
+	Events []string `yaml:"events"`
+	// Plans contains plan names or IDs
+	Plans            []string `yaml:"plans"`
+	OperationTypes   []string `yaml:"operationTypes"`
+	GlobalAccountIDs []string `yaml:"globalAccountIDs"`
+}
+
+func ReadWebhooksFromFile(path string) ([]Webhook, error) {
+	yamlFile, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading YAML file with webhooks")
+	}
+	var config struct {
+		Webhooks []Webhook `yaml:"webhooks"`
+	}
+	if err := yaml.Unmarshal(yamlFile, &config); err != nil {
+		return nil, errors.Wrap(err, "while unmarshaling YAML file with webhooks")
+	}
+	names := map[string]bool{}
+	for _, webhook := range config.Webhooks {
+		if err := webhook.validate(); err != nil {
+			return nil, err
+		}
+		if names[webhook.Name] {
+			return nil, fmt.Errorf("webhook %s is defined more than once", webhook.Name)
+		}
+		names[webhook.Name] = true
+	}
+	return config.Webhooks, nil
+}
+
+func (w Webhook) validate() error {
+	if w.Name == "" || w.URL == "" {
+		return fmt.Errorf("webhook must have a name and an URL")
+	}
+	if w.Secret == "" {
+		return fmt.Errorf("webhook %s must have a secret to sign the payload", w.Name)
+	}
+	for _, event := range w.Events {
+		if event != EventOperationSucceeded && event != EventOperationFailed {
+			return fmt.Errorf("webhook %s: unknown event %s", w.Name, event)
+		}
+	}
+	return nil
+}
+
+func (w Webhook) matches(event string, n notification) bool {
+	return matchesAny(w.Events, event) &&
+		(matchesAny(w.Plans, n.Operation.PlanID) || matchesAny(w.Plans, n.Operation.PlanName)) &&
+		matchesAny(w.OperationTypes, n.Operation.Type) &&
+		matchesAny(w.GlobalAccountIDs, n.Operation.GlobalAccountID)
+}
+
+func matchesAny(filter []string, value string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, f := range filter {
+		if f == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadWebhooksFromFile(t *testing.T) {
+	for name, tc := range map[string]struct {
+		content  string
+		expected []Webhook
+		errMsg   string
+	}{
+		"valid webhooks": {
+			content: `
+webhooks:
+  - name: chat-ops
+    url: https://chat.example.com/hook
+    secret: s3cr3t
+    events: [operation.failed]
+    plans: [azure, trial]
+  - name: tickets
+    url: https://tickets.example.com/hook
+    secret: s3cr3t
+    operationTypes: [deprovision]
+    globalAccountIDs: [ga-1]
+`,
+			expected: []Webhook{
+				{Name: "chat-ops", URL: "https://chat.example.com/hook", Secret: "s3cr3t", Events: []string{EventOperationFailed}, Plans: []string{"azure", "trial"}},
+				{Name: "tickets", URL: "https://tickets.example.com/hook", Secret: "s3cr3t", OperationTypes: []string{"deprovision"}, GlobalAccountIDs: []string{"ga-1"}},
+			},
+		},
+		"missing secret": {
+			content: "webhooks:\n  - name: a\n    url: https://a\n",
+			errMsg:  "webhook a must have a secret to sign the payload",
+		},
+		"unknown event": {
+			content: "webhooks:\n  - name: a\n    url: https://a\n    secret: s\n    events: [operation.started]\n",
+			errMsg:  "webhook a: unknown event operation.started",
+		},
+		"duplicated name": {
+			content: "webhooks:\n  - name: a\n    url: https://a\n    secret: s\n  - name: a\n    url: https://b\n    secret: s\n",
+			errMsg:  "webhook a is defined more than once",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			path := filepath.Join(t.TempDir(), "webhooks.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0600))
+
+			// when
+			webhooks, err := ReadWebhooksFromFile(path)
+
+			// then
+			if tc.errMsg != "" {
+				assert.EqualError(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, webhooks)
+		})
+	}
+}
@@ -0,0 +1,327 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/event"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	SignatureHeader = "X-KEB-Signature"
+	TimestampHeader = "X-KEB-Timestamp"
+	EventHeader     = "X-KEB-Event"
+	DeliveryHeader  = "X-KEB-Delivery"
+)
+
+type notification struct {
+	Event     string           `json:"event"`
+	Timestamp time.Time        `json:"timestamp"`
+	Operation operationDetails `json:"operation"`
+}
+
+type operationDetails struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	State           string `json:"state"`
+	Description     string `json:"description"`
+	InstanceID      string `json:"instanceID"`
+	RuntimeID       string `json:"runtimeID"`
+	GlobalAccountID string `json:"globalAccountID"`
+	SubAccountID    string `json:"subAccountID"`
+	PlanID          string `json:"planID"`
+	PlanName        string `json:"planName"`
+	OrchestrationID string `json:"orchestrationID,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+// Notifier sends the operation lifecycle events to the configured webhooks. Every delivery is stored in the database,
+// failed deliveries are retried and the pending ones are resumed after a restart.
+type Notifier struct {
+	config     Config
+	webhooks   map[string]Webhook
+	order      []string
+	deliveries storage.WebhookDeliveries
+	httpClient *http.Client
+	log        logrus.FieldLogger
+}
+
+func NewNotifier(cfg Config, webhooks []Webhook, deliveries storage.WebhookDeliveries, log logrus.FieldLogger) *Notifier {
+	n := &Notifier{
+		config:     cfg,
+		webhooks:   make(map[string]Webhook),
+		deliveries: deliveries,
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		log:        log,
+	}
+	for _, webhook := range webhooks {
+		n.webhooks[webhook.Name] = webhook
+		n.order = append(n.order, webhook.Name)
+	}
+	return n
+}
+
+// Subscribe registers the notifier for the events published by the operation managers
+func (n *Notifier) Subscribe(sub event.Subscriber) {
+	sub.Subscribe(process.OperationSucceeded{}, n.OnOperationSucceeded)
+	sub.Subscribe(process.OperationStepProcessed{}, n.OnOperationStepProcessed)
+	sub.Subscribe(process.UpdatingStepProcessed{}, n.OnUpdatingStepProcessed)
+	sub.Subscribe(process.UpgradeKymaStepProcessed{}, n.OnUpgradeKymaStepProcessed)
+	sub.Subscribe(process.UpgradeClusterStepProcessed{}, n.OnUpgradeClusterStepProcessed)
+}
+
+func (n *Notifier) OnOperationSucceeded(ctx context.Context, ev interface{}) error {
+	e, ok := ev.(process.OperationSucceeded)
+	if !ok {
+		return fmt.Errorf("expected OperationSucceeded but got %+v", ev)
+	}
+	return n.notify(ctx, EventOperationSucceeded, e.Operation)
+}
+
+func (n *Notifier) OnOperationStepProcessed(ctx context.Context, ev interface{}) error {
+	e, ok := ev.(process.OperationStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected OperationStepProcessed but got %+v", ev)
+	}
+	// the success is published separately, an event without the step name is published when the operation fails outside steps
+	if e.Operation.State == domain.Failed && (e.OldOperation.State != domain.Failed || e.StepName == "") {
+		return n.notify(ctx, EventOperationFailed, e.Operation)
+	}
+	return nil
+}
+
+func (n *Notifier) OnUpdatingStepProcessed(ctx context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpdatingStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpdatingStepProcessed but got %+v", ev)
+	}
+	return n.notifyOnTransition(ctx, e.OldOperation.Operation, e.Operation.Operation)
+}
+
+func (n *Notifier) OnUpgradeKymaStepProcessed(ctx context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpgradeKymaStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpgradeKymaStepProcessed but got %+v", ev)
+	}
+	return n.notifyOnTransition(ctx, e.OldOperation.Operation, e.Operation.Operation)
+}
+
+func (n *Notifier) OnUpgradeClusterStepProcessed(ctx context.Context, ev interface{}) error {
+	e, ok := ev.(process.UpgradeClusterStepProcessed)
+	if !ok {
+		return fmt.Errorf("expected UpgradeClusterStepProcessed but got %+v", ev)
+	}
+	return n.notifyOnTransition(ctx, e.OldOperation.Operation, e.Operation.Operation)
+}
+
+func (n *Notifier) notifyOnTransition(ctx context.Context, old, op internal.Operation) error {
+	if old.State == op.State {
+		return nil
+	}
+	switch op.State {
+	case domain.Succeeded:
+		return n.notify(ctx, EventOperationSucceeded, op)
+	case domain.Failed:
+		return n.notify(ctx, EventOperationFailed, op)
+	}
+	return nil
+}
+
+// notify stores a delivery for every matching webhook and sends them
+func (n *Notifier) notify(ctx context.Context, eventName string, op internal.Operation) error {
+	msg := notification{
+		Event:     eventName,
+		Timestamp: time.Now().UTC(),
+		Operation: toOperationDetails(op),
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return errors.Wrap(err, "while marshaling webhook payload")
+	}
+
+	for _, name := range n.order {
+		webhook := n.webhooks[name]
+		if !webhook.matches(eventName, msg) {
+			continue
+		}
+		now := time.Now()
+		delivery := internal.WebhookDelivery{
+			ID:          uuid.New().String(),
+			Webhook:     webhook.Name,
+			Event:       eventName,
+			OperationID: op.ID,
+			InstanceID:  op.InstanceID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Payload:     string(payload),
+			State:       internal.WebhookDeliveryPending,
+		}
+		if err := n.deliveries.Insert(delivery); err != nil {
+			return errors.Wrapf(err, "while storing delivery for webhook %s", webhook.Name)
+		}
+		go n.deliver(ctx, webhook, delivery)
+	}
+	return nil
+}
+
+// ResumePending sends the deliveries which were not finished before the restart
+func (n *Notifier) ResumePending(ctx context.Context) error {
+	pending, err := n.deliveries.ListByState(internal.WebhookDeliveryPending)
+	if err != nil {
+		return errors.Wrap(err, "while listing pending webhook deliveries")
+	}
+	for _, delivery := range pending {
+		webhook, found := n.webhooks[delivery.Webhook]
+		if !found {
+			delivery.State = internal.WebhookDeliveryFailed
+			delivery.LastError = "webhook is not configured anymore"
+			delivery.UpdatedAt = time.Now()
+			if err := n.deliveries.Update(delivery); err != nil {
+				n.log.Errorf("while updating webhook delivery %s: %s", delivery.ID, err)
+			}
+			continue
+		}
+		go n.deliver(ctx, webhook, delivery)
+	}
+	return nil
+}
+
+// RunCleanup periodically removes the finished deliveries older than the configured retention
+func (n *Notifier) RunCleanup(ctx context.Context) {
+	if n.config.DeliveriesRetention == 0 {
+		return
+	}
+	ticker := time.NewTicker(n.config.CleanupInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n.cleanup()
+		}
+	}
+}
+
+func (n *Notifier) cleanup() {
+	deleted, err := n.deliveries.DeleteFinishedBefore(time.Now().Add(-n.config.DeliveriesRetention))
+	if err != nil {
+		n.log.Errorf("while deleting old webhook deliveries: %s", err)
+		return
+	}
+	if deleted > 0 {
+		n.log.Infof("deleted %d old webhook deliveries", deleted)
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, webhook Webhook, delivery internal.WebhookDelivery) {
+	log := n.log.WithFields(logrus.Fields{"webhook": webhook.Name, "delivery": delivery.ID, "operation": delivery.OperationID})
+	interval := n.config.RetryInterval
+
+	for delivery.Attempts < n.config.MaxAttempts {
+		delivery.Attempts++
+		code, err := n.send(ctx, webhook, delivery)
+		delivery.ResponseCode = code
+		delivery.UpdatedAt = time.Now()
+		if err == nil {
+			delivery.State = internal.WebhookDeliverySucceeded
+			delivery.LastError = ""
+		} else {
+			delivery.LastError = err.Error()
+			if delivery.Attempts >= n.config.MaxAttempts {
+				delivery.State = internal.WebhookDeliveryFailed
+			}
+			log.Warnf("attempt %d of delivery failed: %s", delivery.Attempts, err)
+		}
+		if err := n.deliveries.Update(delivery); err != nil {
+			log.Errorf("while updating webhook delivery: %s", err)
+		}
+		if delivery.State != internal.WebhookDeliveryPending {
+			log.Infof("webhook delivery finished with state %s", delivery.State)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			// the delivery stays pending and is resumed after the restart
+			return
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+func (n *Notifier) send(ctx context.Context, webhook Webhook, delivery internal.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.Wrap(err, "while creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, body))
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return 0, errors.Wrap(err, "while sending request")
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// Sign returns the value of the signature header. The signature is the HMAC-SHA256 of the timestamp and the body
+// joined with a dot, the receivers should reject the requests with an old timestamp to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.", timestamp)))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func toOperationDetails(op internal.Operation) operationDetails {
+	pp := op.ProvisioningParameters
+	details := operationDetails{
+		ID:              op.ID,
+		Type:            string(op.Type),
+		State:           string(op.State),
+		Description:     op.Description,
+		InstanceID:      op.InstanceID,
+		RuntimeID:       op.RuntimeID,
+		GlobalAccountID: pp.ErsContext.GlobalAccountID,
+		SubAccountID:    pp.ErsContext.SubAccountID,
+		PlanID:          pp.PlanID,
+		PlanName:        broker.PlanNameByID(pp.PlanID),
+		OrchestrationID: op.OrchestrationID,
+	}
+	if op.State == domain.Failed && op.LastError.Error() != "" {
+		details.Error = op.LastError.Error()
+	}
+	return details
+}
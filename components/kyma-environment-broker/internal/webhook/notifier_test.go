@@ -0,0 +1,297 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/broker"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/fixture"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/process"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/storage"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/internal/webhook"
+
+	"github.com/pivotal-cf/brokerapi/v8/domain"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	operationID = "op-id"
+	instanceID  = "instance-id"
+	secret      = "webhook-secret"
+)
+
+var testConfig = webhook.Config{
+	Enabled:       true,
+	Timeout:       time.Second,
+	MaxAttempts:   3,
+	RetryInterval: time.Millisecond,
+}
+
+func TestNotifier_OperationSucceeded(t *testing.T) {
+	// given
+	receiver := newReceiver(t, http.StatusOK)
+	defer receiver.Close()
+	db := storage.NewMemoryStorage()
+	notifier := webhook.NewNotifier(testConfig, []webhook.Webhook{
+		{Name: "all", URL: receiver.URL, Secret: secret},
+		{Name: "failures-only", URL: receiver.URL, Secret: secret, Events: []string{webhook.EventOperationFailed}},
+	}, db.WebhookDeliveries(), logrus.New())
+
+	// when
+	err := notifier.OnOperationSucceeded(context.Background(), process.OperationSucceeded{
+		Operation: fixture.FixProvisioningOperation(operationID, instanceID),
+	})
+
+	// then
+	require.NoError(t, err)
+	delivery := waitForDelivery(t, db, internal.WebhookDeliverySucceeded)
+	assert.Equal(t, "all", delivery.Webhook)
+	assert.Equal(t, webhook.EventOperationSucceeded, delivery.Event)
+	assert.Equal(t, 1, delivery.Attempts)
+	assert.Equal(t, http.StatusOK, delivery.ResponseCode)
+
+	requests := receiver.received()
+	require.Len(t, requests, 1)
+	assert.Equal(t, webhook.EventOperationSucceeded, requests[0].Header.Get(webhook.EventHeader))
+	assert.Equal(t, delivery.ID, requests[0].Header.Get(webhook.DeliveryHeader))
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(requests[0].body, &payload))
+	operation := payload["operation"].(map[string]interface{})
+	assert.Equal(t, operationID, operation["id"])
+	assert.Equal(t, string(internal.OperationTypeProvision), operation["type"])
+	assert.Equal(t, fixture.GlobalAccountId, operation["globalAccountID"])
+}
+
+func TestNotifier_OperationFailed(t *testing.T) {
+	// given
+	receiver := newReceiver(t, http.StatusOK)
+	defer receiver.Close()
+	db := storage.NewMemoryStorage()
+	notifier := webhook.NewNotifier(testConfig, []webhook.Webhook{
+		{Name: "failures", URL: receiver.URL, Secret: secret, Events: []string{webhook.EventOperationFailed}},
+	}, db.WebhookDeliveries(), logrus.New())
+	old := fixture.FixProvisioningOperation(operationID, instanceID)
+	old.State = domain.InProgress
+	failed := old
+	failed.State = domain.Failed
+
+	// when
+	err := notifier.OnOperationStepProcessed(context.Background(), process.OperationStepProcessed{
+		StepProcessed: process.StepProcessed{StepName: "in progress step"},
+		OldOperation:  old,
+		Operation:     old,
+	})
+	require.NoError(t, err)
+	err = notifier.OnOperationStepProcessed(context.Background(), process.OperationStepProcessed{
+		StepProcessed: process.StepProcessed{StepName: "failing step"},
+		OldOperation:  old,
+		Operation:     failed,
+	})
+
+	// then
+	require.NoError(t, err)
+	delivery := waitForDelivery(t, db, internal.WebhookDeliverySucceeded)
+	assert.Equal(t, webhook.EventOperationFailed, delivery.Event)
+	assert.Len(t, receiver.received(), 1)
+}
+
+func TestNotifier_Filters(t *testing.T) {
+	op := fixture.FixProvisioningOperation(operationID, instanceID)
+	op.ProvisioningParameters.PlanID = broker.AzurePlanID
+
+	for name, tc := range map[string]struct {
+		webhook webhook.Webhook
+		matches bool
+	}{
+		"plan name":              {webhook: webhook.Webhook{Plans: []string{broker.AzurePlanName}}, matches: true},
+		"plan ID":                {webhook: webhook.Webhook{Plans: []string{broker.AzurePlanID}}, matches: true},
+		"other plan":             {webhook: webhook.Webhook{Plans: []string{broker.TrialPlanName}}, matches: false},
+		"operation type":         {webhook: webhook.Webhook{OperationTypes: []string{"provision", "deprovision"}}, matches: true},
+		"other operation type":   {webhook: webhook.Webhook{OperationTypes: []string{"upgradeKyma"}}, matches: false},
+		"global account":         {webhook: webhook.Webhook{GlobalAccountIDs: []string{fixture.GlobalAccountId}}, matches: true},
+		"other global account":   {webhook: webhook.Webhook{GlobalAccountIDs: []string{"other"}}, matches: false},
+		"all filters must match": {webhook: webhook.Webhook{Plans: []string{broker.AzurePlanName}, GlobalAccountIDs: []string{"other"}}, matches: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			db := storage.NewMemoryStorage()
+			tc.webhook.Name = "filtered"
+			tc.webhook.URL = "http://127.0.0.1:1"
+			tc.webhook.Secret = secret
+			notifier := webhook.NewNotifier(webhook.Config{MaxAttempts: 1}, []webhook.Webhook{tc.webhook}, db.WebhookDeliveries(), logrus.New())
+
+			// when
+			err := notifier.OnOperationSucceeded(context.Background(), process.OperationSucceeded{Operation: op})
+
+			// then
+			require.NoError(t, err)
+			deliveries, err := db.WebhookDeliveries().ListByOperationID(operationID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.matches, len(deliveries) == 1)
+		})
+	}
+}
+
+func TestNotifier_Retries(t *testing.T) {
+	// given
+	receiver := newReceiver(t, http.StatusInternalServerError)
+	defer receiver.Close()
+	db := storage.NewMemoryStorage()
+	notifier := webhook.NewNotifier(testConfig, []webhook.Webhook{
+		{Name: "broken", URL: receiver.URL, Secret: secret},
+	}, db.WebhookDeliveries(), logrus.New())
+
+	// when
+	err := notifier.OnOperationSucceeded(context.Background(), process.OperationSucceeded{
+		Operation: fixture.FixProvisioningOperation(operationID, instanceID),
+	})
+
+	// then
+	require.NoError(t, err)
+	delivery := waitForDelivery(t, db, internal.WebhookDeliveryFailed)
+	assert.Equal(t, 3, delivery.Attempts)
+	assert.Equal(t, http.StatusInternalServerError, delivery.ResponseCode)
+	assert.Equal(t, "webhook responded with status 500", delivery.LastError)
+	assert.Len(t, receiver.received(), 3)
+}
+
+func TestNotifier_ResumePending(t *testing.T) {
+	// given
+	receiver := newReceiver(t, http.StatusOK)
+	defer receiver.Close()
+	db := storage.NewMemoryStorage()
+	require.NoError(t, db.WebhookDeliveries().Insert(internal.WebhookDelivery{
+		ID: "pending", Webhook: "configured", OperationID: operationID, Payload: "{}", State: internal.WebhookDeliveryPending,
+	}))
+	require.NoError(t, db.WebhookDeliveries().Insert(internal.WebhookDelivery{
+		ID: "orphaned", Webhook: "removed", OperationID: operationID, Payload: "{}", State: internal.WebhookDeliveryPending,
+	}))
+	notifier := webhook.NewNotifier(testConfig, []webhook.Webhook{
+		{Name: "configured", URL: receiver.URL, Secret: secret},
+	}, db.WebhookDeliveries(), logrus.New())
+
+	// when
+	err := notifier.ResumePending(context.Background())
+
+	// then
+	require.NoError(t, err)
+	delivery := waitForDelivery(t, db, internal.WebhookDeliverySucceeded)
+	assert.Equal(t, "pending", delivery.ID)
+	failed, err := db.WebhookDeliveries().ListByState(internal.WebhookDeliveryFailed)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "orphaned", failed[0].ID)
+}
+
+func TestNotifier_RunCleanup(t *testing.T) {
+	// given
+	db := storage.NewMemoryStorage()
+	old := time.Now().Add(-48 * time.Hour)
+	for _, delivery := range []internal.WebhookDelivery{
+		{ID: "old-succeeded", OperationID: operationID, CreatedAt: old, State: internal.WebhookDeliverySucceeded},
+		{ID: "old-failed", OperationID: operationID, CreatedAt: old, State: internal.WebhookDeliveryFailed},
+		{ID: "old-pending", OperationID: operationID, CreatedAt: old, State: internal.WebhookDeliveryPending},
+		{ID: "new-succeeded", OperationID: operationID, CreatedAt: time.Now(), State: internal.WebhookDeliverySucceeded},
+	} {
+		require.NoError(t, db.WebhookDeliveries().Insert(delivery))
+	}
+	cfg := testConfig
+	cfg.DeliveriesRetention = 24 * time.Hour
+	cfg.CleanupInterval = time.Millisecond
+	notifier := webhook.NewNotifier(cfg, nil, db.WebhookDeliveries(), logrus.New())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// when
+	go notifier.RunCleanup(ctx)
+
+	// then
+	var deliveries []internal.WebhookDelivery
+	err := wait.PollImmediate(time.Millisecond, time.Second, func() (bool, error) {
+		var err error
+		deliveries, err = db.WebhookDeliveries().ListByOperationID(operationID)
+		return err == nil && len(deliveries) == 2, err
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"old-pending", "new-succeeded"}, []string{deliveries[0].ID, deliveries[1].ID})
+}
+
+func TestSign(t *testing.T) {
+	// given
+	receiver := newReceiver(t, http.StatusOK)
+	defer receiver.Close()
+	db := storage.NewMemoryStorage()
+	notifier := webhook.NewNotifier(testConfig, []webhook.Webhook{
+		{Name: "signed", URL: receiver.URL, Secret: secret},
+	}, db.WebhookDeliveries(), logrus.New())
+
+	// when
+	err := notifier.OnOperationSucceeded(context.Background(), process.OperationSucceeded{
+		Operation: fixture.FixProvisioningOperation(operationID, instanceID),
+	})
+
+	// then
+	require.NoError(t, err)
+	waitForDelivery(t, db, internal.WebhookDeliverySucceeded)
+	request := receiver.received()[0]
+	timestamp, err := strconv.ParseInt(request.Header.Get(webhook.TimestampHeader), 10, 64)
+	require.NoError(t, err)
+	assert.Equal(t, webhook.Sign(secret, timestamp, request.body), request.Header.Get(webhook.SignatureHeader))
+	assert.NotEqual(t, webhook.Sign("other", timestamp, request.body), request.Header.Get(webhook.SignatureHeader))
+}
+
+type receivedRequest struct {
+	Header http.Header
+	body   []byte
+}
+
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []receivedRequest
+}
+
+func newReceiver(t *testing.T, status int) *receiver {
+	r := &receiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := ioutil.ReadAll(req.Body)
+		assert.NoError(t, err)
+		r.mu.Lock()
+		r.requests = append(r.requests, receivedRequest{Header: req.Header, body: body})
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	return r
+}
+
+func (r *receiver) received() []receivedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedRequest{}, r.requests...)
+}
+
+func waitForDelivery(t *testing.T, db storage.BrokerStorage, state string) internal.WebhookDelivery {
+	var delivery internal.WebhookDelivery
+	err := wait.PollImmediate(5*time.Millisecond, 2*time.Second, func() (bool, error) {
+		deliveries, err := db.WebhookDeliveries().ListByState(state)
+		if err != nil || len(deliveries) == 0 {
+			return false, err
+		}
+		delivery = deliveries[0]
+		return true, nil
+	})
+	require.NoError(t, err)
+	return delivery
+}
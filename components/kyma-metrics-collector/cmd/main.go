@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
@@ -34,7 +35,7 @@ import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/env"
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/options"
-	gocache "github.com/patrickmn/go-cache"
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
 )
 
 const (
@@ -79,31 +80,41 @@ func main() {
 	kebClient := keb.NewClient(kebConfig, logger)
 	logger.Debugf("keb config: %v", kebConfig)
 
-	// Creating cache with no expiration and the data will never be cleaned up
-	cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
-
-	// Creating EDP client
-	edpConfig := new(edp.Config)
-	if err := envconfig.Process("", edpConfig); err != nil {
-		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load EDP config")
+	cache, err := newCache(opts, logger)
+	if err != nil {
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Create metric cache")
 	}
 
-	// read the token from the mounted secret
-	token, err := getEDPToken()
+	edpSink, err := newEDPSink(opts, logger)
 	if err != nil {
-		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Load EDP token")
+		logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Create EDP sink")
 	}
-	edpConfig.Token = token
 
-	edpClient := edp.NewClient(edpConfig, logger)
+	var outbox *edp.Outbox
+	if opts.OutboxDir != "" {
+		outbox, err = edp.NewOutbox(edp.OutboxConfig{
+			Dir:        opts.OutboxDir,
+			MaxBackoff: opts.OutboxMaxBackoff,
+		}, edpSink, logger)
+		if err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Fatal("Create EDP outbox")
+		}
+		go outbox.Run(context.Background())
+	}
 
 	queue := workqueue.NewDelayingQueue()
 
+	var runtimeMetrics *kmcprocess.RuntimeMetrics
+	if opts.RuntimeMetricsLimit > 0 {
+		runtimeMetrics = kmcprocess.NewRuntimeMetrics(opts.RuntimeMetricsLimit)
+	}
+
 	kmcProcess := kmcprocess.Process{
 		KEBClient:       kebClient,
 		ShootClient:     shootClient,
 		SecretClient:    secretClient,
-		EDPClient:       edpClient,
+		EDPSink:         edpSink,
+		Outbox:          outbox,
 		Logger:          logger,
 		Providers:       publicCloudSpecs,
 		Cache:           cache,
@@ -113,6 +124,7 @@ func main() {
 		NodeConfig:      skrnode.Config{},
 		PVCConfig:       skrpvc.Config{},
 		SvcConfig:       skrsvc.Config{},
+		RuntimeMetrics:  runtimeMetrics,
 	}
 
 	// Start execution
@@ -127,6 +139,8 @@ func main() {
 		writer.WriteHeader(http.StatusOK)
 	})
 	router.Path(metricsPath).Handler(promhttp.Handler())
+	recordsHandler := service.RecordsHandler{Cache: cache, Logger: logger}
+	recordsHandler.AttachRoutes(router)
 
 	kmcSvr := service.Server{
 		Addr:   fmt.Sprintf(":%d", opts.ListenAddr),
@@ -134,8 +148,67 @@ func main() {
 		Router: router,
 	}
 
-	// Start a server to cater to the metrics and healthz endpoints
+	// Start a server to cater to the metrics, healthz, and records endpoints, it returns on a termination signal
 	kmcSvr.Start()
+
+	// store the latest records of the file cache before exiting
+	if fileCache, ok := cache.(*kmccache.FileCache); ok {
+		if err := fileCache.Flush(); err != nil {
+			logger.With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).Error("Flush metric cache")
+		}
+	}
+}
+
+// newCache creates the metric cache for the configured backend. The file backend is flushed periodically,
+// the last flush is done by main after the server is stopped.
+func newCache(opts *options.Options, logger *zap.SugaredLogger) (kmccache.Cache, error) {
+	if err := kmccache.ValidateBackend(opts.CacheBackend); err != nil {
+		return nil, err
+	}
+	if opts.CacheBackend == kmccache.BackendMemory {
+		// Creating cache with no expiration and the data will never be cleaned up
+		return kmccache.NewInMemoryCache(), nil
+	}
+
+	fileCache, err := kmccache.NewFileCache(opts.CacheFilePath, opts.CacheFlushInterval, logger)
+	if err != nil {
+		return nil, err
+	}
+	go fileCache.Run(context.Background())
+	return fileCache, nil
+}
+
+// newEDPSink creates the destination of the event streams, the stdout and file sinks allow to run the application
+// without EDP, e.g. in dev clusters
+func newEDPSink(opts *options.Options, logger *zap.SugaredLogger) (edp.Sink, error) {
+	if err := edp.ValidateSink(opts.EDPSink); err != nil {
+		return nil, err
+	}
+	switch opts.EDPSink {
+	case edp.SinkStdout:
+		return edp.NewWriterSink(os.Stdout), nil
+	case edp.SinkFile:
+		file, err := os.OpenFile(opts.EDPSinkFilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		return edp.NewWriterSink(file), nil
+	}
+
+	// Creating EDP client
+	edpConfig := new(edp.Config)
+	if err := envconfig.Process("", edpConfig); err != nil {
+		return nil, fmt.Errorf("failed to load EDP config: %w", err)
+	}
+
+	// read the token from the mounted secret
+	token, err := getEDPToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load EDP token: %w", err)
+	}
+	edpConfig.Token = token
+
+	return edp.NewClient(edpConfig, logger), nil
 }
 
 func enableDebugging(debugPort int, log *zap.SugaredLogger) {
@@ -21,6 +21,14 @@ type Options struct {
 	DebugPort           int
 	ListenAddr          int
 	LogLevel            zapcore.Level
+	CacheBackend        string
+	CacheFilePath       string
+	CacheFlushInterval  time.Duration
+	EDPSink             string
+	EDPSinkFilePath     string
+	OutboxDir           string
+	OutboxMaxBackoff    time.Duration
+	RuntimeMetricsLimit int
 }
 
 func ParseArgs() *Options {
@@ -32,6 +40,14 @@ func ParseArgs() *Options {
 	logLevelStr := flag.String("log-level", "info", "The log-level of the application. E.g. fatal, error, info, debug etc")
 	listenAddr := flag.Int("listen-addr", 8080, "The application starts server in this port to serve the metrics and healthz endpoints")
 	debugPort := flag.Int("debug-port", 0, "The custom port to debug when needed")
+	cacheBackend := flag.String("cache-backend", "memory", "The backend of the metric cache. E.g. memory or file")
+	cacheFilePath := flag.String("cache-file-path", "/var/kmc/cache/records.json", "The file where the metric cache is persisted when the file backend is used")
+	cacheFlushInterval := flag.Duration("cache-flush-interval", time.Minute, "The interval of writing the metric cache to the file when the file backend is used")
+	edpSink := flag.String("edp-sink", "edp", "The destination of the event streams. E.g. edp, stdout or file")
+	edpSinkFilePath := flag.String("edp-sink-file-path", "/tmp/kmc-events.jsonl", "The file where the event streams are written when the file sink is used")
+	outboxDir := flag.String("outbox-dir", "", "The directory where the event streams are stored until they are delivered. The outbox is disabled when empty")
+	outboxMaxBackoff := flag.Duration("outbox-max-backoff", 5*time.Minute, "The maximum wait duration between the retries of a failed delivery from the outbox")
+	runtimeMetricsLimit := flag.Int("runtime-metrics-limit", 2000, "The maximum number of runtimes whose metrics are exposed on the metrics endpoint. The runtime metrics are disabled when 0")
 	flag.Parse()
 
 	err := logLevel.Set(*logLevelStr)
@@ -40,19 +56,31 @@ func ParseArgs() *Options {
 	}
 
 	return &Options{
-		GardenerSecretPath: *gardenerSecretPath,
-		GardenerNamespace:  *gardenerNamespace,
-		ScrapeInterval:     *scrapeInterval,
-		WorkerPoolSize:     *workerPoolSize,
-		DebugPort:          *debugPort,
-		LogLevel:           logLevel,
-		ListenAddr:         *listenAddr,
+		GardenerSecretPath:  *gardenerSecretPath,
+		GardenerNamespace:   *gardenerNamespace,
+		ScrapeInterval:      *scrapeInterval,
+		WorkerPoolSize:      *workerPoolSize,
+		DebugPort:           *debugPort,
+		LogLevel:            logLevel,
+		ListenAddr:          *listenAddr,
+		CacheBackend:        *cacheBackend,
+		CacheFilePath:       *cacheFilePath,
+		CacheFlushInterval:  *cacheFlushInterval,
+		EDPSink:             *edpSink,
+		EDPSinkFilePath:     *edpSinkFilePath,
+		OutboxDir:           *outboxDir,
+		OutboxMaxBackoff:    *outboxMaxBackoff,
+		RuntimeMetricsLimit: *runtimeMetricsLimit,
 	}
 }
 
 func (o *Options) String() string {
 	return fmt.Sprintf("--gardener-secret-path=%s --gardener-namespace=%s --scrape-interval=%v "+
-		"--worker-pool-size=%d --log-level=%s --listen-addr=%d, --debug-port=%d",
+		"--worker-pool-size=%d --log-level=%s --listen-addr=%d, --debug-port=%d "+
+		"--cache-backend=%s --cache-file-path=%s --cache-flush-interval=%v "+
+		"--edp-sink=%s --edp-sink-file-path=%s --outbox-dir=%s --outbox-max-backoff=%v --runtime-metrics-limit=%d",
 		o.GardenerSecretPath, o.GardenerNamespace, o.ScrapeInterval,
-		o.WorkerPoolSize, o.LogLevel, o.ListenAddr, o.DebugPort)
+		o.WorkerPoolSize, o.LogLevel, o.ListenAddr, o.DebugPort,
+		o.CacheBackend, o.CacheFilePath, o.CacheFlushInterval,
+		o.EDPSink, o.EDPSinkFilePath, o.OutboxDir, o.OutboxMaxBackoff, o.RuntimeMetricsLimit)
 }
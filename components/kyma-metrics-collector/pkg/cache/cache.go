@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+)
+
+const (
+	BackendMemory = "memory"
+	BackendFile   = "file"
+)
+
+// Cache keeps the records of the tracked subaccounts, keyed by the subaccount ID.
+// It is satisfied by the in-memory go-cache, which is the default backend.
+type Cache interface {
+	Get(k string) (interface{}, bool)
+	Set(k string, x interface{}, d time.Duration)
+	Add(k string, x interface{}, d time.Duration) error
+	Delete(k string)
+	Items() map[string]gocache.Item
+	ItemCount() int
+}
+
+// NewInMemoryCache returns a cache with no expiration, the data is lost when the process exits
+func NewInMemoryCache() *gocache.Cache {
+	return gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+}
+
+// ValidateBackend checks if the given cache backend is supported
+func ValidateBackend(backend string) error {
+	switch backend {
+	case BackendMemory, BackendFile:
+		return nil
+	default:
+		return fmt.Errorf("unsupported cache backend %q, supported are: %s, %s", backend, BackendMemory, BackendFile)
+	}
+}
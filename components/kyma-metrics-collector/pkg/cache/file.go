@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// FileCache is an in-memory cache which persists the records in a file, so the last known metrics
+// survive restarts of the process. Kubeconfigs are never written to the file, they are fetched again when needed.
+type FileCache struct {
+	*gocache.Cache
+
+	path          string
+	flushInterval time.Duration
+	logger        *zap.SugaredLogger
+
+	mu    sync.Mutex
+	dirty bool
+}
+
+// NewFileCache creates the cache and loads the records stored in the given file, a missing file means an empty cache
+func NewFileCache(path string, flushInterval time.Duration, logger *zap.SugaredLogger) (*FileCache, error) {
+	c := &FileCache{
+		Cache:         NewInMemoryCache(),
+		path:          path,
+		flushInterval: flushInterval,
+		logger:        logger,
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *FileCache) Set(k string, x interface{}, d time.Duration) {
+	c.Cache.Set(k, x, d)
+	c.markDirty()
+}
+
+func (c *FileCache) Add(k string, x interface{}, d time.Duration) error {
+	if err := c.Cache.Add(k, x, d); err != nil {
+		return err
+	}
+	c.markDirty()
+	return nil
+}
+
+func (c *FileCache) Delete(k string) {
+	c.Cache.Delete(k)
+	c.markDirty()
+}
+
+// Run flushes the changed records to the file periodically until the context is done, then flushes the last time
+func (c *FileCache) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := c.Flush(); err != nil {
+				c.logger.Errorf("while flushing cache to %s: %v", c.path, err)
+			}
+			return
+		case <-ticker.C:
+			if err := c.Flush(); err != nil {
+				c.logger.Errorf("while flushing cache to %s: %v", c.path, err)
+			}
+		}
+	}
+}
+
+// Flush writes all records to the file if there were changes since the last flush
+func (c *FileCache) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+
+	records := make(map[string]Record, c.Cache.ItemCount())
+	for key, item := range c.Cache.Items() {
+		record, ok := item.Object.(Record)
+		if !ok {
+			c.logger.Errorf("bad item %s in cache, could not cast to a record obj", key)
+			continue
+		}
+		records[key] = record
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		return errors.Wrap(err, "while marshalling records")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return errors.Wrapf(err, "while creating directory for %s", c.path)
+	}
+	// write to a temporary file first, so the stored records are never left half written
+	tmp := fmt.Sprintf("%s.tmp", c.path)
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "while writing %s", tmp)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return errors.Wrapf(err, "while replacing %s", c.path)
+	}
+
+	c.dirty = false
+	return nil
+}
+
+func (c *FileCache) load() error {
+	data, err := os.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while reading %s", c.path)
+	}
+
+	records := map[string]Record{}
+	if err := json.Unmarshal(data, &records); err != nil {
+		return errors.Wrapf(err, "while unmarshalling records from %s", c.path)
+	}
+	for key, record := range records {
+		c.Cache.Set(key, record, gocache.NoExpiration)
+	}
+	c.logger.Infof("loaded %d records from %s", len(records), c.path)
+	return nil
+}
+
+func (c *FileCache) markDirty() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dirty = true
+}
@@ -0,0 +1,71 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+
+	"github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestFileCache(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	log := logger.NewLogger(zapcore.InfoLevel)
+
+	t.Run("should start empty when the file does not exist", func(t *testing.T) {
+		c, err := NewFileCache(filepath.Join(t.TempDir(), "records.json"), time.Minute, log)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(c.ItemCount()).To(gomega.Equal(0))
+	})
+
+	t.Run("should restore records without kubeconfig after restart", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cache", "records.json")
+		record := Record{
+			SubAccountID: "sub-account-1",
+			RuntimeID:    "runtime-1",
+			ShootName:    "shoot-1",
+			KubeConfig:   "secret-kubeconfig",
+			Metric: &edp.ConsumptionMetrics{
+				Timestamp: "2022-11-10T10:00:00Z",
+				Compute:   edp.Compute{ProvisionedCpus: 8},
+			},
+		}
+		c, err := NewFileCache(path, time.Minute, log)
+		g.Expect(err).Should(gomega.BeNil())
+		c.Set(record.SubAccountID, record, gocache.NoExpiration)
+		g.Expect(c.Add("sub-account-2", Record{SubAccountID: "sub-account-2"}, gocache.NoExpiration)).Should(gomega.BeNil())
+		c.Delete("sub-account-2")
+
+		// when
+		g.Expect(c.Flush()).Should(gomega.BeNil())
+		restored, err := NewFileCache(path, time.Minute, log)
+
+		// then
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(restored.ItemCount()).To(gomega.Equal(1))
+		obj, found := restored.Get(record.SubAccountID)
+		g.Expect(found).To(gomega.BeTrue())
+		expected := record
+		expected.KubeConfig = ""
+		g.Expect(obj).To(gomega.Equal(expected))
+
+		content, err := os.ReadFile(path)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(string(content)).NotTo(gomega.ContainSubstring("secret-kubeconfig"))
+	})
+
+	t.Run("should fail when the file is corrupted", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "records.json")
+		g.Expect(os.WriteFile(path, []byte("{not-json"), 0600)).Should(gomega.BeNil())
+
+		_, err := NewFileCache(path, time.Minute, log)
+
+		g.Expect(err).ShouldNot(gomega.BeNil())
+	})
+}
@@ -94,7 +94,7 @@ func (eClient Client) Send(req *http.Request, payload []byte) (*http.Response, e
 		}
 
 		if resp.StatusCode != http.StatusCreated {
-			non2xxErr := fmt.Errorf("failed to send event stream as EDP returned HTTP: %d", resp.StatusCode)
+			non2xxErr := StatusError{StatusCode: resp.StatusCode}
 			eClient.namedLogger().With(log.KeyError, non2xxErr.Error()).With(log.KeyRetry, log.ValueTrue).
 				Warn("send event stream as EDP")
 			err = non2xxErr
@@ -120,6 +120,51 @@ func (eClient Client) Send(req *http.Request, payload []byte) (*http.Response, e
 	return resp, nil
 }
 
+// Deliver sends the event stream of the given tenant to EDP
+func (eClient Client) Deliver(tenant string, payload []byte) error {
+	req, err := eClient.NewRequest(tenant)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create a new request for EDP")
+	}
+
+	resp, err := eClient.Send(req, payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send event-stream to EDP")
+	}
+
+	if !isSuccess(resp.StatusCode) {
+		return StatusError{StatusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// StatusError is returned when EDP responds with an unexpected HTTP status
+type StatusError struct {
+	StatusCode int
+}
+
+func (e StatusError) Error() string {
+	return fmt.Sprintf("failed to send event stream as EDP returned HTTP: %d", e.StatusCode)
+}
+
+// IsPermanent returns true if the error is caused by an event which EDP will never accept,
+// resending such an event makes no sense
+func IsPermanent(err error) bool {
+	var statusErr StatusError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	switch statusErr.StatusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return false
+	}
+	return statusErr.StatusCode >= http.StatusBadRequest && statusErr.StatusCode < http.StatusInternalServerError
+}
+
+func isSuccess(status int) bool {
+	return status >= http.StatusOK && status < http.StatusMultipleChoices
+}
+
 func (c *Client) namedLogger() *zap.SugaredLogger {
 	return c.Logger.Named(clientName).With("component", "EDP")
 }
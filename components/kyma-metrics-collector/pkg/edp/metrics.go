@@ -30,4 +30,22 @@ var (
 			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10},
 		},
 	)
+
+	outboxPendingEvents = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "outbox_pending_events",
+			Help:      "Number of events stored in the outbox which are not delivered yet.",
+		},
+	)
+
+	outboxDeadEvents = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: Subsystem,
+			Name:      "outbox_dead_events_total",
+			Help:      "Total number of events rejected by EDP and moved to the dead letters of the outbox.",
+		},
+	)
 )
@@ -0,0 +1,222 @@
+package edp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const (
+	outboxName         = "edp-outbox"
+	outboxEventExt     = ".json"
+	outboxTmpExt       = ".tmp"
+	outboxDeadLetters  = "dead"
+	outboxPollInterval = time.Minute
+)
+
+// OutboxConfig configures the local queue of the event streams
+type OutboxConfig struct {
+	// Dir is the directory where the events are stored until they are delivered
+	Dir string
+	// InitialBackoff is the time to wait before the first retry after a failed delivery
+	InitialBackoff time.Duration
+	// MaxBackoff limits the time between the retries of a failed delivery
+	MaxBackoff time.Duration
+}
+
+// Outbox stores every event stream in a local directory before it is delivered to the sink.
+// The events are delivered in the order of their timestamps, if the sink fails the delivery is retried
+// with an exponential backoff, so the events collected during an EDP outage are replayed once EDP is back.
+// Events rejected permanently by EDP are moved to the dead letter directory.
+type Outbox struct {
+	config OutboxConfig
+	sink   Sink
+	logger *zap.SugaredLogger
+
+	// mu serializes the delivery, Enqueue is safe to call concurrently
+	mu     sync.Mutex
+	seq    uint64
+	notify chan struct{}
+}
+
+type outboxEvent struct {
+	Tenant    string          `json:"tenant"`
+	Timestamp time.Time       `json:"timestamp"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// NewOutbox creates the outbox directory if needed, the events stored by a previous run are delivered first
+func NewOutbox(config OutboxConfig, sink Sink, logger *zap.SugaredLogger) (*Outbox, error) {
+	if config.InitialBackoff <= 0 {
+		config.InitialBackoff = 5 * time.Second
+	}
+	if config.MaxBackoff < config.InitialBackoff {
+		config.MaxBackoff = config.InitialBackoff
+	}
+	if err := os.MkdirAll(filepath.Join(config.Dir, outboxDeadLetters), 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create outbox directory %s", config.Dir)
+	}
+
+	o := &Outbox{
+		config: config,
+		sink:   sink,
+		logger: logger,
+		seq:    uint64(time.Now().UnixNano()),
+		notify: make(chan struct{}, 1),
+	}
+	pending, err := o.pendingEvents()
+	if err != nil {
+		return nil, err
+	}
+	outboxPendingEvents.Set(float64(len(pending)))
+	return o, nil
+}
+
+// Enqueue stores the event stream of the tenant, the event is delivered asynchronously by Run
+func (o *Outbox) Enqueue(tenant string, timestamp time.Time, payload []byte) error {
+	data, err := json.Marshal(outboxEvent{
+		Tenant:    tenant,
+		Timestamp: timestamp,
+		Payload:   payload,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal event for tenant %s", tenant)
+	}
+
+	// the name starts with the timestamp, so sorting the names gives the order of delivery
+	name := fmt.Sprintf("%020d-%020d%s", timestamp.UnixNano(), atomic.AddUint64(&o.seq, 1), outboxEventExt)
+	path := filepath.Join(o.config.Dir, name)
+	tmp := path + outboxTmpExt
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write event to %s", tmp)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrapf(err, "failed to move event to %s", path)
+	}
+	outboxPendingEvents.Inc()
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Run delivers the stored events until the context is done
+func (o *Outbox) Run(ctx context.Context) {
+	backoff := o.config.InitialBackoff
+	for {
+		if err := o.Deliver(); err != nil {
+			o.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				With(log.KeyRetry, log.ValueTrue).Warnf("deliver stored events, retrying in %v", backoff)
+			// new events do not shorten the backoff, they are delivered with the retry
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+			if backoff > o.config.MaxBackoff {
+				backoff = o.config.MaxBackoff
+			}
+			continue
+		}
+
+		backoff = o.config.InitialBackoff
+		select {
+		case <-ctx.Done():
+			return
+		case <-o.notify:
+		case <-time.After(outboxPollInterval):
+		}
+	}
+}
+
+// Deliver sends all stored events to the sink in the order of their timestamps. It stops at the first event
+// which could not be delivered, so the order is kept when the delivery is retried.
+func (o *Outbox) Deliver() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	pending, err := o.pendingEvents()
+	if err != nil {
+		return err
+	}
+	for _, name := range pending {
+		path := filepath.Join(o.config.Dir, name)
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read event %s", path)
+		}
+		event := outboxEvent{}
+		if err := json.Unmarshal(data, &event); err != nil {
+			o.namedLogger().With(log.KeyError, err.Error()).Errorf("event %s is corrupted", name)
+			if err := o.moveToDeadLetters(name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = o.sink.Deliver(event.Tenant, event.Payload)
+		if err != nil && IsPermanent(err) {
+			o.namedLogger().With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
+				With(log.KeySubAccountID, event.Tenant).Errorf("event %s rejected", name)
+			if err := o.moveToDeadLetters(name); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to deliver event %s", name)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return errors.Wrapf(err, "failed to remove delivered event %s", path)
+		}
+		outboxPendingEvents.Dec()
+		o.namedLogger().With(log.KeySubAccountID, event.Tenant).Debugf("delivered event %s", name)
+	}
+	return nil
+}
+
+func (o *Outbox) moveToDeadLetters(name string) error {
+	if err := os.Rename(filepath.Join(o.config.Dir, name), filepath.Join(o.config.Dir, outboxDeadLetters, name)); err != nil {
+		return errors.Wrapf(err, "failed to move event %s to dead letters", name)
+	}
+	outboxPendingEvents.Dec()
+	outboxDeadEvents.Inc()
+	return nil
+}
+
+// pendingEvents returns the names of the stored events sorted by their timestamps
+func (o *Outbox) pendingEvents() ([]string, error) {
+	entries, err := os.ReadDir(o.config.Dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list outbox directory %s", o.config.Dir)
+	}
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), outboxEventExt) {
+			continue
+		}
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (o *Outbox) namedLogger() *zap.SugaredLogger {
+	return o.logger.Named(outboxName).With("component", "EDP")
+}
@@ -0,0 +1,139 @@
+package edp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+
+	"github.com/onsi/gomega"
+	"go.uber.org/zap/zapcore"
+)
+
+type fakeSink struct {
+	mu        sync.Mutex
+	err       error
+	delivered []string
+}
+
+func (s *fakeSink) Deliver(tenant string, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.delivered = append(s.delivered, fmt.Sprintf("%s:%s", tenant, payload))
+	return nil
+}
+
+func (s *fakeSink) fail(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+func TestOutbox(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	log := logger.NewLogger(zapcore.InfoLevel)
+	now := time.Now()
+
+	t.Run("should replay stored events in timestamp order after an outage", func(t *testing.T) {
+		sink := &fakeSink{err: StatusError{StatusCode: 503}}
+		outbox, err := NewOutbox(OutboxConfig{Dir: t.TempDir()}, sink, log)
+		g.Expect(err).Should(gomega.BeNil())
+
+		g.Expect(outbox.Enqueue("tenant-2", now.Add(time.Minute), []byte(`2`))).Should(gomega.Succeed())
+		g.Expect(outbox.Enqueue("tenant-1", now, []byte(`1`))).Should(gomega.Succeed())
+		g.Expect(outbox.Enqueue("tenant-1", now.Add(2*time.Minute), []byte(`3`))).Should(gomega.Succeed())
+
+		err = outbox.Deliver()
+		g.Expect(err).ShouldNot(gomega.BeNil())
+		g.Expect(sink.delivered).To(gomega.BeEmpty())
+
+		sink.fail(nil)
+		g.Expect(outbox.Deliver()).Should(gomega.Succeed())
+		g.Expect(sink.delivered).To(gomega.Equal([]string{"tenant-1:1", "tenant-2:2", "tenant-1:3"}))
+
+		pending, err := outbox.pendingEvents()
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(pending).To(gomega.BeEmpty())
+	})
+
+	t.Run("should deliver events stored by a previous run", func(t *testing.T) {
+		dir := t.TempDir()
+		outbox, err := NewOutbox(OutboxConfig{Dir: dir}, &fakeSink{}, log)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(outbox.Enqueue("tenant-1", now, []byte(`{"compute":{}}`))).Should(gomega.Succeed())
+
+		sink := &fakeSink{}
+		restarted, err := NewOutbox(OutboxConfig{Dir: dir}, sink, log)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(restarted.Deliver()).Should(gomega.Succeed())
+		g.Expect(sink.delivered).To(gomega.Equal([]string{`tenant-1:{"compute":{}}`}))
+	})
+
+	t.Run("should move events rejected by EDP to dead letters", func(t *testing.T) {
+		dir := t.TempDir()
+		sink := &fakeSink{err: StatusError{StatusCode: 400}}
+		outbox, err := NewOutbox(OutboxConfig{Dir: dir}, sink, log)
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(outbox.Enqueue("tenant-1", now, []byte(`1`))).Should(gomega.Succeed())
+
+		g.Expect(outbox.Deliver()).Should(gomega.Succeed())
+
+		pending, err := outbox.pendingEvents()
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(pending).To(gomega.BeEmpty())
+		dead, err := os.ReadDir(filepath.Join(dir, outboxDeadLetters))
+		g.Expect(err).Should(gomega.BeNil())
+		g.Expect(dead).To(gomega.HaveLen(1))
+	})
+
+	t.Run("should deliver enqueued events while running", func(t *testing.T) {
+		sink := &fakeSink{}
+		outbox, err := NewOutbox(OutboxConfig{Dir: t.TempDir()}, sink, log)
+		g.Expect(err).Should(gomega.BeNil())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go outbox.Run(ctx)
+
+		g.Expect(outbox.Enqueue("tenant-1", now, []byte(`1`))).Should(gomega.Succeed())
+
+		g.Eventually(func() int {
+			sink.mu.Lock()
+			defer sink.mu.Unlock()
+			return len(sink.delivered)
+		}, 5*time.Second).Should(gomega.Equal(1))
+	})
+}
+
+func TestWriterSink(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	buf := &bytes.Buffer{}
+	sink := NewWriterSink(buf)
+
+	g.Expect(sink.Deliver("tenant-1", []byte(`{"timestamp":"2022-11-10T10:00:00Z"}`))).Should(gomega.Succeed())
+
+	event := map[string]interface{}{}
+	g.Expect(json.Unmarshal(buf.Bytes(), &event)).Should(gomega.Succeed())
+	g.Expect(event["tenant"]).To(gomega.Equal("tenant-1"))
+	g.Expect(event["event"]).To(gomega.Equal(map[string]interface{}{"timestamp": "2022-11-10T10:00:00Z"}))
+	g.Expect(buf.String()).To(gomega.HaveSuffix("\n"))
+}
+
+func TestIsPermanent(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(IsPermanent(StatusError{StatusCode: 400})).To(gomega.BeTrue())
+	g.Expect(IsPermanent(fmt.Errorf("wrapped: %w", StatusError{StatusCode: 404}))).To(gomega.BeTrue())
+	g.Expect(IsPermanent(StatusError{StatusCode: 429})).To(gomega.BeFalse())
+	g.Expect(IsPermanent(StatusError{StatusCode: 500})).To(gomega.BeFalse())
+	g.Expect(IsPermanent(fmt.Errorf("connection refused"))).To(gomega.BeFalse())
+}
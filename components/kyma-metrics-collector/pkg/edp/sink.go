@@ -0,0 +1,64 @@
+package edp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	SinkEDP    = "edp"
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+)
+
+// Sink receives the event streams of the tenants
+type Sink interface {
+	Deliver(tenant string, payload []byte) error
+}
+
+// ValidateSink checks if the given sink type is supported
+func ValidateSink(sink string) error {
+	switch sink {
+	case SinkEDP, SinkStdout, SinkFile:
+		return nil
+	}
+	return fmt.Errorf("unsupported EDP sink %q, use one of: %s, %s, %s", sink, SinkEDP, SinkStdout, SinkFile)
+}
+
+// WriterSink writes every event stream as a single JSON line, it is used instead of EDP in the environments
+// where EDP is not available, e.g. dev clusters
+type WriterSink struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+type sinkEvent struct {
+	Tenant    string          `json:"tenant"`
+	Delivered string          `json:"delivered"`
+	Event     json.RawMessage `json:"event"`
+}
+
+func NewWriterSink(writer io.Writer) *WriterSink {
+	return &WriterSink{writer: writer}
+}
+
+func (s *WriterSink) Deliver(tenant string, payload []byte) error {
+	line, err := json.Marshal(sinkEvent{
+		Tenant:    tenant,
+		Delivered: time.Now().Format(time.RFC3339),
+		Event:     payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal event stream: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.writer.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write event stream: %w", err)
+	}
+	return nil
+}
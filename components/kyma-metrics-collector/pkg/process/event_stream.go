@@ -1,6 +1,7 @@
 package process
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -26,9 +27,10 @@ const (
 	// storageRoundingFactor rounds of storage to 32. E.g. 17 -> 32, 33 -> 64
 	storageRoundingFactor = 32
 
-	Azure = "azure"
-	AWS   = "aws"
-	GCP   = "gcp"
+	Azure     = "azure"
+	AWS       = "aws"
+	GCP       = "gcp"
+	OpenStack = "openstack"
 )
 
 type EventStream struct {
@@ -43,6 +45,14 @@ type Input struct {
 	svcList  *corev1.ServiceList
 }
 
+// openStackInfrastructureConfig contains the part of the OpenStack infrastructure config which is relevant for metering
+type openStackInfrastructureConfig struct {
+	Networks struct {
+		// ID is set when an existing network is used, otherwise a new network is created for the shoot
+		ID *string `json:"id,omitempty"`
+	} `json:"networks"`
+}
+
 type NodeInfo struct {
 	cpu    int
 	memory int
@@ -75,6 +85,7 @@ func (inp Input) Parse(providers *Providers) (*edp.ConsumptionMetrics, error) {
 		// Calculate CPU and Memory
 		vmFeature := providers.GetFeature(providerType, nodeType)
 		if vmFeature == nil {
+			missingVMTypes.WithLabelValues(providerType, nodeType).Inc()
 			return nil, fmt.Errorf("providerType: %s and nodeType: %s does not exist in the map", providerType, nodeType)
 		}
 		provisionedCPUs += vmFeature.CpuCores
@@ -139,8 +150,19 @@ func (inp Input) Parse(providers *Providers) (*edp.ConsumptionMetrics, error) {
 			if infraConfig.Networks.VPC != nil && infraConfig.Networks.VPC.CloudRouter != nil {
 				vnets += 1
 			}
+		case OpenStack:
+			infraConfig := &openStackInfrastructureConfig{}
+			if err := json.Unmarshal(rawExtension.Raw, infraConfig); err != nil {
+				return nil, err
+			}
+			if infraConfig.Networks.ID == nil {
+				vnets += 1
+			}
 		default:
-			return nil, fmt.Errorf("provider: %s does not match in the system", inp.shoot.Spec.Provider.Type)
+			// the networks of the generic providers are not metered
+			if !providers.IsSupported(inp.shoot.Spec.Provider.Type) {
+				return nil, fmt.Errorf("provider: %s does not match in the system", inp.shoot.Spec.Provider.Type)
+			}
 		}
 	}
 	metric.Timestamp = getTimestampNow()
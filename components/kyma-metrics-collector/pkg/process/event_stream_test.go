@@ -3,6 +3,8 @@ package process
 import (
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/env"
@@ -121,6 +123,58 @@ func TestParse(t *testing.T) {
 			providers:   *providers,
 			expectedErr: true,
 		},
+		{
+			name: "with OpenStack with 2 vms and a new network",
+			input: Input{
+				shoot: kmctesting.GetShoot("testShoot", kmctesting.WithOpenStackProviderAndGC4M16VMs),
+				nodeList: &corev1.NodeList{
+					Items: []corev1.Node{kmctesting.GetNode("node1", "g_c4_m16"), kmctesting.GetNode("node2", "g_c4_m16")},
+				},
+			},
+			providers: *providers,
+			expectedMetrics: edp.ConsumptionMetrics{
+				Compute: edp.Compute{
+					VMTypes: []edp.VMType{{
+						Name:  "g_c4_m16",
+						Count: 2,
+					}},
+					ProvisionedCpus:  8,
+					ProvisionedRAMGb: 32,
+				},
+				Networking: edp.Networking{
+					ProvisionedVnets: 1,
+				},
+			},
+		},
+		{
+			name: "with generic provider and vm type from the public cloud specs",
+			input: Input{
+				shoot: kmctesting.GetShoot("testShoot", kmctesting.WithProvider("alicloud")),
+				nodeList: &corev1.NodeList{
+					Items: []corev1.Node{kmctesting.GetNode("node1", "ecs.g6.xlarge")},
+				},
+			},
+			providers: *providers,
+			expectedMetrics: edp.ConsumptionMetrics{
+				Compute: edp.Compute{
+					VMTypes: []edp.VMType{{
+						Name:  "ecs.g6.xlarge",
+						Count: 1,
+					}},
+					ProvisionedCpus:  4,
+					ProvisionedRAMGb: 16,
+				},
+			},
+		},
+		{
+			name: "with unknown provider",
+			input: Input{
+				shoot:    kmctesting.GetShoot("testShoot", kmctesting.WithProvider("foo")),
+				nodeList: &corev1.NodeList{},
+			},
+			providers:   *providers,
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -133,12 +187,35 @@ func TestParse(t *testing.T) {
 				g.Expect(gotMetrics.Timestamp).To(gomega.Not(gomega.BeEmpty()))
 				return
 			}
+			g.Expect(tc.expectedErr).Should(gomega.BeTrue())
 			g.Expect(err).ShouldNot(gomega.BeNil())
 			g.Expect(gotMetrics).Should(gomega.BeNil())
 		})
 	}
 }
 
+func TestParseCountsMissingVMTypes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	providersData, err := kmctesting.LoadFixtureFromFile(providersFile)
+	g.Expect(err).Should(gomega.BeNil())
+	config := &env.Config{PublicCloudSpecs: string(providersData)}
+	providers, err := LoadPublicCloudSpecs(config)
+	g.Expect(err).Should(gomega.BeNil())
+	counter := missingVMTypes.WithLabelValues(OpenStack, "g_c2_m8")
+	before := testutil.ToFloat64(counter)
+
+	input := Input{
+		shoot: kmctesting.GetShoot("testShoot", kmctesting.WithOpenStackProviderAndGC4M16VMs),
+		nodeList: &corev1.NodeList{
+			Items: []corev1.Node{kmctesting.GetNode("node1", "g_c2_m8")},
+		},
+	}
+	_, err = input.Parse(providers)
+
+	g.Expect(err).ShouldNot(gomega.BeNil())
+	g.Expect(testutil.ToFloat64(counter)).Should(gomega.Equal(before + 1))
+}
+
 func TestGetSizeInGB(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	testCases := []struct {
@@ -15,4 +15,58 @@ var (
 		},
 		[]string{"requestURI"},
 	)
+	missingVMTypes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "kmc",
+			Subsystem: "process",
+			Name:      "missing_vm_type_total",
+			Help:      "Number of nodes whose VM type is not found in the public cloud specs.",
+		},
+		[]string{"provider", "vm_type"},
+	)
 )
+
+var (
+	runtimeLabels   = []string{"subaccount_id", "shoot_name", "provider", "region"}
+	runtimeVMLabels = append(append([]string{}, runtimeLabels...), "vm_type")
+
+	runtimeProvisionedCPUs = newRuntimeGauge("provisioned_cpus", "Number of CPUs provisioned for the runtime.")
+	runtimeProvisionedRAM  = newRuntimeGauge("provisioned_ram_gb", "Size of the RAM provisioned for the runtime in GB.")
+	runtimeVolumes         = newRuntimeGauge("provisioned_volumes", "Number of volumes provisioned for the runtime.")
+	runtimeVolumesSize     = newRuntimeGauge("provisioned_volumes_size_gb", "Total size of the volumes provisioned for the runtime in GB.")
+	runtimeVolumesRounded  = newRuntimeGauge("provisioned_volumes_size_gb_rounded", "Total size of the volumes provisioned for the runtime in GB, rounded to the billing factor.")
+	runtimeVnets           = newRuntimeGauge("provisioned_vnets", "Number of virtual networks provisioned for the runtime.")
+	runtimeIPs             = newRuntimeGauge("provisioned_ips", "Number of IPs provisioned for the runtime.")
+	runtimeVMs             = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "runtime",
+			Name:      "vms",
+			Help:      "Number of VMs of the VM type provisioned for the runtime.",
+		},
+		runtimeVMLabels,
+	)
+	runtimeScrapeSuccess  = newRuntimeGauge("scrape_success", "Whether the last scrape of the runtime succeeded (1) or failed (0).")
+	runtimeScrapeDuration = newRuntimeGauge("scrape_duration_seconds", "Duration of the last scrape of the runtime in seconds.")
+	runtimeLastScrape     = newRuntimeGauge("last_successful_scrape_timestamp_seconds", "Unix timestamp of the last successful scrape of the runtime.")
+	runtimesDropped       = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "kmc",
+			Subsystem: "runtime",
+			Name:      "dropped_total",
+			Help:      "Number of scrapes whose runtime metrics were not exposed because the maximum number of exposed runtimes was reached.",
+		},
+	)
+)
+
+func newRuntimeGauge(name, help string) *prometheus.GaugeVec {
+	return promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "kmc",
+			Subsystem: "runtime",
+			Name:      name,
+			Help:      help,
+		},
+		runtimeLabels,
+	)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -35,17 +34,19 @@ import (
 
 type Process struct {
 	KEBClient       *keb.Client
-	EDPClient       *edp.Client
+	EDPSink         edp.Sink
+	Outbox          *edp.Outbox
 	Queue           workqueue.DelayingInterface
 	ShootClient     *gardenershoot.Client
 	SecretClient    *gardenersecret.Client
-	Cache           *cache.Cache
+	Cache           kmccache.Cache
 	Providers       *Providers
 	ScrapeInterval  time.Duration
 	WorkersPoolSize int
 	NodeConfig      skrnode.ConfigInf
 	PVCConfig       skrpvc.ConfigInf
 	SvcConfig       skrsvc.ConfigInf
+	RuntimeMetrics  *RuntimeMetrics
 	Logger          *zap.SugaredLogger
 }
 
@@ -98,6 +99,8 @@ func (p Process) generateRecordWithNewMetrics(identifier int, subAccountID strin
 		p.namedLogger().With(log.KeyError, err.Error()).With(log.KeyShoot, shootName).Error("Failed to get shoot")
 		return
 	}
+	record.Provider = shoot.Spec.Provider.Type
+	record.Region = shoot.Spec.Region
 
 	// Get nodes dynamic client
 	nodesClient, err := p.NodeConfig.NewClient(record.KubeConfig)
@@ -200,6 +203,7 @@ func (p *Process) pollKEBForRuntimes() {
 func (p Process) Start() {
 
 	var wg sync.WaitGroup
+	p.queueCachedSubAccounts()
 	go func() {
 		p.pollKEBForRuntimes()
 	}()
@@ -215,6 +219,18 @@ func (p Process) Start() {
 	wg.Wait()
 }
 
+// queueCachedSubAccounts queues the subAccountIDs restored from a persistent cache, so that their last known
+// metrics are sent even if the SKRs are not reachable. Subaccounts which are not tracked anymore are
+// removed from the cache by the next KEB poll.
+func (p Process) queueCachedSubAccounts() {
+	for subAccountID := range p.Cache.Items() {
+		p.Queue.Add(subAccountID)
+	}
+	if count := p.Cache.ItemCount(); count > 0 {
+		p.namedLogger().Infof("queued %d subAccountIDs restored from the cache", count)
+	}
+}
+
 // Execute is executed by each worker to process an entry from the queue
 func (p *Process) execute(identifier int) {
 
@@ -284,7 +300,7 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 	// Note: EDP refers SubAccountID as tenant
 	p.namedLoggerWithRuntime(record).With(log.KeySubAccountID, subAccountID).
 		With(log.KeyWorkerID, identifier).Debugf("sending EventStreamToEDP: payload: %s", string(payload))
-	err = p.sendEventStreamToEDP(subAccountID, payload)
+	err = p.sendEventStream(subAccountID, record.Metric, payload)
 	if err != nil {
 		p.namedLoggerWithRuntime(record).With(log.KeyResult, log.ValueFail).With(log.KeyError, err.Error()).
 			With(log.KeySubAccountID, subAccountID).With(log.KeyWorkerID, identifier).
@@ -317,9 +333,14 @@ func (p Process) processSubAccountID(subAccountID string, identifier int) {
 // getRecordWithOldOrNewMetric generates new metric or fetches the old metric along with a bool flag which
 // indicates whether it is an old metric or not(true, when it is old and false when it is new)
 func (p Process) getRecordWithOldOrNewMetric(identifier int, subAccountID string) (*kmccache.Record, bool, error) {
+	start := time.Now()
 	record, err := p.generateRecordWithNewMetrics(identifier, subAccountID)
+	if !errors.Is(err, errorSubAccountIDNotTrackable) {
+		p.RuntimeMetrics.ObserveScrape(record, time.Since(start), err)
+	}
 	if err != nil {
 		if errors.Is(err, errorSubAccountIDNotTrackable) {
+			p.RuntimeMetrics.Delete(subAccountID)
 			p.namedLoggerWithRuntime(&record).With(log.KeySubAccountID, subAccountID).
 				With(log.KeyWorkerID, identifier).Info("subAccountID is not trackable anymore, skipping the fetch of old metric")
 			return nil, false, err
@@ -337,30 +358,23 @@ func (p Process) getRecordWithOldOrNewMetric(identifier int, subAccountID string
 	return &record, false, nil
 }
 
-func (p Process) sendEventStreamToEDP(tenant string, payload []byte) error {
-	edpRequest, err := p.EDPClient.NewRequest(tenant)
-	if err != nil {
-		return errors.Wrapf(err, "failed to create a new request for EDP")
+// sendEventStream stores the event stream in the outbox when it is enabled, otherwise the event stream is delivered directly
+func (p Process) sendEventStream(tenant string, metric *edp.ConsumptionMetrics, payload []byte) error {
+	if p.Outbox == nil {
+		return p.EDPSink.Deliver(tenant, payload)
 	}
 
-	resp, err := p.EDPClient.Send(edpRequest, payload)
+	// the outbox replays the events in the order of their timestamps
+	timestamp, err := time.Parse(time.RFC3339, metric.Timestamp)
 	if err != nil {
-		return errors.Wrapf(err, "failed to send event-stream to EDP")
+		timestamp = time.Now()
 	}
-
-	if !isSuccess(resp.StatusCode) {
-		return fmt.Errorf("failed to send event-stream to EDP as it returned HTTP: %d", resp.StatusCode)
+	if err := p.Outbox.Enqueue(tenant, timestamp, payload); err != nil {
+		return errors.Wrapf(err, "failed to store event-stream in the outbox")
 	}
 	return nil
 }
 
-func isSuccess(status int) bool {
-	if status >= http.StatusOK && status < http.StatusMultipleChoices {
-		return true
-	}
-	return false
-}
-
 func isClusterTrackable(runtime *kebruntime.RuntimeDTO) bool {
 	if runtime.Status.Provisioning != nil &&
 		runtime.Status.Provisioning.State == "succeeded" &&
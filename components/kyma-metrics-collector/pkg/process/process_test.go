@@ -1,6 +1,7 @@
 package process
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -120,6 +121,28 @@ func TestGetOldRecordIfMetricExists(t *testing.T) {
 	})
 }
 
+func TestQueueCachedSubAccounts(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	cache := gocache.New(gocache.NoExpiration, gocache.NoExpiration)
+	subAccIDs := []string{uuid.New().String(), uuid.New().String()}
+	for _, subAccID := range subAccIDs {
+		err := cache.Add(subAccID, kmccache.Record{SubAccountID: subAccID, Metric: NewMetric()}, gocache.NoExpiration)
+		g.Expect(err).Should(gomega.BeNil())
+	}
+	queue := workqueue.NewDelayingQueue()
+	p := Process{
+		Cache:  cache,
+		Queue:  queue,
+		Logger: logger.NewLogger(zapcore.InfoLevel),
+	}
+
+	// when
+	p.queueCachedSubAccounts()
+
+	// then
+	g.Expect(queue.Len()).To(gomega.Equal(len(subAccIDs)))
+}
+
 func TestPollKEBForRuntimes(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -226,7 +249,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 		}
 
 		p.populateCacheAndQueue(runtimesPage)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedCache))
 		g.Expect(areQueuesEqual(p.Queue, expectedQueue)).To(gomega.BeTrue())
 	})
 
@@ -254,7 +277,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 		}
 
 		p.populateCacheAndQueue(runtimesPage)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedCache))
 		g.Expect(areQueuesEqual(p.Queue, expectedQueue)).To(gomega.BeTrue())
 	})
 
@@ -286,7 +309,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 		runtimesPage.Data = append(runtimesPage.Data, rntme)
 
 		p.populateCacheAndQueue(runtimesPage)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedCache))
 		g.Expect(areQueuesEqual(p.Queue, expectedQueue)).To(gomega.BeTrue())
 	})
 
@@ -313,7 +336,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 		runtimesPageWithNoRuntimes.Data = []kebruntime.RuntimeDTO{}
 
 		p.populateCacheAndQueue(runtimesPageWithNoRuntimes)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedEmptyCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedEmptyCache))
 		g.Expect(areQueuesEqual(p.Queue, expectedEmptyQueue)).To(gomega.BeTrue())
 	})
 
@@ -343,7 +366,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 
 		// expected cache changes after deprovisioning
 		p.populateCacheAndQueue(runtimesPage)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedCache))
 		g.Expect(areQueuesEqual(p.Queue, expectedQueue)).To(gomega.BeTrue())
 
 		// provision a new SKR again with a new name
@@ -359,7 +382,7 @@ func TestPopulateCacheAndQueue(t *testing.T) {
 
 		runtimesPage.Data = []kebruntime.RuntimeDTO{rntme}
 		p.populateCacheAndQueue(skrRuntimesPageWithProvisioning)
-		g.Expect(*p.Cache).To(gomega.Equal(*expectedCache))
+		g.Expect(p.Cache).To(gomega.Equal(expectedCache))
 		gotSubAccID, _ := p.Queue.Get()
 		g.Expect(gotSubAccID).To(gomega.Equal(subAccID))
 	})
@@ -427,7 +450,7 @@ func TestExecute(t *testing.T) {
 	fakeSvcClient := skrsvc.FakeSvcClient{}
 
 	newProcess := &Process{
-		EDPClient:      edpClient,
+		EDPSink:        edpClient,
 		Queue:          queue,
 		ShootClient:    shootClient,
 		SecretClient:   secretClient,
@@ -438,6 +461,7 @@ func TestExecute(t *testing.T) {
 		NodeConfig:     fakeNodeClient,
 		PVCConfig:      fakePVCClient,
 		SvcConfig:      fakeSvcClient,
+		RuntimeMetrics: NewRuntimeMetrics(10),
 	}
 
 	go func() {
@@ -473,6 +497,12 @@ func TestExecute(t *testing.T) {
 		return nil
 	}, bigTimeout).Should(gomega.BeNil())
 
+	// Test runtime metrics
+	scrapeSuccess := runtimeScrapeSuccess.With(labelsOf(kmccache.Record{SubAccountID: subAccID, ShootName: shootName, Provider: Azure}))
+	g.Eventually(func() float64 {
+		return testutil.ToFloat64(scrapeSuccess)
+	}, bigTimeout).Should(gomega.Equal(float64(1)))
+
 	// Test queue state
 	g.Eventually(func() string {
 		item, _ := newProcess.Queue.Get()
@@ -488,6 +518,29 @@ func TestExecute(t *testing.T) {
 	g.Eventually(newProcess.Queue.Len()).Should(gomega.Equal(0))
 }
 
+func TestSendEventStreamWithOutbox(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	log := logger.NewLogger(zapcore.InfoLevel)
+	buf := &bytes.Buffer{}
+	outbox, err := edp.NewOutbox(edp.OutboxConfig{Dir: t.TempDir()}, edp.NewWriterSink(buf), log)
+	g.Expect(err).Should(gomega.BeNil())
+	p := Process{
+		Outbox: outbox,
+		Logger: log,
+	}
+	metric := NewMetric()
+	payload, err := json.Marshal(metric)
+	g.Expect(err).Should(gomega.BeNil())
+
+	err = p.sendEventStream("sub-account-1", metric, payload)
+
+	// the event is only stored until the outbox delivers it
+	g.Expect(err).Should(gomega.BeNil())
+	g.Expect(buf.Len()).To(gomega.Equal(0))
+	g.Expect(outbox.Deliver()).Should(gomega.Succeed())
+	g.Expect(buf.String()).To(gomega.ContainSubstring(`"tenant":"sub-account-1"`))
+}
+
 func NewFakeShootClient(shoot *gardenerv1beta1.Shoot) (*gardenershoot.Client, error) {
 	scheme, err := commons.SetupSchemeOrDie()
 	if err != nil {
@@ -10,9 +10,12 @@ import (
 )
 
 type Providers struct {
-	Azure AzureMachines
-	AWS   AWSMachines
-	GCP   GCPMachines
+	Azure     AzureMachines
+	AWS       AWSMachines
+	GCP       GCPMachines
+	OpenStack OpenStackMachines
+	// Generic contains the machines of all other providers, keyed by the provider type of the shoot
+	Generic map[string]GenericMachines
 }
 
 type AzureMachines map[string]Feature
@@ -21,6 +24,10 @@ type AWSMachines map[string]Feature
 
 type GCPMachines map[string]Feature
 
+type OpenStackMachines map[string]Feature
+
+type GenericMachines map[string]Feature
+
 type Feature struct {
 	CpuCores int     `json:"cpu_cores"`
 	Memory   float64 `json:"memory"`
@@ -44,10 +51,28 @@ func (p Providers) GetFeature(cloudProvider, vmType string) (f *Feature) {
 		if feature, ok := p.GCP[vmType]; ok {
 			return &feature
 		}
+	case OpenStack:
+		if feature, ok := p.OpenStack[vmType]; ok {
+			return &feature
+		}
+	default:
+		if feature, ok := p.Generic[cloudProvider][vmType]; ok {
+			return &feature
+		}
 	}
 	return nil
 }
 
+// IsSupported returns true if the specs contain machines of the given provider
+func (p Providers) IsSupported(cloudProvider string) bool {
+	switch cloudProvider {
+	case AWS, Azure, GCP, OpenStack:
+		return true
+	}
+	_, ok := p.Generic[cloudProvider]
+	return ok
+}
+
 // LoadPublicCloudSpecs loads string data to Providers object from an env var
 func LoadPublicCloudSpecs(cfg *env.Config) (*Providers, error) {
 	if cfg.PublicCloudSpecs == "" {
@@ -59,37 +84,27 @@ func LoadPublicCloudSpecs(cfg *env.Config) (*Providers, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to unmarshal machine info")
 	}
-	awsMachinesData, err := machineInfo[AWS].MarshalJSON()
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to marshal AWS info")
-	}
-	awsMachines := &AWSMachines{}
-	err = json.Unmarshal(awsMachinesData, &awsMachines)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal AWS machines data")
-	}
-	azureMachinesData, err := machineInfo[Azure].MarshalJSON()
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to marshal Azure info")
-	}
-	azureMachines := &AzureMachines{}
-	err = json.Unmarshal(azureMachinesData, azureMachines)
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal Azure machines data")
-	}
-	gcpMachinesData, err := machineInfo[GCP].MarshalJSON()
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to marshal GCP info")
-	}
-	gcpMachines := &GCPMachines{}
-	if err = json.Unmarshal(gcpMachinesData, gcpMachines); err != nil {
-		return nil, errors.Wrapf(err, "failed to unmarshal GCP machines data")
-	}
 
 	providers := Providers{
-		AWS:   *awsMachines,
-		Azure: *azureMachines,
-		GCP:   *gcpMachines,
+		Generic: map[string]GenericMachines{},
+	}
+	for provider, data := range machineInfo {
+		machines := map[string]Feature{}
+		if err := json.Unmarshal(data, &machines); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal %s machines data", provider)
+		}
+		switch provider {
+		case AWS:
+			providers.AWS = machines
+		case Azure:
+			providers.Azure = machines
+		case GCP:
+			providers.GCP = machines
+		case OpenStack:
+			providers.OpenStack = machines
+		default:
+			providers.Generic[provider] = machines
+		}
 	}
 
 	return &providers, nil
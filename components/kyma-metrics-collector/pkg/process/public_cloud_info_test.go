@@ -86,6 +86,30 @@ func TestGetFeature(t *testing.T) {
 				Memory:   64,
 			},
 		},
+		{
+			cloudProvider: "openstack",
+			vmType:        "g_c4_m16",
+			expectedFeature: Feature{
+				CpuCores: 4,
+				Memory:   16,
+			},
+		},
+		{
+			cloudProvider: "openstack",
+			vmType:        "g_c4_m16_foo",
+		},
+		{
+			cloudProvider: "alicloud",
+			vmType:        "ecs.g6.xlarge",
+			expectedFeature: Feature{
+				CpuCores: 4,
+				Memory:   16,
+			},
+		},
+		{
+			cloudProvider: "foo",
+			vmType:        "ecs.g6.xlarge",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -97,3 +121,17 @@ func TestGetFeature(t *testing.T) {
 		g.Expect(gotFeature).Should(gomega.BeNil())
 	}
 }
+
+func TestIsSupported(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	providersData, err := kmctesting.LoadFixtureFromFile(providersFile)
+	g.Expect(err).Should(gomega.BeNil())
+	config := &env.Config{PublicCloudSpecs: string(providersData)}
+	providers, err := LoadPublicCloudSpecs(config)
+	g.Expect(err).Should(gomega.BeNil())
+
+	for _, provider := range []string{"azure", "aws", "gcp", "openstack", "alicloud"} {
+		g.Expect(providers.IsSupported(provider)).Should(gomega.BeTrue(), provider)
+	}
+	g.Expect(providers.IsSupported("foo")).Should(gomega.BeFalse())
+}
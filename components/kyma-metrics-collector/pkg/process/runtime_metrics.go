@@ -0,0 +1,145 @@
+package process
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+)
+
+// RuntimeMetrics exposes the consumption metrics and the scrape results of every runtime as Prometheus gauges.
+// The number of exposed runtimes is limited to guard the cardinality of the metrics, the series of a runtime
+// are removed once it is not tracked anymore.
+type RuntimeMetrics struct {
+	maxRuntimes int
+
+	mu       sync.Mutex
+	runtimes map[string]exposedRuntime
+}
+
+// exposedRuntime contains the label values of the series exposed for a runtime
+type exposedRuntime struct {
+	labels  prometheus.Labels
+	vmTypes []string
+}
+
+// NewRuntimeMetrics creates RuntimeMetrics which exposes at most maxRuntimes runtimes
+func NewRuntimeMetrics(maxRuntimes int) *RuntimeMetrics {
+	return &RuntimeMetrics{
+		maxRuntimes: maxRuntimes,
+		runtimes:    make(map[string]exposedRuntime),
+	}
+}
+
+// ObserveScrape exposes the result of the scrape of the runtime, the consumption metrics are updated
+// only if the scrape succeeded, so the last known values are kept when the runtime is not reachable
+func (m *RuntimeMetrics) ObserveScrape(record kmccache.Record, duration time.Duration, scrapeErr error) {
+	if m == nil || record.SubAccountID == "" {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	labels := prometheus.Labels{
+		"subaccount_id": record.SubAccountID,
+		"shoot_name":    record.ShootName,
+		"provider":      record.Provider,
+		"region":        record.Region,
+	}
+	runtime, found := m.runtimes[record.SubAccountID]
+	if !found && len(m.runtimes) >= m.maxRuntimes {
+		runtimesDropped.Inc()
+		return
+	}
+	if found && !equalLabels(runtime.labels, labels) {
+		// the shoot of the runtime has changed, the series with the old labels are not valid anymore
+		deleteRuntimeSeries(runtime)
+		runtime = exposedRuntime{}
+	}
+	runtime.labels = labels
+
+	runtimeScrapeDuration.With(labels).Set(duration.Seconds())
+	if scrapeErr != nil || record.Metric == nil {
+		runtimeScrapeSuccess.With(labels).Set(0)
+		m.runtimes[record.SubAccountID] = runtime
+		return
+	}
+	runtimeScrapeSuccess.With(labels).Set(1)
+	runtimeLastScrape.With(labels).SetToCurrentTime()
+
+	metric := record.Metric
+	runtimeProvisionedCPUs.With(labels).Set(float64(metric.Compute.ProvisionedCpus))
+	runtimeProvisionedRAM.With(labels).Set(metric.Compute.ProvisionedRAMGb)
+	runtimeVolumes.With(labels).Set(float64(metric.Compute.ProvisionedVolumes.Count))
+	runtimeVolumesSize.With(labels).Set(float64(metric.Compute.ProvisionedVolumes.SizeGbTotal))
+	runtimeVolumesRounded.With(labels).Set(float64(metric.Compute.ProvisionedVolumes.SizeGbRounded))
+	runtimeVnets.With(labels).Set(float64(metric.Networking.ProvisionedVnets))
+	runtimeIPs.With(labels).Set(float64(metric.Networking.ProvisionedIPs))
+
+	// the VM types which are not used anymore are removed, so that the sum of the VMs matches the nodes
+	for _, vmType := range runtime.vmTypes {
+		runtimeVMs.Delete(vmLabels(labels, vmType))
+	}
+	runtime.vmTypes = make([]string, 0, len(metric.Compute.VMTypes))
+	for _, vmType := range metric.Compute.VMTypes {
+		runtimeVMs.With(vmLabels(labels, vmType.Name)).Set(float64(vmType.Count))
+		runtime.vmTypes = append(runtime.vmTypes, vmType.Name)
+	}
+	m.runtimes[record.SubAccountID] = runtime
+}
+
+// Delete removes all the series of the runtime of the subaccount
+func (m *RuntimeMetrics) Delete(subAccountID string) {
+	if m == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if runtime, found := m.runtimes[subAccountID]; found {
+		deleteRuntimeSeries(runtime)
+		delete(m.runtimes, subAccountID)
+	}
+}
+
+func deleteRuntimeSeries(runtime exposedRuntime) {
+	for _, gauge := range []*prometheus.GaugeVec{
+		runtimeProvisionedCPUs,
+		runtimeProvisionedRAM,
+		runtimeVolumes,
+		runtimeVolumesSize,
+		runtimeVolumesRounded,
+		runtimeVnets,
+		runtimeIPs,
+		runtimeScrapeSuccess,
+		runtimeScrapeDuration,
+		runtimeLastScrape,
+	} {
+		gauge.Delete(runtime.labels)
+	}
+	for _, vmType := range runtime.vmTypes {
+		runtimeVMs.Delete(vmLabels(runtime.labels, vmType))
+	}
+}
+
+func vmLabels(labels prometheus.Labels, vmType string) prometheus.Labels {
+	result := prometheus.Labels{"vm_type": vmType}
+	for name, value := range labels {
+		result[name] = value
+	}
+	return result
+}
+
+func equalLabels(a, b prometheus.Labels) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, value := range a {
+		if b[name] != value {
+			return false
+		}
+	}
+	return true
+}
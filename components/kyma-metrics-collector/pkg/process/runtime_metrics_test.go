@@ -0,0 +1,187 @@
+package process
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap/zapcore"
+
+	gocache "github.com/patrickmn/go-cache"
+
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+)
+
+func TestRuntimeMetricsObserveScrape(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	metrics := NewRuntimeMetrics(10)
+	record := newRuntimeRecord()
+	labels := labelsOf(record)
+
+	// when
+	metrics.ObserveScrape(record, 2*time.Second, nil)
+
+	// then
+	g.Expect(testutil.ToFloat64(runtimeScrapeSuccess.With(labels))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(runtimeScrapeDuration.With(labels))).To(gomega.Equal(float64(2)))
+	g.Expect(testutil.ToFloat64(runtimeLastScrape.With(labels))).To(gomega.BeNumerically(">", 0))
+	g.Expect(testutil.ToFloat64(runtimeProvisionedCPUs.With(labels))).To(gomega.Equal(float64(24)))
+	g.Expect(testutil.ToFloat64(runtimeProvisionedRAM.With(labels))).To(gomega.Equal(float64(96)))
+	g.Expect(testutil.ToFloat64(runtimeVolumes.With(labels))).To(gomega.Equal(float64(2)))
+	g.Expect(testutil.ToFloat64(runtimeVolumesSize.With(labels))).To(gomega.Equal(float64(30)))
+	g.Expect(testutil.ToFloat64(runtimeVolumesRounded.With(labels))).To(gomega.Equal(float64(64)))
+	g.Expect(testutil.ToFloat64(runtimeVnets.With(labels))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(runtimeIPs.With(labels))).To(gomega.Equal(float64(2)))
+	g.Expect(testutil.ToFloat64(runtimeVMs.With(vmLabels(labels, "standard_d8_v3")))).To(gomega.Equal(float64(3)))
+
+	// when the next scrape fails
+	metrics.ObserveScrape(record, time.Second, fmt.Errorf("no nodes to process"))
+
+	// then the last known metrics are kept
+	g.Expect(testutil.ToFloat64(runtimeScrapeSuccess.With(labels))).To(gomega.Equal(float64(0)))
+	g.Expect(testutil.ToFloat64(runtimeScrapeDuration.With(labels))).To(gomega.Equal(float64(1)))
+	g.Expect(testutil.ToFloat64(runtimeProvisionedCPUs.With(labels))).To(gomega.Equal(float64(24)))
+
+	metrics.Delete(record.SubAccountID)
+}
+
+func TestRuntimeMetricsRemovesStaleSeries(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	metrics := NewRuntimeMetrics(10)
+	record := newRuntimeRecord()
+	metrics.ObserveScrape(record, time.Second, nil)
+
+	t.Run("VM type not used anymore", func(t *testing.T) {
+		record.Metric = NewMetric()
+		record.Metric.Compute.VMTypes = []edp.VMType{{Name: "standard_d4_v3", Count: 2}}
+
+		metrics.ObserveScrape(record, time.Second, nil)
+
+		g.Expect(countSeries(runtimeVMs, record.SubAccountID)).To(gomega.Equal(1))
+		g.Expect(testutil.ToFloat64(runtimeVMs.With(vmLabels(labelsOf(record), "standard_d4_v3")))).To(gomega.Equal(float64(2)))
+	})
+
+	t.Run("shoot changed", func(t *testing.T) {
+		record.ShootName = "shoot-new"
+
+		metrics.ObserveScrape(record, time.Second, nil)
+
+		g.Expect(countSeries(runtimeProvisionedCPUs, record.SubAccountID)).To(gomega.Equal(1))
+		g.Expect(countSeries(runtimeVMs, record.SubAccountID)).To(gomega.Equal(1))
+		g.Expect(testutil.ToFloat64(runtimeProvisionedCPUs.With(labelsOf(record)))).To(gomega.Equal(float64(24)))
+	})
+
+	t.Run("runtime not tracked anymore", func(t *testing.T) {
+		metrics.Delete(record.SubAccountID)
+
+		g.Expect(countSeries(runtimeProvisionedCPUs, record.SubAccountID)).To(gomega.Equal(0))
+		g.Expect(countSeries(runtimeScrapeSuccess, record.SubAccountID)).To(gomega.Equal(0))
+		g.Expect(countSeries(runtimeVMs, record.SubAccountID)).To(gomega.Equal(0))
+	})
+}
+
+func TestRuntimeMetricsLimit(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	metrics := NewRuntimeMetrics(1)
+	first := newRuntimeRecord()
+	second := newRuntimeRecord()
+	dropped := testutil.ToFloat64(runtimesDropped)
+
+	// when
+	metrics.ObserveScrape(first, time.Second, nil)
+	metrics.ObserveScrape(second, time.Second, nil)
+	metrics.ObserveScrape(first, time.Second, nil)
+
+	// then
+	g.Expect(countSeries(runtimeProvisionedCPUs, first.SubAccountID)).To(gomega.Equal(1))
+	g.Expect(countSeries(runtimeProvisionedCPUs, second.SubAccountID)).To(gomega.Equal(0))
+	g.Expect(testutil.ToFloat64(runtimesDropped)).To(gomega.Equal(dropped + 1))
+
+	// when the exposed runtime is not tracked anymore
+	metrics.Delete(first.SubAccountID)
+	metrics.ObserveScrape(second, time.Second, nil)
+
+	// then
+	g.Expect(countSeries(runtimeProvisionedCPUs, second.SubAccountID)).To(gomega.Equal(1))
+
+	metrics.Delete(second.SubAccountID)
+}
+
+func TestRuntimeMetricsDisabled(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	var metrics *RuntimeMetrics
+	record := newRuntimeRecord()
+
+	metrics.ObserveScrape(record, time.Second, nil)
+	metrics.Delete(record.SubAccountID)
+
+	g.Expect(countSeries(runtimeProvisionedCPUs, record.SubAccountID)).To(gomega.Equal(0))
+}
+
+func TestRuntimeMetricsOfSubAccountNotTrackable(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	record := newRuntimeRecord()
+	p := Process{
+		Cache:          gocache.New(gocache.NoExpiration, gocache.NoExpiration),
+		Logger:         logger.NewLogger(zapcore.InfoLevel),
+		RuntimeMetrics: NewRuntimeMetrics(10),
+	}
+	p.RuntimeMetrics.ObserveScrape(record, time.Second, nil)
+
+	// when
+	_, _, err := p.getRecordWithOldOrNewMetric(1, record.SubAccountID)
+
+	// then
+	g.Expect(err).To(gomega.MatchError(errorSubAccountIDNotTrackable))
+	g.Expect(countSeries(runtimeProvisionedCPUs, record.SubAccountID)).To(gomega.Equal(0))
+	g.Expect(countSeries(runtimeScrapeSuccess, record.SubAccountID)).To(gomega.Equal(0))
+}
+
+func newRuntimeRecord() kmccache.Record {
+	return kmccache.Record{
+		SubAccountID: uuid.New().String(),
+		RuntimeID:    uuid.New().String(),
+		ShootName:    "shoot-old",
+		Provider:     Azure,
+		Region:       "westeurope",
+		Metric:       NewMetric(),
+	}
+}
+
+func labelsOf(record kmccache.Record) prometheus.Labels {
+	return prometheus.Labels{
+		"subaccount_id": record.SubAccountID,
+		"shoot_name":    record.ShootName,
+		"provider":      record.Provider,
+		"region":        record.Region,
+	}
+}
+
+// countSeries counts the series of the gauge which belong to the subaccount
+func countSeries(gauge *prometheus.GaugeVec, subAccountID string) int {
+	ch := make(chan prometheus.Metric, 100)
+	gauge.Collect(ch)
+	close(ch)
+
+	count := 0
+	for metric := range ch {
+		written := &dto.Metric{}
+		if err := metric.Write(written); err != nil {
+			continue
+		}
+		for _, label := range written.GetLabel() {
+			if label.GetName() == "subaccount_id" && label.GetValue() == subAccountID {
+				count++
+			}
+		}
+	}
+	return count
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	log "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+const recordsPath = "/records/{subAccountID}"
+
+// RecordsHandler exposes the records of the tracked subaccounts with the last collected metric,
+// e.g. to estimate the costs of the runtime based on the live node counts. The kubeconfig is never exposed.
+type RecordsHandler struct {
+	Cache  kmccache.Cache
+	Logger *zap.SugaredLogger
+}
+
+func (h *RecordsHandler) AttachRoutes(router *mux.Router) {
+	router.Path(recordsPath).Methods(http.MethodGet).HandlerFunc(h.getRecord)
+}
+
+func (h *RecordsHandler) getRecord(w http.ResponseWriter, req *http.Request) {
+	subAccountID := mux.Vars(req)["subAccountID"]
+
+	obj, found := h.Cache.Get(subAccountID)
+	if !found {
+		http.Error(w, "record not found", http.StatusNotFound)
+		return
+	}
+	record, ok := obj.(kmccache.Record)
+	if !ok {
+		h.Logger.With(log.KeySubAccountID, subAccountID).With(log.KeyResult, log.ValueFail).Error("bad item from cache, could not cast to a record obj")
+		http.Error(w, "invalid record", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(record); err != nil {
+		h.Logger.With(log.KeySubAccountID, subAccountID).With(log.KeyError, err.Error()).Error("write record")
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
+	"go.uber.org/zap/zapcore"
+
+	kmccache "github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/cache"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/edp"
+	"github.com/kyma-project/control-plane/components/kyma-metrics-collector/pkg/logger"
+)
+
+func TestRecordsHandler(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	cache := kmccache.NewInMemoryCache()
+	cache.Set("sub-account-1", kmccache.Record{
+		SubAccountID: "sub-account-1",
+		RuntimeID:    "runtime-1",
+		ShootName:    "shoot-1",
+		KubeConfig:   "secret-kubeconfig",
+		Metric: &edp.ConsumptionMetrics{
+			Timestamp: "2022-11-10T10:00:00Z",
+			Compute: edp.Compute{
+				VMTypes:         []edp.VMType{{Name: "m5.xlarge", Count: 3}},
+				ProvisionedCpus: 12,
+			},
+		},
+	}, gocache.NoExpiration)
+	cache.Set("sub-account-2", "not a record", gocache.NoExpiration)
+
+	router := mux.NewRouter()
+	handler := RecordsHandler{Cache: cache, Logger: logger.NewLogger(zapcore.InfoLevel)}
+	handler.AttachRoutes(router)
+
+	t.Run("should return the record without kubeconfig", func(t *testing.T) {
+		// when
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/records/sub-account-1", nil))
+
+		// then
+		g.Expect(resp.Code).To(gomega.Equal(http.StatusOK))
+		g.Expect(resp.Body.String()).NotTo(gomega.ContainSubstring("secret-kubeconfig"))
+		var record kmccache.Record
+		g.Expect(json.NewDecoder(resp.Body).Decode(&record)).Should(gomega.BeNil())
+		g.Expect(record.RuntimeID).To(gomega.Equal("runtime-1"))
+		g.Expect(record.Metric.Compute.VMTypes).To(gomega.Equal([]edp.VMType{{Name: "m5.xlarge", Count: 3}}))
+	})
+
+	t.Run("should return not found for unknown subaccount", func(t *testing.T) {
+		// when
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/records/sub-account-3", nil))
+
+		// then
+		g.Expect(resp.Code).To(gomega.Equal(http.StatusNotFound))
+	})
+
+	t.Run("should fail for invalid cache item", func(t *testing.T) {
+		// when
+		resp := httptest.NewRecorder()
+		router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/records/sub-account-2", nil))
+
+		// then
+		g.Expect(resp.Code).To(gomega.Equal(http.StatusInternalServerError))
+	})
+}
@@ -191,6 +191,37 @@ func WithAzureProviderAndFooVMType(shoot *gardencorev1beta1.Shoot) {
 	}
 }
 
+func WithOpenStackProviderAndGC4M16VMs(shoot *gardencorev1beta1.Shoot) {
+	shoot.Spec.Provider = gardencorev1beta1.Provider{
+		Type: "openstack",
+		InfrastructureConfig: &runtime.RawExtension{
+			Raw: []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","floatingPoolName":"FloatingIP-external","networks":{"workers":"10.250.0.0/16"}}`),
+		},
+		Workers: []gardencorev1beta1.Worker{
+			{
+				Name: "cpu-worker-0",
+				Machine: gardencorev1beta1.Machine{
+					Type: "g_c4_m16",
+					Image: &gardencorev1beta1.ShootMachineImage{
+						Name: "gardenlinux",
+					},
+				},
+			},
+		},
+	}
+}
+
+func WithProvider(providerType string) NewShootOpts {
+	return func(shoot *gardencorev1beta1.Shoot) {
+		shoot.Spec.Provider = gardencorev1beta1.Provider{
+			Type: providerType,
+			InfrastructureConfig: &runtime.RawExtension{
+				Raw: []byte(`{}`),
+			},
+		}
+	}
+}
+
 func Get2Nodes() *corev1.NodeList {
 	node1 := GetNode("node1", "Standard_D8_v3")
 	node2 := GetNode("node2", "Standard_D8_v3")
@@ -33,6 +33,8 @@ CREATE TABLE gardener_config
     target_secret varchar(256) NOT NULL,
     disk_type varchar(256),
     worker_cidr varchar(256) NOT NULL,
+    pods_cidr varchar(256),
+    services_cidr varchar(256),
     auto_scaler_min integer NOT NULL,
     auto_scaler_max integer NOT NULL,
     max_surge integer NOT NULL,
@@ -44,6 +46,9 @@ CREATE TABLE gardener_config
     provider_specific_config jsonb,
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
+    additional_worker_node_pools jsonb,
+    cert_issuers jsonb,
+    hibernation_schedules jsonb,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -64,7 +69,8 @@ CREATE TYPE operation_type AS ENUM (
     'UPGRADE_SHOOT',
     'HIBERNATE',
     'PROVISION_NO_INSTALL',
-    'DEPROVISION_NO_INSTALL'
+    'DEPROVISION_NO_INSTALL',
+    'WAKE_UP'
     );
 
 CREATE TABLE operation
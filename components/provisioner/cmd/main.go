@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"sync"
 	"time"
+	// the images do not contain the time zone database required to validate the hibernation schedule locations
+	_ "time/tzdata"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -237,6 +239,8 @@ func main() {
 
 	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient)
 
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.HibernationTimeout, dbsFactory, directorClient, shootClient)
+
 	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
 	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
 	exitOnError(err, "Failed to create Shoot controller.")
@@ -268,6 +272,7 @@ func main() {
 		upgradeQueue,
 		shootUpgradeQueue,
 		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate,
 		cfg.Gardener.ForceAllowPrivilegedContainers)
@@ -293,6 +298,8 @@ func main() {
 
 	hibernationQueue.Run(ctx.Done())
 
+	wakeUpQueue.Run(ctx.Done())
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -348,14 +355,14 @@ func main() {
 	}()
 
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
 	wg.Wait()
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -389,6 +396,8 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 			upgradeQueue.Add(op.ID)
 		case model.Hibernate:
 			hibernationQueue.Add(op.ID)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID)
 		case model.UpgradeShoot:
 			shootUpgradeQueue.Add(op.ID)
 		}
@@ -167,6 +167,24 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
+func (r *Resolver) Runtimes(ctx context.Context, filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, error) {
+	log.Infof("Requested to list Runtimes.")
+
+	if _, err := r.tenantUpdater.GetTenant(ctx); err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	runtimes, err := r.provisioning.ListRuntimes(filter, first, after)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+	log.Infof("Listing Runtimes succeeded, %d of %d Runtimes returned.", len(runtimes.Edges), runtimes.TotalCount)
+
+	return runtimes, nil
+}
+
 func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
@@ -211,6 +229,24 @@ func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gql
 	return status, nil
 }
 
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to wake up runtime : %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpCluster(runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
 func getSubAccount(ctx context.Context) string {
 	subAccount, ok := ctx.Value(middlewares.SubAccountID).(string)
 	if !ok {
@@ -200,6 +200,9 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	shootHibernationQueue := queue.CreateHibernationQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
 	shootHibernationQueue.Run(queueCtx.Done())
 
+	shootWakeUpQueue := queue.CreateWakeUpQueue(testHibernationTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	shootWakeUpQueue.Run(queueCtx.Done())
+
 	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
 	require.NoError(t, err)
 
@@ -252,7 +255,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			inputConverter := provisioning.NewInputConverter(uuidGenerator, provider, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, forceAllowPrivilegedContainers)
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
-			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue)
+			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), installationServiceMockForDeprovisiong, provisioningQueue, provisioningNoInstallQueue, deprovisioningQueue, deprovisioningNoInstallQueue, upgradeQueue, shootUpgradeQueue, shootHibernationQueue, shootWakeUpQueue)
 
 			validator := api.NewValidator()
 
@@ -273,6 +276,8 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 
 			testHibernateRuntime(t, ctx, resolver, dbsFactory, config.runtimeID, shootInterface)
 
+			testWakeUpRuntime(t, ctx, resolver, dbsFactory, config.runtimeID, shootInterface)
+
 			testDeprovisionRuntime(t, ctx, resolver, dbsFactory, config.runtimeID, shootInterface)
 		})
 	}
@@ -531,6 +536,32 @@ func testHibernateRuntime(t *testing.T, ctx context.Context, resolver *api.Resol
 	assert.Equal(t, strings.ToUpper(gqlschema.OperationStateSucceeded.String()), string(operation.State))
 }
 
+func testWakeUpRuntime(t *testing.T, ctx context.Context, resolver *api.Resolver, dbsFactory dbsession.Factory, runtimeID string, shootInterface gardener_apis.ShootInterface) {
+
+	list, err := shootInterface.List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	shoot := &list.Items[0]
+
+	readSession := dbsFactory.NewReadSession()
+
+	// when
+	wakeUpOperation, err := resolver.WakeUpRuntime(ctx, runtimeID)
+	require.NoError(t, err)
+	require.NotEmpty(t, wakeUpOperation.ID)
+
+	// when
+	simulateWakeUp(t, shootInterface, shoot.Name)
+
+	// when
+	// wait for Shoot to update
+	time.Sleep(8 * waitPeriod)
+
+	// assert database content
+	operation, err := readSession.GetOperation(*wakeUpOperation.ID)
+	require.NoError(t, err)
+	assert.Equal(t, strings.ToUpper(gqlschema.OperationStateSucceeded.String()), string(operation.State))
+}
+
 func fixOperationStatusProvisioned(runtimeId, operationId *string) *gqlschema.OperationStatus {
 	return &gqlschema.OperationStatus{
 		ID:        operationId,
@@ -591,6 +622,7 @@ func testOperatorRoleBinding() provisioning2.OperatorRoleBinding {
 func testHibernationTimeouts() queue.HibernationTimeouts {
 	return queue.HibernationTimeouts{
 		WaitingForClusterHibernation: 5 * time.Minute,
+		WaitingForClusterWakeUp:      5 * time.Minute,
 	}
 }
 
@@ -661,6 +693,17 @@ func simulateHibernation(t *testing.T, f gardener_apis.ShootInterface, shootName
 	require.NoError(t, err)
 }
 
+func simulateWakeUp(t *testing.T, f gardener_apis.ShootInterface, shootName string) {
+	s, err := f.Get(context.Background(), shootName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	s.Status.IsHibernated = false
+	s.Status.LastOperation = &gardener_types.LastOperation{State: gardener_types.LastOperationStateSucceeded}
+
+	_, err = f.Update(context.Background(), s, metav1.UpdateOptions{})
+	require.NoError(t, err)
+}
+
 func createKubeconfigSecret(t *testing.T, s v1core.SecretInterface, shootName string) {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
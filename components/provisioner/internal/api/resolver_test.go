@@ -608,6 +608,105 @@ func TestResolver_HibernateRuntime(t *testing.T) {
 	})
 }
 
+func TestResolver_WakeUpRuntime(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+
+	t.Run("Should wake up cluster", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		operationID := "acc5040c-3bb6-47b8-8651-07f6950bd0a7"
+		message := "some message"
+		operationStatus := &gqlschema.OperationStatus{
+			ID:        &operationID,
+			Operation: gqlschema.OperationTypeWakeUp,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: &runtimeID,
+			Message:   &message,
+		}
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(operationStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operationStatus, status)
+	})
+
+	t.Run("Should return error when wake-up fails", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("WakeUpCluster", runtimeID).Return(nil, apperrors.BadRequest("cluster is not hibernated"))
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.WakeUpRuntime(ctx, runtimeID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		require.Empty(t, status)
+	})
+}
+
+func TestResolver_Runtimes(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+
+	t.Run("Should list runtimes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		connection := &gqlschema.RuntimeConnection{TotalCount: 1, PageInfo: &gqlschema.PageInfo{}}
+		provisioningService.On("ListRuntimes", (*gqlschema.RuntimesFilter)(nil), util.IntPtr(10), (*string)(nil)).Return(connection, nil)
+		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, nil, util.IntPtr(10), nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, connection, runtimes)
+	})
+
+	t.Run("Should fail when tenant header is not passed to context", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		ctx := context.Background()
+		tenantUpdater.On("GetTenant", ctx).Return("", apperrors.BadRequest("tenant header is empty"))
+
+		//when
+		runtimes, err := provisioner.Runtimes(ctx, nil, nil, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		require.Nil(t, runtimes)
+		provisioningService.AssertNotCalled(t, "ListRuntimes")
+	})
+}
+
 func oidcInput() *gqlschema.OIDCConfigInput {
 	return &gqlschema.OIDCConfigInput{
 		ClientID:       "9bd05ed7-a930-44e6-8c79-e6defeb2222",
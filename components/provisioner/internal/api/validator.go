@@ -1,9 +1,15 @@
 package api
 
 import (
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -11,6 +17,9 @@ import (
 
 const RuntimeAgent = "compass-runtime-agent"
 
+// Gardener limits the worker pool name to 15 characters
+var workerNodePoolNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,13}[a-z0-9])?$`)
+
 //go:generate mockery -name=Validator
 type Validator interface {
 	ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError
@@ -76,6 +85,14 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateWorkerNodePools(config.AdditionalWorkerNodePools); err != nil {
+		return err
+	}
+
+	if err := v.validateHibernationSchedules(config.HibernationSchedules); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -122,6 +139,122 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateWorkerNodePools(gardenerConfig.AdditionalWorkerNodePools); err != nil {
+		return err
+	}
+
+	for _, pool := range gardenerConfig.AdditionalWorkerNodePools {
+		if err := v.validateOpenStackVolume(nil, pool.VolumeSizeGb, gardenerConfig.Provider); err != nil {
+			return err
+		}
+	}
+
+	if err := v.validateNetworking(gardenerConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateCertIssuers(gardenerConfig.CertIssuers); err != nil {
+		return err
+	}
+
+	if err := v.validateHibernationSchedules(gardenerConfig.HibernationSchedules); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateHibernationSchedules checks the time zones of the schedules, the cron expressions are validated by Gardener
+func (v *validator) validateHibernationSchedules(schedules []*gqlschema.HibernationScheduleInput) apperrors.AppError {
+	for _, schedule := range schedules {
+		if schedule == nil {
+			return apperrors.BadRequest("error: empty hibernation schedule provided")
+		}
+		if util.IsNilOrEmpty(schedule.Start) && util.IsNilOrEmpty(schedule.End) {
+			return apperrors.BadRequest("error: hibernation schedule must define the start or the end")
+		}
+		if util.NotNilOrEmpty(schedule.Location) {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				return apperrors.BadRequest("error: invalid location %q of hibernation schedule", *schedule.Location)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCertIssuers checks the ACME issuers, the certificate service requires the HTTPS server URL and the account email
+func (v *validator) validateCertIssuers(issuers []*gqlschema.CertIssuerInput) apperrors.AppError {
+	names := map[string]bool{}
+	for _, issuer := range issuers {
+		if issuer == nil {
+			return apperrors.BadRequest("error: empty certificate issuer provided")
+		}
+		if issuer.Name == "" {
+			return apperrors.BadRequest("error: empty certificate issuer name provided")
+		}
+		if names[issuer.Name] {
+			return apperrors.BadRequest("error: certificate issuer name %q is not unique", issuer.Name)
+		}
+		names[issuer.Name] = true
+		server, err := url.Parse(issuer.Server)
+		if err != nil || server.Scheme != "https" || server.Host == "" {
+			return apperrors.BadRequest("error: invalid server %q of certificate issuer %q, the server must be an HTTPS URL", issuer.Server, issuer.Name)
+		}
+		if _, err := mail.ParseAddress(issuer.Email); err != nil {
+			return apperrors.BadRequest("error: invalid email %q of certificate issuer %q", issuer.Email, issuer.Name)
+		}
+	}
+	return nil
+}
+
+// validateNetworking checks the pods and the services CIDRs, they cannot overlap with each other and with the worker CIDR
+func (v *validator) validateNetworking(config gqlschema.GardenerConfigInput) apperrors.AppError {
+	networks := map[string]*net.IPNet{}
+	// the worker CIDR is validated by the provider, it is only checked against the overlaps
+	if _, worker, err := net.ParseCIDR(config.WorkerCidr); err == nil {
+		networks["worker"] = worker
+	}
+	for _, cidr := range []struct {
+		name  string
+		value *string
+	}{{"pods", config.PodsCidr}, {"services", config.ServicesCidr}} {
+		if !util.NotNilOrEmpty(cidr.value) {
+			continue
+		}
+		_, network, err := net.ParseCIDR(*cidr.value)
+		if err != nil {
+			return apperrors.BadRequest("error: invalid %s CIDR %q", cidr.name, *cidr.value)
+		}
+		for name, other := range networks {
+			if other.Contains(network.IP) || network.Contains(other.IP) {
+				return apperrors.BadRequest("error: %s CIDR %s overlaps with %s CIDR %s", cidr.name, network, name, other)
+			}
+		}
+		networks[cidr.name] = network
+	}
+	return nil
+}
+
+func (v *validator) validateWorkerNodePools(pools []*gqlschema.WorkerNodePoolInput) apperrors.AppError {
+	names := map[string]bool{model.MainWorkerNodePoolName: true}
+	for _, pool := range pools {
+		if pool == nil {
+			return apperrors.BadRequest("error: empty worker node pool provided")
+		}
+		if !workerNodePoolNameRegexp.MatchString(pool.Name) {
+			return apperrors.BadRequest("error: invalid worker node pool name %q, the name must consist of up to 15 lower case alphanumeric characters or '-'", pool.Name)
+		}
+		if names[pool.Name] {
+			return apperrors.BadRequest("error: worker node pool name %q is not unique", pool.Name)
+		}
+		names[pool.Name] = true
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: empty machine type provided for worker node pool %q", pool.Name)
+		}
+		if pool.AutoScalerMin < 0 || pool.AutoScalerMin > pool.AutoScalerMax {
+			return apperrors.BadRequest("error: invalid autoscaler range %d-%d for worker node pool %q", pool.AutoScalerMin, pool.AutoScalerMax, pool.Name)
+		}
+	}
 	return nil
 }
 
@@ -409,3 +409,175 @@ func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput
 	}
 	return clusterConfig, runtimeInput, kymaConfig
 }
+
+func TestValidator_ValidateAdditionalWorkerNodePools(t *testing.T) {
+
+	t.Run("Should return nil when worker node pools are correct", func(t *testing.T) {
+		//given
+		validator := NewValidator()
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerNodePools: []*gqlschema.WorkerNodePoolInput{
+					{Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 0, AutoScalerMax: 3},
+					{Name: "compute", MachineType: "n2-highcpu-16", VolumeSizeGb: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 5},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	for description, pool := range map[string]*gqlschema.WorkerNodePoolInput{
+		"invalid name":             {Name: "Memory_Pool", MachineType: "n2-highmem-8", AutoScalerMax: 3},
+		"too long name":            {Name: "memory-optimized-pool", MachineType: "n2-highmem-8", AutoScalerMax: 3},
+		"main worker node pool":    {Name: "cpu-worker-0", MachineType: "n2-highmem-8", AutoScalerMax: 3},
+		"duplicated name":          {Name: "compute", MachineType: "n2-highmem-8", AutoScalerMax: 3},
+		"empty machine type":       {Name: "memory", AutoScalerMax: 3},
+		"invalid autoscaler range": {Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 4, AutoScalerMax: 3},
+	} {
+		t.Run("Should return error for "+description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					AdditionalWorkerNodePools: []*gqlschema.WorkerNodePoolInput{
+						{Name: "compute", MachineType: "n2-highcpu-16", AutoScalerMin: 1, AutoScalerMax: 5},
+						pool,
+					},
+				},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+}
+
+func TestValidator_ValidateNetworking(t *testing.T) {
+	for description, tc := range map[string]struct {
+		podsCidr     *string
+		servicesCidr *string
+		valid        bool
+	}{
+		"default networking":                {valid: true},
+		"custom networking":                 {podsCidr: util.StringPtr("100.64.0.0/12"), servicesCidr: util.StringPtr("100.104.0.0/13"), valid: true},
+		"invalid pods CIDR":                 {podsCidr: util.StringPtr("100.64.0.0"), valid: false},
+		"pods CIDR overlapping with worker": {podsCidr: util.StringPtr("10.250.128.0/17"), valid: false},
+		"services CIDR overlapping pods":    {podsCidr: util.StringPtr("100.64.0.0/12"), servicesCidr: util.StringPtr("100.64.0.0/13"), valid: false},
+	} {
+		t.Run("Should validate "+description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, _ := initializeConfigs()
+			clusterConfig.GardenerConfig.WorkerCidr = "10.250.0.0/16"
+			clusterConfig.GardenerConfig.PodsCidr = tc.podsCidr
+			clusterConfig.GardenerConfig.ServicesCidr = tc.servicesCidr
+
+			//when
+			err := validator.ValidateProvisioningInput(gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+			})
+
+			//then
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateCertIssuers(t *testing.T) {
+	issuer := func(name, server, email string) *gqlschema.CertIssuerInput {
+		return &gqlschema.CertIssuerInput{Name: name, Server: server, Email: email}
+	}
+	letsEncrypt := "https://acme-v02.api.letsencrypt.org/directory"
+
+	for description, tc := range map[string]struct {
+		issuers []*gqlschema.CertIssuerInput
+		valid   bool
+	}{
+		"no issuers":      {valid: true},
+		"valid issuers":   {issuers: []*gqlschema.CertIssuerInput{issuer("custom", letsEncrypt, "admin@example.com"), issuer("other", letsEncrypt, "admin@example.com")}, valid: true},
+		"empty name":      {issuers: []*gqlschema.CertIssuerInput{issuer("", letsEncrypt, "admin@example.com")}, valid: false},
+		"duplicated name": {issuers: []*gqlschema.CertIssuerInput{issuer("custom", letsEncrypt, "admin@example.com"), issuer("custom", letsEncrypt, "admin@example.com")}, valid: false},
+		"HTTP server":     {issuers: []*gqlschema.CertIssuerInput{issuer("custom", "http://acme.example.com/directory", "admin@example.com")}, valid: false},
+		"invalid server":  {issuers: []*gqlschema.CertIssuerInput{issuer("custom", "acme", "admin@example.com")}, valid: false},
+		"invalid email":   {issuers: []*gqlschema.CertIssuerInput{issuer("custom", letsEncrypt, "admin")}, valid: false},
+		"empty issuer":    {issuers: []*gqlschema.CertIssuerInput{nil}, valid: false},
+	} {
+		t.Run("Should validate "+description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, _ := initializeConfigs()
+			clusterConfig.GardenerConfig.CertIssuers = tc.issuers
+
+			//when
+			err := validator.ValidateProvisioningInput(gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+			})
+
+			//then
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateHibernationSchedules(t *testing.T) {
+	for description, tc := range map[string]struct {
+		schedules []*gqlschema.HibernationScheduleInput
+		valid     bool
+	}{
+		"no schedules":     {valid: true},
+		"valid schedule":   {schedules: []*gqlschema.HibernationScheduleInput{{Start: util.StringPtr("00 20 * * 1-5"), End: util.StringPtr("00 08 * * 1-5"), Location: util.StringPtr("Europe/Berlin")}}, valid: true},
+		"only start":       {schedules: []*gqlschema.HibernationScheduleInput{{Start: util.StringPtr("00 20 * * 5")}}, valid: true},
+		"no start and end": {schedules: []*gqlschema.HibernationScheduleInput{{Location: util.StringPtr("Europe/Berlin")}}, valid: false},
+		"invalid location": {schedules: []*gqlschema.HibernationScheduleInput{{Start: util.StringPtr("00 20 * * 5"), Location: util.StringPtr("Europe/Nowhere")}}, valid: false},
+		"empty schedule":   {schedules: []*gqlschema.HibernationScheduleInput{nil}, valid: false},
+	} {
+		t.Run("Should validate "+description, func(t *testing.T) {
+			//given
+			validator := NewValidator()
+			clusterConfig, runtimeInput, _ := initializeConfigs()
+			clusterConfig.GardenerConfig.HibernationSchedules = tc.schedules
+
+			//when
+			provisioningErr := validator.ValidateProvisioningInput(gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+			})
+			upgradeErr := validator.ValidateUpgradeShootInput(gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{HibernationSchedules: tc.schedules},
+			})
+
+			//then
+			for _, err := range []apperrors.AppError{provisioningErr, upgradeErr} {
+				if tc.valid {
+					require.NoError(t, err)
+				} else {
+					require.Error(t, err)
+					util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+				}
+			}
+		})
+	}
+}
@@ -155,6 +155,40 @@ func (g *GardenerProvisioner) HibernateCluster(clusterID string, gardenerConfig
 	return nil
 }
 
+func (g *GardenerProvisioner) WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError {
+	shoot, err := g.shootClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, gardenerConfig.Name)
+	}
+
+	hibernationEnabled := shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled
+	if !hibernationEnabled && !shoot.Status.IsHibernated {
+		return apperrors.BadRequest(fmt.Sprintf("cannot wake up cluster %s: cluster is not hibernated", clusterID))
+	}
+
+	enabled := false
+	if shoot.Spec.Hibernation != nil {
+		shoot.Spec.Hibernation.Enabled = &enabled
+	} else {
+		shoot.Spec.Hibernation = &v1beta1.Hibernation{
+			Enabled: &enabled,
+		}
+	}
+
+	err = retry.Do(func() error {
+		_, err := g.shootClient.Update(context.Background(), shoot, v1.UpdateOptions{})
+		return err
+	}, retry.Attempts(5))
+
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return apperr.Append("error executing update shoot configuration")
+	}
+
+	return nil
+}
+
 func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, withoutUninstall bool, operationId string) (model.Operation, apperrors.AppError) {
 	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -24,6 +25,8 @@ const (
 	LicenceTypeAnnotation                = "kcp.provisioner.kyma-project.io/licence-type"
 	ShootNetworkingFilterExtensionType   = "shoot-networking-filter"
 	ShootNetworkingFilterDisabledDefault = true
+
+	MainWorkerNodePoolName = "cpu-worker-0"
 )
 
 type OIDCConfig struct {
@@ -65,6 +68,8 @@ type GardenerConfig struct {
 	TargetSecret                        string
 	Region                              string
 	WorkerCidr                          string
+	PodsCidr                            *string
+	ServicesCidr                        *string
 	AutoScalerMin                       int
 	AutoScalerMax                       int
 	MaxSurge                            int
@@ -78,6 +83,37 @@ type GardenerConfig struct {
 	ExposureClassName                   *string
 	ShootNetworkingFilterDisabled       *bool
 	ControlPlaneFailureTolerance        *string
+	AdditionalWorkerNodePools           []WorkerNodePool      `db:"-"`
+	CertIssuers                         []CertIssuer          `db:"-"`
+	HibernationSchedules                []HibernationSchedule `db:"-"`
+}
+
+// HibernationSchedule describes the window in which the shoot is hibernated, Gardener hibernates the shoot
+// at the start and wakes it up at the end cron expressions evaluated in the location time zone.
+type HibernationSchedule struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
+}
+
+// WorkerNodePool describes the worker node pool created next to the main worker node pool.
+// The pool uses the machine image, the disk type, and the zones of the main worker node pool.
+type WorkerNodePool struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	VolumeSizeGB  *int   `json:"volumeSizeGB,omitempty"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
+// CertIssuer describes the ACME issuer of the Gardener certificate service created in the shoot.
+// The private key secret of the existing ACME account must be present in the Gardener project.
+type CertIssuer struct {
+	Name                 string   `json:"name"`
+	Server               string   `json:"server"`
+	Email                string   `json:"email"`
+	PrivateKeySecretName *string  `json:"privateKeySecretName,omitempty"`
+	Domains              []string `json:"domains,omitempty"`
 }
 
 type ExtensionProviderConfig struct {
@@ -87,6 +123,8 @@ type ExtensionProviderConfig struct {
 	DNSProviderReplication *DNSProviderReplication `json:"dnsProviderReplication,omitempty"`
 	// ShootIssuers indicates whether shoot Issuers are on
 	ShootIssuers *ShootIssuers `json:"shootIssuers,omitempty"`
+	// Issuers are the ACME issuers created by the certificate service
+	Issuers []CertIssuerConfig `json:"issuers,omitempty"`
 	// Kind is extension type
 	Kind string `json:"kind"`
 }
@@ -101,6 +139,19 @@ type ShootIssuers struct {
 	Enabled bool `json:"enabled"`
 }
 
+type CertIssuerConfig struct {
+	Name                 string                   `json:"name"`
+	Server               string                   `json:"server"`
+	Email                string                   `json:"email"`
+	PrivateKeySecretName *string                  `json:"privateKeySecretName,omitempty"`
+	Domains              *CertIssuerDomainsConfig `json:"domains,omitempty"`
+}
+
+type CertIssuerDomainsConfig struct {
+	// Include are the domains the issuer is restricted to
+	Include []string `json:"include"`
+}
+
 func NewDNSConfig() *ExtensionProviderConfig {
 	return &ExtensionProviderConfig{
 		ApiVersion:             "service.dns.extensions.gardener.cloud/v1alpha1",
@@ -109,12 +160,45 @@ func NewDNSConfig() *ExtensionProviderConfig {
 	}
 }
 
-func NewCertConfig() *ExtensionProviderConfig {
-	return &ExtensionProviderConfig{
+func NewCertConfig(issuers []CertIssuer) *ExtensionProviderConfig {
+	config := &ExtensionProviderConfig{
 		ApiVersion:   "service.cert.extensions.gardener.cloud/v1alpha1",
 		ShootIssuers: &ShootIssuers{Enabled: true},
 		Kind:         "CertConfig",
 	}
+	for _, issuer := range issuers {
+		issuerConfig := CertIssuerConfig{
+			Name:                 issuer.Name,
+			Server:               issuer.Server,
+			Email:                issuer.Email,
+			PrivateKeySecretName: issuer.PrivateKeySecretName,
+		}
+		if len(issuer.Domains) > 0 {
+			issuerConfig.Domains = &CertIssuerDomainsConfig{Include: issuer.Domains}
+		}
+		config.Issuers = append(config.Issuers, issuerConfig)
+	}
+	return config
+}
+
+// certIssuerResources references the private key secrets of the issuers, the certificate service
+// reads the secrets referenced in the shoot resources
+func certIssuerResources(issuers []CertIssuer) []gardener_types.NamedResourceReference {
+	var resources []gardener_types.NamedResourceReference
+	for _, issuer := range issuers {
+		if util.IsNilOrEmpty(issuer.PrivateKeySecretName) {
+			continue
+		}
+		resources = append(resources, gardener_types.NamedResourceReference{
+			Name: *issuer.PrivateKeySecretName,
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       *issuer.PrivateKeySecretName,
+			},
+		})
+	}
+	return resources
 }
 
 func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subAccountId string, oidcConfig *OIDCConfig, dnsInputConfig *DNSConfig) (*gardener_types.Shoot, apperrors.AppError) {
@@ -147,7 +231,7 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 		return nil, apperrors.Internal("error encoding DNS extension config: %s", encodingErr.Error())
 	}
 
-	certConfig := NewCertConfig()
+	certConfig := NewCertConfig(c.CertIssuers)
 	jsonCertConfig, encodingErr := json.Marshal(certConfig)
 	if encodingErr != nil {
 		return nil, apperrors.Internal("error encoding Cert extension config: %s", encodingErr.Error())
@@ -187,8 +271,10 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				},
 			},
 			Networking: gardener_types.Networking{
-				Type:  "calico", // Default value - we may consider adding it to API (if Hydroform will support it)
-				Nodes: util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c)),
+				Type:     "calico", // Default value - we may consider adding it to API (if Hydroform will support it)
+				Nodes:    util.StringPtr(c.GardenerProviderConfig.NodeCIDR(c)),
+				Pods:     c.PodsCidr,
+				Services: c.ServicesCidr,
 			},
 			Purpose:           purpose,
 			ExposureClassName: exposureClassName,
@@ -205,6 +291,8 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				{Type: ShootNetworkingFilterExtensionType, Disabled: util.DefaultBoolIfNil(c.ShootNetworkingFilterDisabled, util.BoolPtr(ShootNetworkingFilterDisabledDefault))},
 			},
 			ControlPlane: controlPlane,
+			Resources:    certIssuerResources(c.CertIssuers),
+			Hibernation:  gardenerHibernation(c.HibernationSchedules),
 		},
 	}
 
@@ -216,6 +304,25 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 	return shoot, nil
 }
 
+func gardenerHibernation(schedules []HibernationSchedule) *gardener_types.Hibernation {
+	if len(schedules) == 0 {
+		return nil
+	}
+	return &gardener_types.Hibernation{Schedules: gardenerHibernationSchedules(schedules)}
+}
+
+func gardenerHibernationSchedules(schedules []HibernationSchedule) []gardener_types.HibernationSchedule {
+	result := make([]gardener_types.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		result = append(result, gardener_types.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return result
+}
+
 func gardenerOidcConfig(oidcConfig *OIDCConfig) *gardener_types.OIDCConfig {
 	if oidcConfig != nil {
 		return &gardener_types.OIDCConfig{
@@ -360,7 +467,7 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = "gcp"
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -508,7 +615,7 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -607,7 +714,7 @@ func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoo
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkersConfig(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -672,7 +779,7 @@ func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig,
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 	shoot.Spec.CloudProfileName = c.input.CloudProfileName
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkersConfig(gardenerConfig, c.input.Zones)
 
 	openStackInfra := NewOpenStackInfrastructure(c.input.FloatingPoolName, gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(openStackInfra)
@@ -696,27 +803,87 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 	return nil
 }
 
-func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
+func getWorkersConfig(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	mainPool := WorkerNodePool{
+		Name:          MainWorkerNodePoolName,
+		MachineType:   gardenerConfig.MachineType,
+		VolumeSizeGB:  gardenerConfig.VolumeSizeGB,
+		AutoScalerMin: gardenerConfig.AutoScalerMin,
+		AutoScalerMax: gardenerConfig.AutoScalerMax,
+	}
+
+	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, mainPool, zones)}
+	for _, pool := range gardenerConfig.AdditionalWorkerNodePools {
+		workers = append(workers, getWorkerConfig(gardenerConfig, pool, zones))
+	}
+
+	return workers
+}
+
+func getWorkerConfig(gardenerConfig GardenerConfig, pool WorkerNodePool, zones []string) gardener_types.Worker {
+	machine := getMachineConfig(gardenerConfig)
+	machine.Type = pool.MachineType
+
 	worker := gardener_types.Worker{
-		Name:           "cpu-worker-0",
+		Name:           pool.Name,
 		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxSurge)),
 		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxUnavailable)),
-		Machine:        getMachineConfig(gardenerConfig),
-		Maximum:        int32(gardenerConfig.AutoScalerMax),
-		Minimum:        int32(gardenerConfig.AutoScalerMin),
+		Machine:        machine,
+		Maximum:        int32(pool.AutoScalerMax),
+		Minimum:        int32(pool.AutoScalerMin),
 		Zones:          zones,
 	}
 
-	if gardenerConfig.DiskType != nil && gardenerConfig.VolumeSizeGB != nil {
+	volumeSizeGB := pool.VolumeSizeGB
+	if volumeSizeGB == nil {
+		volumeSizeGB = gardenerConfig.VolumeSizeGB
+	}
+	if gardenerConfig.DiskType != nil && volumeSizeGB != nil {
 		worker.Volume = &gardener_types.Volume{
 			Type:       gardenerConfig.DiskType,
-			VolumeSize: fmt.Sprintf("%dGi", *gardenerConfig.VolumeSizeGB),
+			VolumeSize: fmt.Sprintf("%dGi", *volumeSizeGB),
 		}
 	}
 
 	return worker
 }
 
+// updateAdditionalWorkers replaces the additional workers of the shoot with the given worker node pools.
+// The settings of the existing workers which are not managed by the provisioner are preserved.
+func updateAdditionalWorkers(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) {
+	mainWorker := shoot.Spec.Provider.Workers[0]
+	existing := make(map[string]gardener_types.Worker)
+	for _, worker := range shoot.Spec.Provider.Workers[1:] {
+		existing[worker.Name] = worker
+	}
+
+	workers := []gardener_types.Worker{mainWorker}
+	for _, pool := range upgradeConfig.AdditionalWorkerNodePools {
+		worker, found := existing[pool.Name]
+		if !found {
+			worker = *mainWorker.DeepCopy()
+			worker.Name = pool.Name
+		}
+		worker.Machine.Type = pool.MachineType
+		if mainWorker.Machine.Image != nil {
+			worker.Machine.Image = mainWorker.Machine.Image.DeepCopy()
+		}
+		worker.Minimum = int32(pool.AutoScalerMin)
+		worker.Maximum = int32(pool.AutoScalerMax)
+		worker.MaxSurge = mainWorker.MaxSurge
+		worker.MaxUnavailable = mainWorker.MaxUnavailable
+		if worker.Volume != nil && mainWorker.Volume != nil {
+			worker.Volume.Type = mainWorker.Volume.Type
+		}
+		if pool.VolumeSizeGB != nil && worker.Volume != nil {
+			worker.Volume.VolumeSize = fmt.Sprintf("%dGi", *pool.VolumeSizeGB)
+		}
+		workers = append(workers, worker)
+	}
+
+	shoot.Spec.Provider.Workers = workers
+}
+
 func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
 
 	if upgradeConfig.KubernetesVersion != "" {
@@ -755,6 +922,16 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 	if util.NotNilOrEmpty(upgradeConfig.MachineImageVersion) {
 		shoot.Spec.Provider.Workers[0].Machine.Image.Version = upgradeConfig.MachineImageVersion
 	}
+	if upgradeConfig.AdditionalWorkerNodePools != nil {
+		updateAdditionalWorkers(upgradeConfig, shoot)
+	}
+	if upgradeConfig.HibernationSchedules != nil {
+		// the hibernation state set by the hibernation operation is not changed
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardener_types.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Schedules = gardenerHibernationSchedules(upgradeConfig.HibernationSchedules)
+	}
 	if upgradeConfig.OIDCConfig != nil {
 		if shoot.Spec.Kubernetes.KubeAPIServer == nil {
 			shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{}
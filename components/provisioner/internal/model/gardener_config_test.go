@@ -9,6 +9,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -607,6 +608,125 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 
 }
 
+func TestGardenerConfig_ToShootTemplateWithAdditionalWorkerNodePools(t *testing.T) {
+	// given
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+	gardenerConfig.AdditionalWorkerNodePools = []WorkerNodePool{
+		{Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 0, AutoScalerMax: 4},
+		{Name: "compute", MachineType: "n2-highcpu-16", VolumeSizeGB: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 10},
+	}
+
+	memoryWorker := fixWorker(zones)
+	memoryWorker.Name = "memory"
+	memoryWorker.Machine.Type = "n2-highmem-8"
+	memoryWorker.Minimum = 0
+	memoryWorker.Maximum = 4
+
+	computeWorker := fixWorker(zones)
+	computeWorker.Name = "compute"
+	computeWorker.Machine.Type = "n2-highcpu-16"
+	computeWorker.Volume.VolumeSize = "80Gi"
+	computeWorker.Minimum = 1
+	computeWorker.Maximum = 10
+
+	// when
+	template, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, appErr)
+	assert.Equal(t, []gardener_types.Worker{fixWorker(zones), memoryWorker, computeWorker}, template.Spec.Provider.Workers)
+}
+
+func TestGardenerConfig_ToShootTemplateWithNetworking(t *testing.T) {
+	// given
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+	gardenerConfig.PodsCidr = util.StringPtr("100.64.0.0/12")
+	gardenerConfig.ServicesCidr = util.StringPtr("100.104.0.0/13")
+
+	// when
+	template, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, appErr)
+	assert.Equal(t, util.StringPtr("100.64.0.0/12"), template.Spec.Networking.Pods)
+	assert.Equal(t, util.StringPtr("100.104.0.0/13"), template.Spec.Networking.Services)
+}
+
+func TestGardenerConfig_ToShootTemplateWithCertIssuers(t *testing.T) {
+	// given
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+	gardenerConfig.CertIssuers = []CertIssuer{
+		{
+			Name:                 "custom-domain",
+			Server:               "https://acme-v02.api.letsencrypt.org/directory",
+			Email:                "admin@example.com",
+			PrivateKeySecretName: util.StringPtr("acme-account-key"),
+			Domains:              []string{"example.com"},
+		},
+		{Name: "staging", Server: "https://acme-staging-v02.api.letsencrypt.org/directory", Email: "admin@example.com"},
+	}
+
+	// when
+	template, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, appErr)
+	require.Len(t, template.Spec.Extensions, 3)
+	assert.Equal(t, "shoot-cert-service", template.Spec.Extensions[1].Type)
+	assert.JSONEq(t, `{
+		"apiVersion":"service.cert.extensions.gardener.cloud/v1alpha1",
+		"shootIssuers":{"enabled":true},
+		"issuers":[
+			{"name":"custom-domain","server":"https://acme-v02.api.letsencrypt.org/directory","email":"admin@example.com","privateKeySecretName":"acme-account-key","domains":{"include":["example.com"]}},
+			{"name":"staging","server":"https://acme-staging-v02.api.letsencrypt.org/directory","email":"admin@example.com"}
+		],
+		"kind":"CertConfig"}`, string(template.Spec.Extensions[1].ProviderConfig.Raw))
+	assert.Equal(t, []gardener_types.NamedResourceReference{
+		{
+			Name: "acme-account-key",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{
+				APIVersion: "v1",
+				Kind:       "Secret",
+				Name:       "acme-account-key",
+			},
+		},
+	}, template.Spec.Resources)
+}
+
+func TestGardenerConfig_ToShootTemplateWithHibernationSchedules(t *testing.T) {
+	// given
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	gardenerConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+	gardenerConfig.HibernationSchedules = []HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+		{Start: util.StringPtr("00 20 * * 5")},
+	}
+
+	// when
+	template, appErr := gardenerConfig.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, appErr)
+	assert.Equal(t, &gardener_types.Hibernation{
+		Schedules: []gardener_types.HibernationSchedule{
+			{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+			{Start: util.StringPtr("00 20 * * 5")},
+		},
+	}, template.Spec.Hibernation)
+}
+
 func TestEditShootConfig(t *testing.T) {
 	zones := []string{"fix-zone-1", "fix-zone-2"}
 
@@ -665,6 +785,89 @@ func TestEditShootConfig(t *testing.T) {
 			initialShoot:  initialShoot.DeepCopy(),
 			expectedShoot: expectedShoot.DeepCopy(),
 		},
+		{description: "should replace additional worker node pools",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.AdditionalWorkerNodePools = []WorkerNodePool{
+					{Name: "compute", MachineType: "compute-machine", VolumeSizeGB: util.IntPtr(50), AutoScalerMin: 2, AutoScalerMax: 5},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, testkit.NewTestWorker("memory").ToWorker())
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("compute").
+						WithMachineType("compute-machine").
+						WithMachineImageAndVersion("gardenlinux", "25.0.0").
+						WithVolume("SSD", 50).
+						WithMinMax(2, 5).
+						WithMaxSurge(30).
+						WithMaxUnavailable(1).
+						ToWorker())
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should update existing additional worker node pool",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.AdditionalWorkerNodePools = []WorkerNodePool{
+					{Name: "memory", MachineType: "memory-machine", AutoScalerMin: 0, AutoScalerMax: 2},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").WithVolume("standard", 80).WithZones("zone-a").ToWorker())
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers,
+					testkit.NewTestWorker("memory").
+						WithMachineType("memory-machine").
+						WithMachineImageAndVersion("gardenlinux", "25.0.0").
+						WithVolume("SSD", 80).
+						WithMinMax(0, 2).
+						WithMaxSurge(30).
+						WithMaxUnavailable(1).
+						WithZones("zone-a").
+						ToWorker())
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should replace hibernation schedules and keep hibernation state",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled:   util.BoolPtr(true),
+					Schedules: []gardener_types.HibernationSchedule{{Start: util.StringPtr("00 18 * * *")}},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled: util.BoolPtr(true),
+					Schedules: []gardener_types.HibernationSchedule{
+						{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+					},
+				}
+				return shoot
+			}(expectedShoot),
+		},
 		{description: "should update shoot networking extension",
 			provider: "gcp",
 			upgradeConfig: func(config GardenerConfig) GardenerConfig {
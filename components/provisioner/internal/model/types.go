@@ -28,6 +28,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -53,6 +54,7 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
@@ -113,3 +115,14 @@ type HibernationStatus struct {
 	Hibernated          bool
 	HibernationPossible bool
 }
+
+// RuntimesFilter narrows down the listed Runtimes, the empty fields are not used for filtering
+type RuntimesFilter struct {
+	Tenant             string
+	Provider           string
+	Region             string
+	KubernetesVersion  string
+	LastOperationState OperationState
+	Hibernated         *bool
+	RuntimeIDs         []string
+}
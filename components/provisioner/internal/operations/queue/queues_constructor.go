@@ -56,6 +56,7 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
 }
 
 func CreateProvisioningQueue(
@@ -266,3 +267,26 @@ func CreateHibernationQueue(
 
 	return NewQueue(hibernateClusterExecutor)
 }
+
+func CreateWakeUpQueue(
+	timeouts HibernationTimeouts,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: waitForWakeUp,
+	}
+
+	wakeUpClusterExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+	)
+
+	return NewQueue(wakeUpClusterExecutor)
+}
@@ -92,6 +92,8 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		Seed:                                &config.Seed,
 		TargetSecret:                        &config.TargetSecret,
 		WorkerCidr:                          &config.WorkerCidr,
+		PodsCidr:                            config.PodsCidr,
+		ServicesCidr:                        config.ServicesCidr,
 		Region:                              &config.Region,
 		AutoScalerMin:                       &config.AutoScalerMin,
 		AutoScalerMax:                       &config.AutoScalerMax,
@@ -106,9 +108,61 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		ExposureClassName:                   config.ExposureClassName,
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
+		AdditionalWorkerNodePools:           c.workerNodePoolsToGraphQLConfig(config.AdditionalWorkerNodePools),
+		CertIssuers:                         c.certIssuersToGraphQLConfig(config.CertIssuers),
+		HibernationSchedules:                c.hibernationSchedulesToGraphQLConfig(config.HibernationSchedules),
 	}
 }
 
+func (c graphQLConverter) hibernationSchedulesToGraphQLConfig(schedules []model.HibernationSchedule) []*gqlschema.HibernationSchedule {
+	if schedules == nil {
+		return nil
+	}
+	result := make([]*gqlschema.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		result = append(result, &gqlschema.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return result
+}
+
+func (c graphQLConverter) certIssuersToGraphQLConfig(issuers []model.CertIssuer) []*gqlschema.CertIssuer {
+	if issuers == nil {
+		return nil
+	}
+	result := make([]*gqlschema.CertIssuer, 0, len(issuers))
+	for _, issuer := range issuers {
+		result = append(result, &gqlschema.CertIssuer{
+			Name:                 issuer.Name,
+			Server:               issuer.Server,
+			Email:                issuer.Email,
+			PrivateKeySecretName: issuer.PrivateKeySecretName,
+			Domains:              issuer.Domains,
+		})
+	}
+	return result
+}
+
+func (c graphQLConverter) workerNodePoolsToGraphQLConfig(pools []model.WorkerNodePool) []*gqlschema.WorkerNodePool {
+	if pools == nil {
+		return nil
+	}
+	result := make([]*gqlschema.WorkerNodePool, 0, len(pools))
+	for _, pool := range pools {
+		result = append(result, &gqlschema.WorkerNodePool{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			VolumeSizeGb:  pool.VolumeSizeGB,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		})
+	}
+	return result
+}
+
 func (c graphQLConverter) oidcConfigToGraphQLConfig(config *model.OIDCConfig) *gqlschema.OIDCConfig {
 	if config == nil {
 		return nil
@@ -179,6 +233,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
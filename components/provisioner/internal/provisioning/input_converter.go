@@ -112,6 +112,8 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		DiskType:                            input.DiskType,
 		VolumeSizeGB:                        input.VolumeSizeGb,
 		WorkerCidr:                          input.WorkerCidr,
+		PodsCidr:                            input.PodsCidr,
+		ServicesCidr:                        input.ServicesCidr,
 		AutoScalerMin:                       input.AutoScalerMin,
 		AutoScalerMax:                       input.AutoScalerMax,
 		MaxSurge:                            input.MaxSurge,
@@ -128,6 +130,9 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ExposureClassName:                   input.ExposureClassName,
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
+		AdditionalWorkerNodePools:           workerNodePoolsFromInput(input.AdditionalWorkerNodePools),
+		CertIssuers:                         certIssuersFromInput(input.CertIssuers),
+		HibernationSchedules:                hibernationSchedulesFromInput(input.HibernationSchedules),
 	}, nil
 }
 
@@ -145,6 +150,55 @@ func oidcConfigFromInput(config *gqlschema.OIDCConfigInput) *model.OIDCConfig {
 	return nil
 }
 
+func workerNodePoolsFromInput(input []*gqlschema.WorkerNodePoolInput) []model.WorkerNodePool {
+	if input == nil {
+		return nil
+	}
+	pools := make([]model.WorkerNodePool, 0, len(input))
+	for _, pool := range input {
+		pools = append(pools, model.WorkerNodePool{
+			Name:          pool.Name,
+			MachineType:   pool.MachineType,
+			VolumeSizeGB:  pool.VolumeSizeGb,
+			AutoScalerMin: pool.AutoScalerMin,
+			AutoScalerMax: pool.AutoScalerMax,
+		})
+	}
+	return pools
+}
+
+func certIssuersFromInput(input []*gqlschema.CertIssuerInput) []model.CertIssuer {
+	if input == nil {
+		return nil
+	}
+	issuers := make([]model.CertIssuer, 0, len(input))
+	for _, issuer := range input {
+		issuers = append(issuers, model.CertIssuer{
+			Name:                 issuer.Name,
+			Server:               issuer.Server,
+			Email:                issuer.Email,
+			PrivateKeySecretName: issuer.PrivateKeySecretName,
+			Domains:              issuer.Domains,
+		})
+	}
+	return issuers
+}
+
+func hibernationSchedulesFromInput(input []*gqlschema.HibernationScheduleInput) []model.HibernationSchedule {
+	if input == nil {
+		return nil
+	}
+	schedules := make([]model.HibernationSchedule, 0, len(input))
+	for _, schedule := range input {
+		schedules = append(schedules, model.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+	return schedules
+}
+
 func dnsConfigFromInput(input *gqlschema.DNSConfigInput) *model.DNSConfig {
 	config := model.DNSConfig{}
 	if input != nil {
@@ -199,6 +253,8 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		LicenceType:               config.LicenceType,
 		AllowPrivilegedContainers: config.AllowPrivilegedContainers,
 		WorkerCidr:                config.WorkerCidr,
+		PodsCidr:                  config.PodsCidr,
+		ServicesCidr:              config.ServicesCidr,
 
 		Purpose:                             util.DefaultStrIfNil(input.Purpose, config.Purpose),
 		KubernetesVersion:                   util.UnwrapStrOrDefault(input.KubernetesVersion, config.KubernetesVersion),
@@ -217,9 +273,26 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.DefaultStrIfNil(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.DefaultBoolIfNil(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		AdditionalWorkerNodePools:           additionalWorkerNodePoolsForUpgrade(input.AdditionalWorkerNodePools, config.AdditionalWorkerNodePools),
+		CertIssuers:                         config.CertIssuers,
+		HibernationSchedules:                hibernationSchedulesForUpgrade(input.HibernationSchedules, config.HibernationSchedules),
 	}, nil
 }
 
+func additionalWorkerNodePoolsForUpgrade(input []*gqlschema.WorkerNodePoolInput, existing []model.WorkerNodePool) []model.WorkerNodePool {
+	if input == nil {
+		return existing
+	}
+	return workerNodePoolsFromInput(input)
+}
+
+func hibernationSchedulesForUpgrade(input []*gqlschema.HibernationScheduleInput, existing []model.HibernationSchedule) []model.HibernationSchedule {
+	if input == nil {
+		return existing
+	}
+	return hibernationSchedulesFromInput(input)
+}
+
 func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
 	if input == nil {
 		return nil, apperrors.Internal("provider config not specified")
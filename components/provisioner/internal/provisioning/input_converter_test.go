@@ -921,6 +921,98 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
 			},
 		},
+		{
+			description: "shoot upgrade with additional worker node pools",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.AdditionalWorkerNodePools = []*gqlschema.WorkerNodePoolInput{
+					{Name: "compute", MachineType: "n2-highcpu-16", VolumeSizeGb: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 5},
+				}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				AdditionalWorkerNodePools: []model.WorkerNodePool{
+					{Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 0, AutoScalerMax: 3},
+				},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AutoScalerMin:     1,
+				AutoScalerMax:     2,
+				OIDCConfig:        upgradedOidcConfig(),
+				AdditionalWorkerNodePools: []model.WorkerNodePool{
+					{Name: "compute", MachineType: "n2-highcpu-16", VolumeSizeGB: util.IntPtr(80), AutoScalerMin: 1, AutoScalerMax: 5},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade preserves additional worker node pools",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				AdditionalWorkerNodePools: []model.WorkerNodePool{
+					{Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 0, AutoScalerMax: 3},
+				},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				AdditionalWorkerNodePools: []model.WorkerNodePool{
+					{Name: "memory", MachineType: "n2-highmem-8", AutoScalerMin: 0, AutoScalerMax: 3},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade preserves certificate issuers",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				CertIssuers: []model.CertIssuer{
+					{Name: "custom-domain", Server: "https://acme-v02.api.letsencrypt.org/directory", Email: "admin@example.com"},
+				},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				CertIssuers: []model.CertIssuer{
+					{Name: "custom-domain", Server: "https://acme-v02.api.letsencrypt.org/directory", Email: "admin@example.com"},
+				},
+			},
+		},
+		{
+			description: "shoot upgrade replaces hibernation schedules",
+			upgradeInput: func() gqlschema.UpgradeShootInput {
+				input := newUpgradeShootInputWithNilValues()
+				input.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5")},
+				}
+				return input
+			}(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				HibernationSchedules: []model.HibernationSchedule{
+					{Start: util.StringPtr("00 18 * * *")},
+				},
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				HibernationSchedules: []model.HibernationSchedule{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 08 * * 1,2,3,4,5")},
+				},
+			},
+		},
 	}
 
 	casesWithErrors := []struct {
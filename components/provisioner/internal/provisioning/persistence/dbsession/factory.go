@@ -27,6 +27,9 @@ type ReadSession interface {
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	ListClusters(filter model.RuntimesFilter, after string, limit int) ([]model.Cluster, dberrors.Error)
+	CountClusters(filter model.RuntimesFilter) (int, dberrors.Error)
+	ListLastOperations(runtimeIDs []string) (map[string]model.Operation, dberrors.Error)
 	//TODO:Remove after schema migration
 	GetProviderSpecificConfigsByProvider(provider string) ([]ProviderData, dberrors.Error)
 	GetUpdatedProviderSpecificConfigByID(id string) (string, dberrors.Error)
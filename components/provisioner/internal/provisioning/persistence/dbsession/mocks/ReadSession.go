@@ -16,6 +16,29 @@ type ReadSession struct {
 	mock.Mock
 }
 
+// CountClusters provides a mock function with given fields: filter
+func (_m *ReadSession) CountClusters(filter model.RuntimesFilter) (int, dberrors.Error) {
+	ret := _m.Called(filter)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(model.RuntimesFilter) int); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func(model.RuntimesFilter) dberrors.Error); ok {
+		r1 = rf(filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetCluster provides a mock function with given fields: runtimeID
 func (_m *ReadSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error) {
 	ret := _m.Called(runtimeID)
@@ -248,6 +271,31 @@ func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, dberr
 	return r0, r1
 }
 
+// ListClusters provides a mock function with given fields: filter, after, limit
+func (_m *ReadSession) ListClusters(filter model.RuntimesFilter, after string, limit int) ([]model.Cluster, dberrors.Error) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []model.Cluster
+	if rf, ok := ret.Get(0).(func(model.RuntimesFilter, string, int) []model.Cluster); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Cluster)
+		}
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func(model.RuntimesFilter, string, int) dberrors.Error); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, dberrors.Error) {
 	ret := _m.Called()
@@ -272,3 +320,28 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, dberrors.E
 
 	return r0, r1
 }
+
+// ListLastOperations provides a mock function with given fields: runtimeIDs
+func (_m *ReadSession) ListLastOperations(runtimeIDs []string) (map[string]model.Operation, dberrors.Error) {
+	ret := _m.Called(runtimeIDs)
+
+	var r0 map[string]model.Operation
+	if rf, ok := ret.Get(0).(func([]string) map[string]model.Operation); ok {
+		r0 = rf(runtimeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]model.Operation)
+		}
+	}
+
+	var r1 dberrors.Error
+	if rf, ok := ret.Get(1).(func([]string) dberrors.Error); ok {
+		r1 = rf(runtimeIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(dberrors.Error)
+		}
+	}
+
+	return r0, r1
+}
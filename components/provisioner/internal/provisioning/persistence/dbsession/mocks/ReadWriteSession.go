@@ -17,6 +17,29 @@ type ReadWriteSession struct {
 	mock.Mock
 }
 
+// CountClusters provides a mock function with given fields: filter
+func (_m *ReadWriteSession) CountClusters(filter model.RuntimesFilter) (int, apperrors.AppError) {
+	ret := _m.Called(filter)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(model.RuntimesFilter) int); ok {
+		r0 = rf(filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimesFilter) apperrors.AppError); ok {
+		r1 = rf(filter)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -393,6 +416,31 @@ func (_m *ReadWriteSession) InsertRuntimeUpgrade(runtimeUpgrade model.RuntimeUpg
 	return r0
 }
 
+// ListClusters provides a mock function with given fields: filter, after, limit
+func (_m *ReadWriteSession) ListClusters(filter model.RuntimesFilter, after string, limit int) ([]model.Cluster, apperrors.AppError) {
+	ret := _m.Called(filter, after, limit)
+
+	var r0 []model.Cluster
+	if rf, ok := ret.Get(0).(func(model.RuntimesFilter, string, int) []model.Cluster); ok {
+		r0 = rf(filter, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Cluster)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func(model.RuntimesFilter, string, int) apperrors.AppError); ok {
+		r1 = rf(filter, after, limit)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -418,6 +466,31 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListLastOperations provides a mock function with given fields: runtimeIDs
+func (_m *ReadWriteSession) ListLastOperations(runtimeIDs []string) (map[string]model.Operation, apperrors.AppError) {
+	ret := _m.Called(runtimeIDs)
+
+	var r0 map[string]model.Operation
+	if rf, ok := ret.Get(0).(func([]string) map[string]model.Operation); ok {
+		r0 = rf(runtimeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]model.Operation)
+		}
+	}
+
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(1).(func([]string) apperrors.AppError); ok {
+		r1 = rf(runtimeIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -171,10 +171,10 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"cluster.creation_timestamp", "cluster.deleted", "cluster.active_kyma_config_id",
 			"name", "project_name", "kubernetes_version",
 			"volume_size_gb", "disk_type", "machine_type", "machine_image", "machine_image_version",
-			"provider", "purpose", "seed", "target_secret", "worker_cidr", "region", "auto_scaler_min",
+			"provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "allow_privileged_containers", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "additional_worker_node_pools", "cert_issuers", "hibernation_schedules").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
 		Where(dbr.Eq("name", name)).
@@ -193,6 +193,18 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 	if err != nil {
 		return model.Cluster{}, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
 	}
+	err = clusterWithProvider.gardenerConfigRead.DecodeWorkerNodePools()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode additional worker node pools fetched from database: %s", err.Error())
+	}
+	err = clusterWithProvider.gardenerConfigRead.DecodeCertIssuers()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode certificate issuers fetched from database: %s", err.Error())
+	}
+	err = clusterWithProvider.gardenerConfigRead.DecodeHibernationSchedules()
+	if err != nil {
+		return model.Cluster{}, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+	}
 	cluster.ClusterConfig = clusterWithProvider.gardenerConfigRead.GardenerConfig
 
 	if cluster.ActiveKymaConfigId != nil {
@@ -333,7 +345,10 @@ func (r readSession) getClusterAdministrator(runtimeID string) ([]model.ClusterA
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig   string  `db:"provider_specific_config"`
+	WorkerNodePools          *string `db:"additional_worker_node_pools"`
+	CertIssuersJSON          *string `db:"cert_issuers"`
+	HibernationSchedulesJSON *string `db:"hibernation_schedules"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -346,17 +361,53 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	return nil
 }
 
+func (gcr *gardenerConfigRead) DecodeWorkerNodePools() error {
+	if gcr.WorkerNodePools == nil {
+		return nil
+	}
+
+	err := json.Unmarshal([]byte(*gcr.WorkerNodePools), &gcr.AdditionalWorkerNodePools)
+	if err != nil {
+		return fmt.Errorf("error decoding additional worker node pools: %s", err.Error())
+	}
+	return nil
+}
+
+func (gcr *gardenerConfigRead) DecodeCertIssuers() error {
+	if gcr.CertIssuersJSON == nil {
+		return nil
+	}
+
+	err := json.Unmarshal([]byte(*gcr.CertIssuersJSON), &gcr.CertIssuers)
+	if err != nil {
+		return fmt.Errorf("error decoding certificate issuers: %s", err.Error())
+	}
+	return nil
+}
+
+func (gcr *gardenerConfigRead) DecodeHibernationSchedules() error {
+	if gcr.HibernationSchedulesJSON == nil {
+		return nil
+	}
+
+	err := json.Unmarshal([]byte(*gcr.HibernationSchedulesJSON), &gcr.HibernationSchedules)
+	if err != nil {
+		return fmt.Errorf("error decoding hibernation schedules: %s", err.Error())
+	}
+	return nil
+}
+
 func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig, dberrors.Error) {
 	gardenerConfig := gardenerConfigRead{}
 
 	err := r.session.
 		Select("gardener_config.id", "cluster_id", "gardener_config.name", "project_name",
 			"kubernetes_version", "volume_size_gb", "disk_type", "machine_type", "machine_image",
-			"machine_image_version", "provider", "purpose", "seed", "target_secret", "worker_cidr", "region",
+			"machine_image_version", "provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region",
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"allow_privileged_containers", "exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "additional_worker_node_pools", "cert_issuers", "hibernation_schedules").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -374,6 +425,18 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 	if err != nil {
 		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
 	}
+	err = gardenerConfig.DecodeWorkerNodePools()
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode additional worker node pools fetched from database: %s", err.Error())
+	}
+	err = gardenerConfig.DecodeCertIssuers()
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode certificate issuers fetched from database: %s", err.Error())
+	}
+	err = gardenerConfig.DecodeHibernationSchedules()
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+	}
 
 	return gardenerConfig.GardenerConfig, nil
 }
@@ -494,6 +557,151 @@ func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberror
 	return operationsCount, nil
 }
 
+// ListClusters returns the page of the not deleted clusters matching the filter ordered by the creation timestamp,
+// the page starts after the cluster with the given ID, the clusters contain only the Gardener config without the kubeconfig
+func (r readSession) ListClusters(filter model.RuntimesFilter, after string, limit int) ([]model.Cluster, dberrors.Error) {
+	var clustersWithProvider []struct {
+		model.Cluster
+		gardenerConfigRead
+	}
+
+	query := r.clustersQuery(filter,
+		"cluster.id", "cluster.tenant", "cluster.sub_account_id",
+		"cluster.creation_timestamp", "cluster.deleted", "cluster.active_kyma_config_id",
+		"gardener_config.name", "project_name", "kubernetes_version",
+		"volume_size_gb", "disk_type", "machine_type", "machine_image", "machine_image_version",
+		"provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region", "auto_scaler_min",
+		"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
+		"enable_machine_image_version_auto_update", "allow_privileged_containers", "exposure_class_name", "provider_specific_config",
+		"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "additional_worker_node_pools", "cert_issuers", "hibernation_schedules")
+	if after != "" {
+		query = query.Where("(cluster.creation_timestamp, cluster.id) > (SELECT creation_timestamp, id FROM cluster WHERE id = ?)", after)
+	}
+
+	_, err := query.
+		OrderBy("cluster.creation_timestamp").
+		OrderBy("cluster.id").
+		Limit(uint64(limit)).
+		Load(&clustersWithProvider)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list Clusters: %s", err)
+	}
+
+	clusters := make([]model.Cluster, 0, len(clustersWithProvider))
+	for _, clusterWithProvider := range clustersWithProvider {
+		err = clusterWithProvider.gardenerConfigRead.DecodeProviderConfig()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode Gardener provider config fetched from database: %s", err.Error())
+		}
+		err = clusterWithProvider.gardenerConfigRead.DecodeWorkerNodePools()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode additional worker node pools fetched from database: %s", err.Error())
+		}
+		err = clusterWithProvider.gardenerConfigRead.DecodeCertIssuers()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode certificate issuers fetched from database: %s", err.Error())
+		}
+		err = clusterWithProvider.gardenerConfigRead.DecodeHibernationSchedules()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode hibernation schedules fetched from database: %s", err.Error())
+		}
+
+		cluster := clusterWithProvider.Cluster
+		cluster.ClusterConfig = clusterWithProvider.gardenerConfigRead.GardenerConfig
+		cluster.ClusterConfig.ClusterID = cluster.ID
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+// CountClusters returns the number of the not deleted clusters matching the filter
+func (r readSession) CountClusters(filter model.RuntimesFilter) (int, dberrors.Error) {
+	var count int
+
+	err := r.clustersQuery(filter, "count(*)").LoadOne(&count)
+	if err != nil {
+		return 0, dberrors.Internal("Failed to count Clusters: %s", err)
+	}
+
+	return count, nil
+}
+
+func (r readSession) clustersQuery(filter model.RuntimesFilter, columns ...string) *dbr.SelectStmt {
+	query := r.session.
+		Select(columns...).
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.Eq("cluster.deleted", false))
+
+	if filter.Tenant != "" {
+		query = query.Where(dbr.Eq("cluster.tenant", filter.Tenant))
+	}
+	if filter.Provider != "" {
+		query = query.Where(dbr.Eq("gardener_config.provider", filter.Provider))
+	}
+	if filter.Region != "" {
+		query = query.Where(dbr.Eq("gardener_config.region", filter.Region))
+	}
+	if filter.KubernetesVersion != "" {
+		query = query.Where(dbr.Eq("gardener_config.kubernetes_version", filter.KubernetesVersion))
+	}
+	if len(filter.RuntimeIDs) > 0 {
+		query = query.Where(dbr.Eq("cluster.id", filter.RuntimeIDs))
+	}
+
+	if filter.LastOperationState == "" && filter.Hibernated == nil {
+		return query
+	}
+
+	query = query.Join(dbr.I("operation").As("last_operation"),
+		"last_operation.cluster_id=cluster.id AND last_operation.start_timestamp=(SELECT MAX(start_timestamp) FROM operation WHERE operation.cluster_id=cluster.id)")
+	if filter.LastOperationState != "" {
+		query = query.Where(dbr.Eq("last_operation.state", filter.LastOperationState))
+	}
+	if filter.Hibernated != nil {
+		if *filter.Hibernated {
+			query = query.Where(dbr.And(
+				dbr.Eq("last_operation.type", model.Hibernate),
+				dbr.Eq("last_operation.state", model.Succeeded)))
+		} else {
+			query = query.Where(dbr.Or(
+				dbr.Neq("last_operation.type", model.Hibernate),
+				dbr.Neq("last_operation.state", model.Succeeded)))
+		}
+	}
+
+	return query
+}
+
+// ListLastOperations returns the last operations of the clusters mapped by the cluster ID
+func (r readSession) ListLastOperations(runtimeIDs []string) (map[string]model.Operation, dberrors.Error) {
+	lastOperations := make(map[string]model.Operation, len(runtimeIDs))
+	if len(runtimeIDs) == 0 {
+		return lastOperations, nil
+	}
+
+	var operations []model.Operation
+
+	_, err := r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.Eq("cluster_id", runtimeIDs)).
+		Where("start_timestamp=(SELECT MAX(start_timestamp) FROM operation AS cluster_operation WHERE cluster_operation.cluster_id=operation.cluster_id)").
+		Load(&operations)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to list last operations: %s", err)
+	}
+
+	for _, operation := range operations {
+		lastOperations[operation.ClusterID] = operation
+	}
+
+	return lastOperations, nil
+}
+
 func (r readSession) getOidcConfig(gardenerConfigID string) (model.OIDCConfig, dberrors.Error) {
 	var oidc model.OIDCConfig
 	var algorithms []string
@@ -97,6 +97,19 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	workerNodePools, dberr := encodeWorkerNodePools(config.AdditionalWorkerNodePools)
+	if dberr != nil {
+		return dberr
+	}
+	certIssuers, dberr := encodeCertIssuers(config.CertIssuers)
+	if dberr != nil {
+		return dberr
+	}
+	hibernationSchedules, dberr := encodeHibernationSchedules(config.HibernationSchedules)
+	if dberr != nil {
+		return dberr
+	}
+
 	_, err := ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
@@ -115,6 +128,8 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("target_secret", config.TargetSecret).
 		Pair("disk_type", config.DiskType).
 		Pair("worker_cidr", config.WorkerCidr).
+		Pair("pods_cidr", config.PodsCidr).
+		Pair("services_cidr", config.ServicesCidr).
 		Pair("auto_scaler_min", config.AutoScalerMin).
 		Pair("auto_scaler_max", config.AutoScalerMax).
 		Pair("max_surge", config.MaxSurge).
@@ -126,6 +141,9 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Pair("additional_worker_node_pools", workerNodePools).
+		Pair("cert_issuers", certIssuers).
+		Pair("hibernation_schedules", hibernationSchedules).
 		Exec()
 
 	if err != nil {
@@ -209,6 +227,15 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
+	workerNodePools, dberr := encodeWorkerNodePools(config.AdditionalWorkerNodePools)
+	if dberr != nil {
+		return dberr
+	}
+	hibernationSchedules, dberr := encodeHibernationSchedules(config.HibernationSchedules)
+	if dberr != nil {
+		return dberr
+	}
+
 	res, err := ws.update("gardener_config").
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
@@ -229,6 +256,8 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("additional_worker_node_pools", workerNodePools).
+		Set("hibernation_schedules", hibernationSchedules).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -245,6 +274,48 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update record of configuration for gardener shoot cluster '%s' state: %s", config.Name, err))
 }
 
+func encodeWorkerNodePools(pools []model.WorkerNodePool) (*string, dberrors.Error) {
+	if pools == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(pools)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode additional worker node pools: %s", err)
+	}
+
+	result := string(encoded)
+	return &result, nil
+}
+
+func encodeCertIssuers(issuers []model.CertIssuer) (*string, dberrors.Error) {
+	if issuers == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(issuers)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode certificate issuers: %s", err)
+	}
+
+	result := string(encoded)
+	return &result, nil
+}
+
+func encodeHibernationSchedules(schedules []model.HibernationSchedule) (*string, dberrors.Error) {
+	if schedules == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(schedules)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode hibernation schedules: %s", err)
+	}
+
+	result := string(encoded)
+	return &result, nil
+}
+
 func (ws writeSession) updateOidcConfig(config model.GardenerConfig) dberrors.Error {
 	_, err := ws.deleteFrom("oidc_config").
 		Where(dbr.Eq("gardener_config_id", config.ID)).
@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"encoding/base64"
 	"time"
 
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -20,6 +21,11 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	defaultRuntimesPageSize = 50
+	maxRuntimesPageSize     = 100
+)
+
 //go:generate mockery --name=Service
 type Service interface {
 	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
@@ -31,6 +37,8 @@ type Service interface {
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
 	RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	HibernateCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(clusterID string) (*gqlschema.OperationStatus, apperrors.AppError)
+	ListRuntimes(filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
@@ -39,6 +47,7 @@ type Provisioner interface {
 	DeprovisionCluster(cluster model.Cluster, withoutInstallation bool, operationId string) (model.Operation, apperrors.AppError)
 	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
 	HibernateCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	WakeUpCluster(clusterID string, gardenerConfig model.GardenerConfig) apperrors.AppError
 	GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError)
 }
 
@@ -65,6 +74,7 @@ type service struct {
 	upgradeQueue                 queue.OperationQueue
 	shootUpgradeQueue            queue.OperationQueue
 	hibernationQueue             queue.OperationQueue
+	wakeUpQueue                  queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -83,6 +93,7 @@ func NewProvisioningService(
 	upgradeQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
 	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 
 ) Service {
 	return &service{
@@ -99,6 +110,7 @@ func NewProvisioningService(
 		upgradeQueue:                 upgradeQueue,
 		shootUpgradeQueue:            shootUpgradeQueue,
 		hibernationQueue:             hibernationQueue,
+		wakeUpQueue:                  wakeUpQueue,
 		shootProvider:                shootProvider,
 		installationClient:           installationClient,
 	}
@@ -327,6 +339,47 @@ func (r *service) HibernateCluster(runtimeID string) (*gqlschema.OperationStatus
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) WakeUpCluster(runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake-up for Runtime '%s'...", runtimeID)
+
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return nil, apperrors.Internal("Failed to find shoot cluster to wake up in database: %s", dberr.Error())
+	}
+
+	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, gardError := r.setWakeUpStarted(txSession, cluster)
+	if gardError != nil {
+		return nil, apperrors.Internal("Failed to set wake-up started: %s", gardError.Error())
+	}
+
+	err = r.provisioner.WakeUpCluster(cluster.ID, cluster.ClusterConfig)
+	if err != nil {
+		return nil, err.Append("Failed to wake up Cluster")
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return nil, apperrors.Internal("Failed to commit wake-up transaction: %s", dbErr.Error())
+	}
+
+	r.wakeUpQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -437,6 +490,133 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) ListRuntimes(filter *gqlschema.RuntimesFilter, first *int, after *string) (*gqlschema.RuntimeConnection, apperrors.AppError) {
+	limit := util.UnwrapIntOrDefault(first, defaultRuntimesPageSize)
+	if limit < 1 || limit > maxRuntimesPageSize {
+		return nil, apperrors.BadRequest("the number of Runtimes must be between 1 and %d", maxRuntimesPageSize)
+	}
+
+	if filter != nil && filter.LastOperationState != nil && *filter.LastOperationState == gqlschema.OperationStatePending {
+		return nil, apperrors.BadRequest("the Runtimes cannot be filtered by the %s last operation state", gqlschema.OperationStatePending)
+	}
+
+	afterID := ""
+	if after != nil && *after != "" {
+		decoded, err := runtimeIDFromCursor(*after)
+		if err != nil {
+			return nil, apperrors.BadRequest("invalid cursor %q", *after)
+		}
+		afterID = decoded
+	}
+
+	modelFilter := runtimesFilterToModel(filter)
+	session := r.dbSessionFactory.NewReadSession()
+
+	if afterID != "" {
+		// the next page cannot be found when the Runtime of the cursor is removed
+		if _, dberr := session.GetTenant(afterID); dberr != nil {
+			if dberr.Code() == dberrors.CodeNotFound {
+				return nil, apperrors.BadRequest("cursor %q does not point to an existing Runtime", *after)
+			}
+			return nil, dberr.Append("failed to get the Runtime of the cursor")
+		}
+	}
+
+	// one more cluster is fetched to check if there is the next page
+	clusters, dberr := session.ListClusters(modelFilter, afterID, limit+1)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+	totalCount, dberr := session.CountClusters(modelFilter)
+	if dberr != nil {
+		return nil, dberr.Append("failed to count Runtimes")
+	}
+
+	hasNextPage := len(clusters) > limit
+	if hasNextPage {
+		clusters = clusters[:limit]
+	}
+
+	runtimeIDs := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		runtimeIDs = append(runtimeIDs, cluster.ID)
+	}
+	lastOperations, dberr := session.ListLastOperations(runtimeIDs)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list last operations of Runtimes")
+	}
+
+	edges := make([]*gqlschema.RuntimeEdge, 0, len(clusters))
+	for _, cluster := range clusters {
+		lastOperation := lastOperations[cluster.ID]
+		status := r.graphQLConverter.RuntimeStatusToGraphQLStatus(model.RuntimeStatus{
+			LastOperationStatus:  lastOperation,
+			RuntimeConfiguration: cluster,
+			HibernationStatus: model.HibernationStatus{
+				Hibernated: lastOperation.Type == model.Hibernate && lastOperation.State == model.Succeeded,
+			},
+		})
+		// checking if the hibernation is possible requires calling Gardener for every Runtime
+		status.HibernationStatus.HibernationPossible = nil
+
+		edges = append(edges, &gqlschema.RuntimeEdge{
+			Cursor: runtimesCursor(cluster.ID),
+			Node:   status,
+		})
+	}
+
+	pageInfo := &gqlschema.PageInfo{HasNextPage: hasNextPage}
+	if len(edges) > 0 {
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &gqlschema.RuntimeConnection{
+		TotalCount: totalCount,
+		Edges:      edges,
+		PageInfo:   pageInfo,
+	}, nil
+}
+
+// runtimesCursor hides the pagination details from the clients, the Runtimes are paginated by the Runtime ID
+func runtimesCursor(runtimeID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(runtimeID))
+}
+
+func runtimeIDFromCursor(cursor string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func runtimesFilterToModel(filter *gqlschema.RuntimesFilter) model.RuntimesFilter {
+	if filter == nil {
+		return model.RuntimesFilter{}
+	}
+
+	result := model.RuntimesFilter{
+		Tenant:            util.UnwrapStr(filter.Tenant),
+		Provider:          util.UnwrapStr(filter.Provider),
+		Region:            util.UnwrapStr(filter.Region),
+		KubernetesVersion: util.UnwrapStr(filter.KubernetesVersion),
+		Hibernated:        filter.Hibernated,
+		RuntimeIDs:        filter.RuntimeIDs,
+	}
+	if filter.LastOperationState != nil {
+		switch *filter.LastOperationState {
+		case gqlschema.OperationStateInProgress:
+			result.LastOperationState = model.InProgress
+		case gqlschema.OperationStateSucceeded:
+			result.LastOperationState = model.Succeeded
+		case gqlschema.OperationStateFailed:
+			result.LastOperationState = model.Failed
+		}
+	}
+
+	return result
+}
+
 func (r *service) RollBackLastUpgrade(runtimeID string) (*gqlschema.RuntimeStatus, apperrors.AppError) {
 
 	readSession := r.dbSessionFactory.NewReadSession()
@@ -621,6 +801,18 @@ func (r *service) setHibernationStarted(txSession dbsession.WriteSession, curren
 	return operation, nil
 }
 
+func (r *service) setWakeUpStarted(txSession dbsession.WriteSession, currentCluster model.Cluster) (model.Operation, error) {
+	log.Infof("Starting wake-up operation")
+
+	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.WakeUp, model.WaitForWakeUp, time.Now(), "Starting wake-up")
+
+	if dbError != nil {
+		return model.Operation{}, dbError.Append("Failed to start wake-up operation")
+	}
+
+	return operation, nil
+}
+
 func (r *service) setOperationStarted(
 	dbSession dbsession.WriteSession,
 	runtimeID string,
@@ -136,7 +136,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -182,7 +182,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningNoInstallQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningNoInstallQueue, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
@@ -218,7 +218,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -251,7 +251,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
 		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -273,7 +273,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return("", apperrors.Internal("registering error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -308,7 +308,7 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, provisioningQueue, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
@@ -382,7 +382,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -415,7 +415,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		installationClient.On("CheckInstallationState", mock.Anything).Return(errorEmptyState, errors.New("Installation error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -453,7 +453,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -496,7 +496,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 
 		installationClient.On("CheckInstallationState", mock.Anything).Return(notInstalledState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -543,7 +543,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), true, mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, deprovisioningNoInstallQueue, nil, nil, nil, nil)
 
 		// when
 		opID, err := resolver.DeprovisionRuntime(runtimeID)
@@ -570,7 +570,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), false, mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 		installationClient.On("CheckInstallationState", mock.Anything).Return(installedState, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, installationClient, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -595,7 +595,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -619,7 +619,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -642,7 +642,7 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.DeprovisionRuntime(runtimeID)
@@ -678,7 +678,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeOperationStatus(operationID)
@@ -701,7 +701,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeOperationStatus(operationID)
@@ -747,7 +747,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeStatus(operationID)
@@ -769,7 +769,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -788,7 +788,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -810,7 +810,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetCluster", operationID).Return(cluster, nil)
 		provisioner.On("GetHibernationStatus", mock.AnythingOfType("string"), cluster.ClusterConfig).Return(model.HibernationStatus{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -822,6 +822,161 @@ func TestService_RuntimeStatus(t *testing.T) {
 	})
 }
 
+func TestService_ListRuntimes(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, nil, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, forceAllowPrivilegedContainers)
+	graphQLConverter := NewGraphQLConverter()
+
+	firstRuntimeID := "a5a4b44a-1f5c-4d4c-9b5e-7cd4a1d6d0b1"
+	secondRuntimeID := "b7c1c0a9-2f33-4e57-8d6a-0c4ad5f5e0e2"
+	clusters := []model.Cluster{
+		{ID: firstRuntimeID, Tenant: tenant, ClusterConfig: model.GardenerConfig{Provider: "aws", Region: "eu-central-1"}},
+		{ID: secondRuntimeID, Tenant: tenant, ClusterConfig: model.GardenerConfig{Provider: "aws", Region: "eu-central-1"}},
+	}
+	lastOperations := map[string]model.Operation{
+		firstRuntimeID:  {ID: "op-1", Type: model.Hibernate, State: model.Succeeded, ClusterID: firstRuntimeID},
+		secondRuntimeID: {ID: "op-2", Type: model.Provision, State: model.Succeeded, ClusterID: secondRuntimeID},
+	}
+	filter := &gqlschema.RuntimesFilter{
+		Provider:           util.StringPtr("aws"),
+		LastOperationState: func() *gqlschema.OperationState { s := gqlschema.OperationStateSucceeded; return &s }(),
+		RuntimeIDs:         []string{firstRuntimeID, secondRuntimeID},
+	}
+	modelFilter := model.RuntimesFilter{Provider: "aws", LastOperationState: model.Succeeded, RuntimeIDs: []string{firstRuntimeID, secondRuntimeID}}
+
+	t.Run("Should return the first page of runtimes", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListClusters", modelFilter, "", 2).Return(clusters, nil)
+		readSession.On("CountClusters", modelFilter).Return(3, nil)
+		readSession.On("ListLastOperations", []string{firstRuntimeID}).Return(lastOperations, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		runtimes, err := service.ListRuntimes(filter, util.IntPtr(1), nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, 3, runtimes.TotalCount)
+		require.Len(t, runtimes.Edges, 1)
+		assert.Equal(t, firstRuntimeID, *runtimes.Edges[0].Node.LastOperationStatus.RuntimeID)
+		assert.Equal(t, "aws", *runtimes.Edges[0].Node.RuntimeConfiguration.ClusterConfig.Provider)
+		assert.True(t, *runtimes.Edges[0].Node.HibernationStatus.Hibernated)
+		assert.Nil(t, runtimes.Edges[0].Node.HibernationStatus.HibernationPossible)
+		assert.True(t, runtimes.PageInfo.HasNextPage)
+		assert.Equal(t, runtimes.Edges[0].Cursor, *runtimes.PageInfo.EndCursor)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return the next page of runtimes", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenant", firstRuntimeID).Return(tenant, nil)
+		readSession.On("ListClusters", model.RuntimesFilter{}, firstRuntimeID, 51).Return(clusters[1:], nil)
+		readSession.On("CountClusters", model.RuntimesFilter{}).Return(2, nil)
+		readSession.On("ListLastOperations", []string{secondRuntimeID}).Return(lastOperations, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		firstPage := runtimesCursor(firstRuntimeID)
+
+		// when
+		runtimes, err := service.ListRuntimes(nil, nil, &firstPage)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, runtimes.Edges, 1)
+		assert.Equal(t, secondRuntimeID, *runtimes.Edges[0].Node.LastOperationStatus.RuntimeID)
+		assert.False(t, *runtimes.Edges[0].Node.HibernationStatus.Hibernated)
+		assert.False(t, runtimes.PageInfo.HasNextPage)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when the page size is invalid", func(t *testing.T) {
+		// given
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(nil, util.IntPtr(101), nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("Should return error when the cursor is invalid", func(t *testing.T) {
+		// given
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(nil, nil, util.StringPtr("not a cursor!"))
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("Should return error when the Runtime of the cursor does not exist", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetTenant", firstRuntimeID).Return("", dberrors.NotFound("not found"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		cursor := runtimesCursor(firstRuntimeID)
+
+		// when
+		_, err := service.ListRuntimes(nil, nil, &cursor)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		readSession.AssertNotCalled(t, "ListClusters")
+	})
+
+	t.Run("Should return error when filtering by the Pending last operation state", func(t *testing.T) {
+		// given
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		pending := gqlschema.OperationStatePending
+
+		// when
+		_, err := service.ListRuntimes(&gqlschema.RuntimesFilter{LastOperationState: &pending}, nil, nil)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("Should return error when failed to list clusters", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("ListClusters", model.RuntimesFilter{}, "", 51).Return(nil, dberrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(nil, nil, nil)
+
+		// then
+		require.Error(t, err)
+		sessionFactoryMock.AssertExpectations(t)
+		readSession.AssertExpectations(t)
+	})
+}
+
 func TestService_UpgradeRuntime(t *testing.T) {
 	releaseProvider := &releaseMocks.Provider{}
 	releaseProvider.On("GetReleaseByVersion", kymaVersion).Return(kymaRelease, nil)
@@ -939,7 +1094,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider, upgradeQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1029,7 +1184,7 @@ func TestService_UpgradeRuntime(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, writeSession, readSession, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, provisioningQueue, nil, deprovisioningQueue, nil, upgradeQueue, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeRuntime(runtimeID, upgradeInput)
@@ -1143,7 +1298,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1263,7 +1418,7 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
 			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
@@ -1325,7 +1480,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 			Hibernated:          true,
 		}, nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		runtimeStatus, err := service.RollBackLastUpgrade(runtimeID)
@@ -1371,7 +1526,7 @@ func TestService_RollBackLastUpgrade(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.RollBackLastUpgrade(runtimeID)
@@ -1490,7 +1645,7 @@ func TestService_HibernateShoot(t *testing.T) {
 
 			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock, provisioner)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			// when
 			_, err := service.HibernateCluster(runtimeID)
@@ -1522,7 +1677,7 @@ func TestService_HibernateShoot(t *testing.T) {
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		hibernationQueue.On("Add", mock.AnythingOfType("string")).Return()
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue)
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, hibernationQueue, nil)
 
 		// when
 		runtimeStatus, err := service.HibernateCluster(runtimeID)
@@ -1537,6 +1692,131 @@ func TestService_HibernateShoot(t *testing.T) {
 	})
 }
 
+func TestService_WakeUpShoot(t *testing.T) {
+	releaseProvider := &releaseMocks.Provider{}
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), releaseProvider, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate, forceAllowPrivilegedContainers)
+	uuidGenerator := uuid.NewUUIDGenerator()
+	graphQLConverter := NewGraphQLConverter()
+
+	lastOperation := model.Operation{ID: operationID, State: model.Succeeded, Type: model.Hibernate}
+
+	cluster := model.Cluster{
+		ID: runtimeID,
+	}
+
+	timeNow := time.Now()
+	wakeUpOperation := model.Operation{
+		ID:             operationID,
+		Type:           model.WakeUp,
+		StartTimestamp: timeNow,
+		State:          model.InProgress,
+		ClusterID:      runtimeID,
+		Stage:          model.WaitForWakeUp,
+		LastTransition: &timeNow,
+	}
+
+	for _, testCase := range []struct {
+		description string
+		mockFunc    func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSessionWithinTransaction, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner)
+	}{
+		{
+			description: "should fail when operation in progress",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSessionWithinTransaction, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: operationID, State: model.InProgress, Type: model.Hibernate}, nil)
+			},
+		},
+		{
+			description: "should fail when failed to get cluster",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSessionWithinTransaction, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("error"))
+			},
+		},
+		{
+			description: "should fail when failed to wake up cluster",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSessionWithinTransaction, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+				writeSession.On("RollbackUnlessCommitted").Return(nil)
+				provisioner.On("WakeUpCluster", cluster.ID, cluster.ClusterConfig).Return(apperrors.BadRequest("cluster is not hibernated"))
+			},
+		},
+		{
+			description: "should fail when failed to commit transaction",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, writeSession *sessionMocks.WriteSessionWithinTransaction, readSession *sessionMocks.ReadSession, provisioner *mocks2.Provisioner) {
+				sessionFactory.On("NewReadSession").Return(readSession, nil)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+				writeSession.On("RollbackUnlessCommitted").Return(nil)
+				provisioner.On("WakeUpCluster", cluster.ID, cluster.ClusterConfig).Return(nil)
+				writeSession.On("Commit").Return(dberrors.Internal("error"))
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactoryMock := &sessionMocks.Factory{}
+			writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+			readSessionMock := &sessionMocks.ReadSession{}
+			provisioner := &mocks2.Provisioner{}
+
+			testCase.mockFunc(sessionFactoryMock, writeSessionWithinTransactionMock, readSessionMock, provisioner)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			_, err := service.WakeUpCluster(runtimeID)
+			require.Error(t, err)
+
+			// then
+			sessionFactoryMock.AssertExpectations(t)
+			writeSessionWithinTransactionMock.AssertExpectations(t)
+			readSessionMock.AssertExpectations(t)
+			provisioner.AssertExpectations(t)
+		})
+	}
+
+	t.Run("Should wake up cluster and return operation ID", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		writeSessionWithinTransactionMock := &sessionMocks.WriteSessionWithinTransaction{}
+		readSessionMock := &sessionMocks.ReadSession{}
+		provisionerMock := &mocks2.Provisioner{}
+		wakeUpQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSessionMock, nil)
+		readSessionMock.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSessionMock.On("GetCluster", runtimeID).Return(cluster, nil)
+		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
+		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(getOperationMatcher(wakeUpOperation))).Return(nil)
+		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return(nil)
+		provisionerMock.On("WakeUpCluster", cluster.ID, cluster.ClusterConfig).Return(nil)
+		writeSessionWithinTransactionMock.On("Commit").Return(nil)
+		wakeUpQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisionerMock, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil, nil, wakeUpQueue)
+
+		// when
+		operationStatus, err := service.WakeUpCluster(runtimeID)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, gqlschema.OperationTypeWakeUp, operationStatus.Operation)
+		sessionFactoryMock.AssertExpectations(t)
+		writeSessionWithinTransactionMock.AssertExpectations(t)
+		readSessionMock.AssertExpectations(t)
+		provisionerMock.AssertExpectations(t)
+		wakeUpQueue.AssertExpectations(t)
+	})
+}
+
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
 	return func(op model.Operation) bool {
 		return op.Type == expected.Type && op.ClusterID == expected.ClusterID &&
@@ -66,6 +66,22 @@ type AzureZoneInput struct {
 	Cidr string `json:"cidr"`
 }
 
+type CertIssuer struct {
+	Name                 string   `json:"name"`
+	Server               string   `json:"server"`
+	Email                string   `json:"email"`
+	PrivateKeySecretName *string  `json:"privateKeySecretName"`
+	Domains              []string `json:"domains"`
+}
+
+type CertIssuerInput struct {
+	Name                 string   `json:"name"`
+	Server               string   `json:"server"`
+	Email                string   `json:"email"`
+	PrivateKeySecretName *string  `json:"privateKeySecretName"`
+	Domains              []string `json:"domains"`
+}
+
 type ClusterConfigInput struct {
 	GardenerConfig *GardenerConfigInput `json:"gardenerConfig"`
 	Administrators []string             `json:"administrators"`
@@ -149,6 +165,8 @@ type GardenerConfig struct {
 	DiskType                            *string                `json:"diskType"`
 	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
 	WorkerCidr                          *string                `json:"workerCidr"`
+	PodsCidr                            *string                `json:"podsCidr"`
+	ServicesCidr                        *string                `json:"servicesCidr"`
 	AutoScalerMin                       *int                   `json:"autoScalerMin"`
 	AutoScalerMax                       *int                   `json:"autoScalerMax"`
 	MaxSurge                            *int                   `json:"maxSurge"`
@@ -164,56 +182,78 @@ type GardenerConfig struct {
 	ExposureClassName                   *string                `json:"exposureClassName"`
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
+	AdditionalWorkerNodePools           []*WorkerNodePool      `json:"additionalWorkerNodePools"`
+	CertIssuers                         []*CertIssuer          `json:"certIssuers"`
+	HibernationSchedules                []*HibernationSchedule `json:"hibernationSchedules"`
 }
 
 type GardenerConfigInput struct {
-	Name                                string                 `json:"name"`
-	KubernetesVersion                   string                 `json:"kubernetesVersion"`
-	Provider                            string                 `json:"provider"`
-	TargetSecret                        string                 `json:"targetSecret"`
-	Region                              string                 `json:"region"`
-	MachineType                         string                 `json:"machineType"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	WorkerCidr                          string                 `json:"workerCidr"`
-	AutoScalerMin                       int                    `json:"autoScalerMin"`
-	AutoScalerMax                       int                    `json:"autoScalerMax"`
-	MaxSurge                            int                    `json:"maxSurge"`
-	MaxUnavailable                      int                    `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	LicenceType                         *string                `json:"licenceType"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	AllowPrivilegedContainers           *bool                  `json:"allowPrivilegedContainers"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	DNSConfig                           *DNSConfigInput        `json:"dnsConfig"`
-	Seed                                *string                `json:"seed"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
-	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
+	Name                                string                      `json:"name"`
+	KubernetesVersion                   string                      `json:"kubernetesVersion"`
+	Provider                            string                      `json:"provider"`
+	TargetSecret                        string                      `json:"targetSecret"`
+	Region                              string                      `json:"region"`
+	MachineType                         string                      `json:"machineType"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	WorkerCidr                          string                      `json:"workerCidr"`
+	PodsCidr                            *string                     `json:"podsCidr"`
+	ServicesCidr                        *string                     `json:"servicesCidr"`
+	AutoScalerMin                       int                         `json:"autoScalerMin"`
+	AutoScalerMax                       int                         `json:"autoScalerMax"`
+	MaxSurge                            int                         `json:"maxSurge"`
+	MaxUnavailable                      int                         `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	LicenceType                         *string                     `json:"licenceType"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	AllowPrivilegedContainers           *bool                       `json:"allowPrivilegedContainers"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	DNSConfig                           *DNSConfigInput             `json:"dnsConfig"`
+	Seed                                *string                     `json:"seed"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	ControlPlaneFailureTolerance        *string                     `json:"controlPlaneFailureTolerance"`
+	AdditionalWorkerNodePools           []*WorkerNodePoolInput      `json:"additionalWorkerNodePools"`
+	CertIssuers                         []*CertIssuerInput          `json:"certIssuers"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
 }
 
 type GardenerUpgradeInput struct {
-	KubernetesVersion                   *string                `json:"kubernetesVersion"`
-	MachineType                         *string                `json:"machineType"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	AutoScalerMin                       *int                   `json:"autoScalerMin"`
-	AutoScalerMax                       *int                   `json:"autoScalerMax"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	MaxSurge                            *int                   `json:"maxSurge"`
-	MaxUnavailable                      *int                   `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	KubernetesVersion                   *string                     `json:"kubernetesVersion"`
+	MachineType                         *string                     `json:"machineType"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	AutoScalerMin                       *int                        `json:"autoScalerMin"`
+	AutoScalerMax                       *int                        `json:"autoScalerMax"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	MaxSurge                            *int                        `json:"maxSurge"`
+	MaxUnavailable                      *int                        `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	AdditionalWorkerNodePools           []*WorkerNodePoolInput      `json:"additionalWorkerNodePools"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+}
+
+type HibernationSchedule struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
+}
+
+type HibernationScheduleInput struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
 }
 
 type HibernationStatus struct {
@@ -285,6 +325,11 @@ type OperationStatus struct {
 	LastError *LastError     `json:"lastError"`
 }
 
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
 type ProviderSpecificInput struct {
 	GcpConfig       *GCPProviderConfigInput       `json:"gcpConfig"`
 	AzureConfig     *AzureProviderConfigInput     `json:"azureConfig"`
@@ -304,11 +349,22 @@ type RuntimeConfig struct {
 	Kubeconfig    *string         `json:"kubeconfig"`
 }
 
+type RuntimeConnection struct {
+	TotalCount int            `json:"totalCount"`
+	Edges      []*RuntimeEdge `json:"edges"`
+	PageInfo   *PageInfo      `json:"pageInfo"`
+}
+
 type RuntimeConnectionStatus struct {
 	Status RuntimeAgentConnectionStatus `json:"status"`
 	Errors []*Error                     `json:"errors"`
 }
 
+type RuntimeEdge struct {
+	Cursor string         `json:"cursor"`
+	Node   *RuntimeStatus `json:"node"`
+}
+
 type RuntimeInput struct {
 	Name        string  `json:"name"`
 	Description *string `json:"description"`
@@ -322,6 +378,16 @@ type RuntimeStatus struct {
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus"`
 }
 
+type RuntimesFilter struct {
+	Tenant             *string         `json:"tenant"`
+	Provider           *string         `json:"provider"`
+	Region             *string         `json:"region"`
+	KubernetesVersion  *string         `json:"kubernetesVersion"`
+	LastOperationState *OperationState `json:"lastOperationState"`
+	Hibernated         *bool           `json:"hibernated"`
+	RuntimeIDs         []string        `json:"runtimeIDs"`
+}
+
 type UpgradeRuntimeInput struct {
 	KymaConfig *KymaConfigInput `json:"kymaConfig"`
 }
@@ -331,6 +397,22 @@ type UpgradeShootInput struct {
 	Administrators []string              `json:"administrators"`
 }
 
+type WorkerNodePool struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	VolumeSizeGb  *int   `json:"volumeSizeGB"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
+type WorkerNodePoolInput struct {
+	Name          string `json:"name"`
+	MachineType   string `json:"machineType"`
+	VolumeSizeGb  *int   `json:"volumeSizeGB"`
+	AutoScalerMin int    `json:"autoScalerMin"`
+	AutoScalerMax int    `json:"autoScalerMax"`
+}
+
 type ConflictStrategy string
 
 const (
@@ -469,6 +551,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -480,11 +563,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
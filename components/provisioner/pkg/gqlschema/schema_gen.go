@@ -67,6 +67,14 @@ type ComplexityRoot struct {
 		Name func(childComplexity int) int
 	}
 
+	CertIssuer struct {
+		Domains              func(childComplexity int) int
+		Email                func(childComplexity int) int
+		Name                 func(childComplexity int) int
+		PrivateKeySecretName func(childComplexity int) int
+		Server               func(childComplexity int) int
+	}
+
 	ComponentConfiguration struct {
 		Component     func(childComplexity int) int
 		Configuration func(childComplexity int) int
@@ -101,15 +109,18 @@ type ComplexityRoot struct {
 	}
 
 	GardenerConfig struct {
+		AdditionalWorkerNodePools           func(childComplexity int) int
 		AllowPrivilegedContainers           func(childComplexity int) int
 		AutoScalerMax                       func(childComplexity int) int
 		AutoScalerMin                       func(childComplexity int) int
+		CertIssuers                         func(childComplexity int) int
 		ControlPlaneFailureTolerance        func(childComplexity int) int
 		DNSConfig                           func(childComplexity int) int
 		DiskType                            func(childComplexity int) int
 		EnableKubernetesVersionAutoUpdate   func(childComplexity int) int
 		EnableMachineImageVersionAutoUpdate func(childComplexity int) int
 		ExposureClassName                   func(childComplexity int) int
+		HibernationSchedules                func(childComplexity int) int
 		KubernetesVersion                   func(childComplexity int) int
 		LicenceType                         func(childComplexity int) int
 		MachineImage                        func(childComplexity int) int
@@ -119,17 +130,25 @@ type ComplexityRoot struct {
 		MaxUnavailable                      func(childComplexity int) int
 		Name                                func(childComplexity int) int
 		OidcConfig                          func(childComplexity int) int
+		PodsCidr                            func(childComplexity int) int
 		Provider                            func(childComplexity int) int
 		ProviderSpecificConfig              func(childComplexity int) int
 		Purpose                             func(childComplexity int) int
 		Region                              func(childComplexity int) int
 		Seed                                func(childComplexity int) int
+		ServicesCidr                        func(childComplexity int) int
 		ShootNetworkingFilterDisabled       func(childComplexity int) int
 		TargetSecret                        func(childComplexity int) int
 		VolumeSizeGb                        func(childComplexity int) int
 		WorkerCidr                          func(childComplexity int) int
 	}
 
+	HibernationSchedule struct {
+		End      func(childComplexity int) int
+		Location func(childComplexity int) int
+		Start    func(childComplexity int) int
+	}
+
 	HibernationStatus struct {
 		Hibernated          func(childComplexity int) int
 		HibernationPossible func(childComplexity int) int
@@ -156,6 +175,7 @@ type ComplexityRoot struct {
 		RollBackUpgradeOperation func(childComplexity int, id string) int
 		UpgradeRuntime           func(childComplexity int, id string, config UpgradeRuntimeInput) int
 		UpgradeShoot             func(childComplexity int, id string, config UpgradeShootInput) int
+		WakeUpRuntime            func(childComplexity int, id string) int
 	}
 
 	OIDCConfig struct {
@@ -183,9 +203,15 @@ type ComplexityRoot struct {
 		State     func(childComplexity int) int
 	}
 
+	PageInfo struct {
+		EndCursor   func(childComplexity int) int
+		HasNextPage func(childComplexity int) int
+	}
+
 	Query struct {
 		RuntimeOperationStatus func(childComplexity int, id string) int
 		RuntimeStatus          func(childComplexity int, id string) int
+		Runtimes               func(childComplexity int, filter *RuntimesFilter, first *int, after *string) int
 	}
 
 	RuntimeConfig struct {
@@ -194,17 +220,36 @@ type ComplexityRoot struct {
 		KymaConfig    func(childComplexity int) int
 	}
 
+	RuntimeConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
 	RuntimeConnectionStatus struct {
 		Errors func(childComplexity int) int
 		Status func(childComplexity int) int
 	}
 
+	RuntimeEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	RuntimeStatus struct {
 		HibernationStatus       func(childComplexity int) int
 		LastOperationStatus     func(childComplexity int) int
 		RuntimeConfiguration    func(childComplexity int) int
 		RuntimeConnectionStatus func(childComplexity int) int
 	}
+
+	WorkerNodePool struct {
+		AutoScalerMax func(childComplexity int) int
+		AutoScalerMin func(childComplexity int) int
+		MachineType   func(childComplexity int) int
+		Name          func(childComplexity int) int
+		VolumeSizeGb  func(childComplexity int) int
+	}
 }
 
 type MutationResolver interface {
@@ -213,12 +258,14 @@ type MutationResolver interface {
 	DeprovisionRuntime(ctx context.Context, id string) (string, error)
 	UpgradeShoot(ctx context.Context, id string, config UpgradeShootInput) (*OperationStatus, error)
 	HibernateRuntime(ctx context.Context, id string) (*OperationStatus, error)
+	WakeUpRuntime(ctx context.Context, id string) (*OperationStatus, error)
 	RollBackUpgradeOperation(ctx context.Context, id string) (*RuntimeStatus, error)
 	ReconnectRuntimeAgent(ctx context.Context, id string) (string, error)
 }
 type QueryResolver interface {
 	RuntimeStatus(ctx context.Context, id string) (*RuntimeStatus, error)
 	RuntimeOperationStatus(ctx context.Context, id string) (*OperationStatus, error)
+	Runtimes(ctx context.Context, filter *RuntimesFilter, first *int, after *string) (*RuntimeConnection, error)
 }
 
 type executableSchema struct {
@@ -327,6 +374,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.AzureZone.Name(childComplexity), true
 
+	case "CertIssuer.domains":
+		if e.complexity.CertIssuer.Domains == nil {
+			break
+		}
+
+		return e.complexity.CertIssuer.Domains(childComplexity), true
+
+	case "CertIssuer.email":
+		if e.complexity.CertIssuer.Email == nil {
+			break
+		}
+
+		return e.complexity.CertIssuer.Email(childComplexity), true
+
+	case "CertIssuer.name":
+		if e.complexity.CertIssuer.Name == nil {
+			break
+		}
+
+		return e.complexity.CertIssuer.Name(childComplexity), true
+
+	case "CertIssuer.privateKeySecretName":
+		if e.complexity.CertIssuer.PrivateKeySecretName == nil {
+			break
+		}
+
+		return e.complexity.CertIssuer.PrivateKeySecretName(childComplexity), true
+
+	case "CertIssuer.server":
+		if e.complexity.CertIssuer.Server == nil {
+			break
+		}
+
+		return e.complexity.CertIssuer.Server(childComplexity), true
+
 	case "ComponentConfiguration.component":
 		if e.complexity.ComponentConfiguration.Component == nil {
 			break
@@ -432,6 +514,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GCPProviderConfig.Zones(childComplexity), true
 
+	case "GardenerConfig.additionalWorkerNodePools":
+		if e.complexity.GardenerConfig.AdditionalWorkerNodePools == nil {
+			break
+		}
+
+		return e.complexity.GardenerConfig.AdditionalWorkerNodePools(childComplexity), true
+
 	case "GardenerConfig.allowPrivilegedContainers":
 		if e.complexity.GardenerConfig.AllowPrivilegedContainers == nil {
 			break
@@ -453,6 +542,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GardenerConfig.AutoScalerMin(childComplexity), true
 
+	case "GardenerConfig.certIssuers":
+		if e.complexity.GardenerConfig.CertIssuers == nil {
+			break
+		}
+
+		return e.complexity.GardenerConfig.CertIssuers(childComplexity), true
+
 	case "GardenerConfig.controlPlaneFailureTolerance":
 		if e.complexity.GardenerConfig.ControlPlaneFailureTolerance == nil {
 			break
@@ -495,6 +591,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GardenerConfig.ExposureClassName(childComplexity), true
 
+	case "GardenerConfig.hibernationSchedules":
+		if e.complexity.GardenerConfig.HibernationSchedules == nil {
+			break
+		}
+
+		return e.complexity.GardenerConfig.HibernationSchedules(childComplexity), true
+
 	case "GardenerConfig.kubernetesVersion":
 		if e.complexity.GardenerConfig.KubernetesVersion == nil {
 			break
@@ -558,6 +661,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GardenerConfig.OidcConfig(childComplexity), true
 
+	case "GardenerConfig.podsCidr":
+		if e.complexity.GardenerConfig.PodsCidr == nil {
+			break
+		}
+
+		return e.complexity.GardenerConfig.PodsCidr(childComplexity), true
+
 	case "GardenerConfig.provider":
 		if e.complexity.GardenerConfig.Provider == nil {
 			break
@@ -593,6 +703,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GardenerConfig.Seed(childComplexity), true
 
+	case "GardenerConfig.servicesCidr":
+		if e.complexity.GardenerConfig.ServicesCidr == nil {
+			break
+		}
+
+		return e.complexity.GardenerConfig.ServicesCidr(childComplexity), true
+
 	case "GardenerConfig.shootNetworkingFilterDisabled":
 		if e.complexity.GardenerConfig.ShootNetworkingFilterDisabled == nil {
 			break
@@ -621,6 +738,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.GardenerConfig.WorkerCidr(childComplexity), true
 
+	case "HibernationSchedule.end":
+		if e.complexity.HibernationSchedule.End == nil {
+			break
+		}
+
+		return e.complexity.HibernationSchedule.End(childComplexity), true
+
+	case "HibernationSchedule.location":
+		if e.complexity.HibernationSchedule.Location == nil {
+			break
+		}
+
+		return e.complexity.HibernationSchedule.Location(childComplexity), true
+
+	case "HibernationSchedule.start":
+		if e.complexity.HibernationSchedule.Start == nil {
+			break
+		}
+
+		return e.complexity.HibernationSchedule.Start(childComplexity), true
+
 	case "HibernationStatus.hibernated":
 		if e.complexity.HibernationStatus.Hibernated == nil {
 			break
@@ -768,6 +906,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.UpgradeShoot(childComplexity, args["id"].(string), args["config"].(UpgradeShootInput)), true
 
+	case "Mutation.wakeUpRuntime":
+		if e.complexity.Mutation.WakeUpRuntime == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_wakeUpRuntime_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.WakeUpRuntime(childComplexity, args["id"].(string)), true
+
 	case "OIDCConfig.clientID":
 		if e.complexity.OIDCConfig.ClientID == nil {
 			break
@@ -880,6 +1030,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.OperationStatus.State(childComplexity), true
 
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
 	case "Query.runtimeOperationStatus":
 		if e.complexity.Query.RuntimeOperationStatus == nil {
 			break
@@ -904,6 +1068,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.RuntimeStatus(childComplexity, args["id"].(string)), true
 
+	case "Query.runtimes":
+		if e.complexity.Query.Runtimes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_runtimes_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Runtimes(childComplexity, args["filter"].(*RuntimesFilter), args["first"].(*int), args["after"].(*string)), true
+
 	case "RuntimeConfig.clusterConfig":
 		if e.complexity.RuntimeConfig.ClusterConfig == nil {
 			break
@@ -925,6 +1101,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RuntimeConfig.KymaConfig(childComplexity), true
 
+	case "RuntimeConnection.edges":
+		if e.complexity.RuntimeConnection.Edges == nil {
+			break
+		}
+
+		return e.complexity.RuntimeConnection.Edges(childComplexity), true
+
+	case "RuntimeConnection.pageInfo":
+		if e.complexity.RuntimeConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.RuntimeConnection.PageInfo(childComplexity), true
+
+	case "RuntimeConnection.totalCount":
+		if e.complexity.RuntimeConnection.TotalCount == nil {
+			break
+		}
+
+		return e.complexity.RuntimeConnection.TotalCount(childComplexity), true
+
 	case "RuntimeConnectionStatus.errors":
 		if e.complexity.RuntimeConnectionStatus.Errors == nil {
 			break
@@ -939,6 +1136,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RuntimeConnectionStatus.Status(childComplexity), true
 
+	case "RuntimeEdge.cursor":
+		if e.complexity.RuntimeEdge.Cursor == nil {
+			break
+		}
+
+		return e.complexity.RuntimeEdge.Cursor(childComplexity), true
+
+	case "RuntimeEdge.node":
+		if e.complexity.RuntimeEdge.Node == nil {
+			break
+		}
+
+		return e.complexity.RuntimeEdge.Node(childComplexity), true
+
 	case "RuntimeStatus.hibernationStatus":
 		if e.complexity.RuntimeStatus.HibernationStatus == nil {
 			break
@@ -967,6 +1178,41 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.RuntimeStatus.RuntimeConnectionStatus(childComplexity), true
 
+	case "WorkerNodePool.autoScalerMax":
+		if e.complexity.WorkerNodePool.AutoScalerMax == nil {
+			break
+		}
+
+		return e.complexity.WorkerNodePool.AutoScalerMax(childComplexity), true
+
+	case "WorkerNodePool.autoScalerMin":
+		if e.complexity.WorkerNodePool.AutoScalerMin == nil {
+			break
+		}
+
+		return e.complexity.WorkerNodePool.AutoScalerMin(childComplexity), true
+
+	case "WorkerNodePool.machineType":
+		if e.complexity.WorkerNodePool.MachineType == nil {
+			break
+		}
+
+		return e.complexity.WorkerNodePool.MachineType(childComplexity), true
+
+	case "WorkerNodePool.name":
+		if e.complexity.WorkerNodePool.Name == nil {
+			break
+		}
+
+		return e.complexity.WorkerNodePool.Name(childComplexity), true
+
+	case "WorkerNodePool.volumeSizeGB":
+		if e.complexity.WorkerNodePool.VolumeSizeGb == nil {
+			break
+		}
+
+		return e.complexity.WorkerNodePool.VolumeSizeGb(childComplexity), true
+
 	}
 	return 0, false
 }
@@ -1052,6 +1298,8 @@ type GardenerConfig {
     diskType: String
     volumeSizeGB: Int
     workerCidr: String
+    podsCidr: String
+    servicesCidr: String
     autoScalerMin: Int
     autoScalerMax: Int
     maxSurge: Int
@@ -1067,6 +1315,17 @@ type GardenerConfig {
     exposureClassName: String
     shootNetworkingFilterDisabled: Boolean
     controlPlaneFailureTolerance: String
+    additionalWorkerNodePools: [WorkerNodePool!]
+    certIssuers: [CertIssuer!]
+    hibernationSchedules: [HibernationSchedule!]
+}
+
+type WorkerNodePool {
+    name: String!
+    machineType: String!
+    volumeSizeGB: Int
+    autoScalerMin: Int!
+    autoScalerMax: Int!
 }
 
 union ProviderSpecificConfig = GCPProviderConfig | AzureProviderConfig | AWSProviderConfig | OpenStackProviderConfig
@@ -1083,6 +1342,20 @@ type DNSProvider {
     type: String!
 }
 
+type CertIssuer {
+    name: String!
+    server: String!
+    email: String!
+    privateKeySecretName: String
+    domains: [String!]
+}
+
+type HibernationSchedule {
+    start: String
+    end: String
+    location: String
+}
+
 type GCPProviderConfig {
     zones: [String!]!
 }
@@ -1172,6 +1445,7 @@ enum OperationType {
     DeprovisionNoInstall
     ReconnectRuntime
     Hibernate
+    WakeUp
 }
 
 type Error {
@@ -1197,6 +1471,24 @@ type RuntimeStatus {
     hibernationStatus: HibernationStatus
 }
 
+# Single page of Runtimes, the cursor of the last edge is used to fetch the next page
+type RuntimeConnection {
+    totalCount: Int!
+    edges: [RuntimeEdge!]!
+    pageInfo: PageInfo!
+}
+
+type RuntimeEdge {
+    cursor: String!
+    # Status of the Runtime, the hibernation status is based on the last operation of the Runtime
+    node: RuntimeStatus!
+}
+
+type PageInfo {
+    endCursor: String
+    hasNextPage: Boolean!
+}
+
 enum OperationState {
     Pending
     InProgress
@@ -1224,6 +1516,18 @@ enum ConflictStrategy {
 
 scalar Labels
 
+# Narrows down the Runtimes returned by the runtimes query, the fields not provided are not used for filtering
+input RuntimesFilter {
+    tenant: String
+    provider: String
+    region: String
+    kubernetesVersion: String
+    lastOperationState: OperationState
+    # Runtime is hibernated if the last operation is the succeeded hibernation
+    hibernated: Boolean
+    runtimeIDs: [String!]
+}
+
 input RuntimeInput {
     name: String!           # Name of the Runtime
     description: String     # Runtime description
@@ -1253,6 +1557,8 @@ input GardenerConfigInput {
     diskType: String                                # Disk type, varies depending on the target provider
     volumeSizeGB: Int                               # Size of the available disk, provided in GB
     workerCidr: String!                             # Classless Inter-Domain Routing range for the nodes
+    podsCidr: String                                # Classless Inter-Domain Routing range for the pods. If not provided, the Gardener default is used
+    servicesCidr: String                            # Classless Inter-Domain Routing range for the services. If not provided, the Gardener default is used
     autoScalerMin: Int!                             # Minimum number of VMs to create
     autoScalerMax: Int!                             # Maximum number of VMs to create
     maxSurge: Int!                                  # Maximum number of VMs created during an update
@@ -1269,6 +1575,17 @@ input GardenerConfigInput {
     exposureClassName: String                       # Name of the ExposureClass
     shootNetworkingFilterDisabled: Boolean          # Indicator for the Shoot Networking Filter extension being disabled. If 'nil' provided, 'true' will be used as a default value
     controlPlaneFailureTolerance: String            # Shoot control plane HA failure tolerance level to configure. Valid values: 'nil' (left empty, no HA), "node", "zone"
+    additionalWorkerNodePools: [WorkerNodePoolInput!]   # Worker node pools created next to the main worker node pool
+    certIssuers: [CertIssuerInput!]                 # ACME issuers of the certificate service, used to issue the certificates for the custom domains
+    hibernationSchedules: [HibernationScheduleInput!]   # Schedules of the cluster hibernation
+}
+
+input WorkerNodePoolInput {
+    name: String!                 # Name of the worker node pool, unique in the cluster
+    machineType: String!          # Type of node machines, varies depending on the target provider
+    volumeSizeGB: Int             # Size of the available disk, provided in GB. If not provided, the size of the main worker node pool is used
+    autoScalerMin: Int!           # Minimum number of VMs to create
+    autoScalerMax: Int!           # Maximum number of VMs to create
 }
 
 input OIDCConfigInput {
@@ -1299,6 +1616,20 @@ input DNSProviderInput {
     type: String!
 }
 
+input CertIssuerInput {
+    name: String!                   # Name of the issuer, unique in the cluster
+    server: String!                 # URL of the ACME server, for example https://acme-v02.api.letsencrypt.org/directory
+    email: String!                  # Email of the ACME account
+    privateKeySecretName: String    # Secret in Gardener containing the private key of the existing ACME account. If not provided, a new account is registered
+    domains: [String!]              # Domains the issuer is restricted to. If not provided, the issuer can be used for any domain
+}
+
+input HibernationScheduleInput {
+    start: String       # Cron expression of the hibernation start, for example "00 20 * * 1,2,3,4,5"
+    end: String         # Cron expression of the wake up, for example "00 08 * * 1,2,3,4,5"
+    location: String    # Time zone of the cron expressions, for example "Europe/Berlin". If not provided, UTC is used
+}
+
 input GCPProviderConfigInput {
     zones: [String!]!      # Zones in which to create the cluster
 }
@@ -1386,6 +1717,8 @@ input GardenerUpgradeInput {
     oidcConfig: OIDCConfigInput
     exposureClassName: String                     # ExposureClass name
     shootNetworkingFilterDisabled: Boolean        # Indicator for the Shoot Networking Filter extension being disabled
+    additionalWorkerNodePools: [WorkerNodePoolInput!] # Replaces the additional worker node pools. If not provided, the pools are not changed
+    hibernationSchedules: [HibernationScheduleInput!] # Replaces the hibernation schedules. If not provided, the schedules are not changed
 }
 
 type Mutation {
@@ -1395,6 +1728,7 @@ type Mutation {
     deprovisionRuntime(id: String!): String!
     upgradeShoot(id: String!, config: UpgradeShootInput!): OperationStatus
     hibernateRuntime(id: String!): OperationStatus
+    wakeUpRuntime(id: String!): OperationStatus
 
     # rollbackUpgradeOperation rolls back last upgrade operation for the Runtime but does not affect cluster in any way
     # can be used in case upgrade failed and the cluster was restored from the backup to align data stored in Provisioner database
@@ -1411,6 +1745,9 @@ type Query {
 
     # Provides status of specified operation
     runtimeOperationStatus(id: String!): OperationStatus
+
+    # Provides Runtimes matching the filter ordered by the creation time, up to 100 Runtimes per page
+    runtimes(filter: RuntimesFilter, first: Int = 50, after: String): RuntimeConnection
 }
 `, BuiltIn: false},
 }
@@ -1534,6 +1871,20 @@ func (ec *executionContext) field_Mutation_upgradeShoot_args(ctx context.Context
 	return args, nil
 }
 
+func (ec *executionContext) field_Mutation_wakeUpRuntime_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["id"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["id"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1576,6 +1927,36 @@ func (ec *executionContext) field_Query_runtimeStatus_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_runtimes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *RuntimesFilter
+	if tmp, ok := rawArgs["filter"]; ok {
+		arg0, err = ec.unmarshalORuntimesFilter2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimesFilter(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["filter"] = arg0
+	var arg1 *int
+	if tmp, ok := rawArgs["first"]; ok {
+		arg1, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["first"] = arg1
+	var arg2 *string
+	if tmp, ok := rawArgs["after"]; ok {
+		arg2, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["after"] = arg2
+	return args, nil
+}
+
 func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2024,7 +2405,7 @@ func (ec *executionContext) _AzureZone_cidr(ctx context.Context, field graphql.C
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ComponentConfiguration_component(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
+func (ec *executionContext) _CertIssuer_name(ctx context.Context, field graphql.CollectedField, obj *CertIssuer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2032,7 +2413,7 @@ func (ec *executionContext) _ComponentConfiguration_component(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ComponentConfiguration",
+		Object:   "CertIssuer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2041,7 +2422,7 @@ func (ec *executionContext) _ComponentConfiguration_component(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Component, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2058,7 +2439,7 @@ func (ec *executionContext) _ComponentConfiguration_component(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ComponentConfiguration_namespace(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
+func (ec *executionContext) _CertIssuer_server(ctx context.Context, field graphql.CollectedField, obj *CertIssuer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2066,7 +2447,7 @@ func (ec *executionContext) _ComponentConfiguration_namespace(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ComponentConfiguration",
+		Object:   "CertIssuer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2075,7 +2456,7 @@ func (ec *executionContext) _ComponentConfiguration_namespace(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Namespace, nil
+		return obj.Server, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2092,7 +2473,7 @@ func (ec *executionContext) _ComponentConfiguration_namespace(ctx context.Contex
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ComponentConfiguration_configuration(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
+func (ec *executionContext) _CertIssuer_email(ctx context.Context, field graphql.CollectedField, obj *CertIssuer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2100,7 +2481,7 @@ func (ec *executionContext) _ComponentConfiguration_configuration(ctx context.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ComponentConfiguration",
+		Object:   "CertIssuer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2109,21 +2490,24 @@ func (ec *executionContext) _ComponentConfiguration_configuration(ctx context.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Configuration, nil
+		return obj.Email, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*ConfigEntry)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOConfigEntry2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐConfigEntry(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ComponentConfiguration_sourceURL(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
+func (ec *executionContext) _CertIssuer_privateKeySecretName(ctx context.Context, field graphql.CollectedField, obj *CertIssuer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2131,7 +2515,7 @@ func (ec *executionContext) _ComponentConfiguration_sourceURL(ctx context.Contex
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ComponentConfiguration",
+		Object:   "CertIssuer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2140,7 +2524,7 @@ func (ec *executionContext) _ComponentConfiguration_sourceURL(ctx context.Contex
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SourceURL, nil
+		return obj.PrivateKeySecretName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2154,7 +2538,7 @@ func (ec *executionContext) _ComponentConfiguration_sourceURL(ctx context.Contex
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ConfigEntry_key(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+func (ec *executionContext) _CertIssuer_domains(ctx context.Context, field graphql.CollectedField, obj *CertIssuer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2162,7 +2546,7 @@ func (ec *executionContext) _ConfigEntry_key(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ConfigEntry",
+		Object:   "CertIssuer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2171,24 +2555,21 @@ func (ec *executionContext) _ConfigEntry_key(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.Domains, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ConfigEntry_value(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+func (ec *executionContext) _ComponentConfiguration_component(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2196,7 +2577,7 @@ func (ec *executionContext) _ConfigEntry_value(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ConfigEntry",
+		Object:   "ComponentConfiguration",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2205,7 +2586,7 @@ func (ec *executionContext) _ConfigEntry_value(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.Component, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2222,7 +2603,7 @@ func (ec *executionContext) _ConfigEntry_value(ctx context.Context, field graphq
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _ConfigEntry_secret(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+func (ec *executionContext) _ComponentConfiguration_namespace(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2230,7 +2611,7 @@ func (ec *executionContext) _ConfigEntry_secret(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "ConfigEntry",
+		Object:   "ComponentConfiguration",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2239,21 +2620,24 @@ func (ec *executionContext) _ConfigEntry_secret(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Secret, nil
+		return obj.Namespace, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DNSConfig_domain(ctx context.Context, field graphql.CollectedField, obj *DNSConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _ComponentConfiguration_configuration(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2261,7 +2645,7 @@ func (ec *executionContext) _DNSConfig_domain(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DNSConfig",
+		Object:   "ComponentConfiguration",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2270,24 +2654,21 @@ func (ec *executionContext) _DNSConfig_domain(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
+		return obj.Configuration, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*ConfigEntry)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOConfigEntry2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐConfigEntry(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _DNSConfig_providers(ctx context.Context, field graphql.CollectedField, obj *DNSConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _ComponentConfiguration_sourceURL(ctx context.Context, field graphql.CollectedField, obj *ComponentConfiguration) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2295,7 +2676,7 @@ func (ec *executionContext) _DNSConfig_providers(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "DNSConfig",
+		Object:   "ComponentConfiguration",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2304,7 +2685,7 @@ func (ec *executionContext) _DNSConfig_providers(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Providers, nil
+		return obj.SourceURL, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2313,9 +2694,173 @@ func (ec *executionContext) _DNSConfig_providers(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*DNSProvider)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalODNSProvider2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐDNSProvider(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ConfigEntry_key(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ConfigEntry",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Key, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ConfigEntry_value(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ConfigEntry",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _ConfigEntry_secret(ctx context.Context, field graphql.CollectedField, obj *ConfigEntry) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "ConfigEntry",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Secret, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DNSConfig_domain(ctx context.Context, field graphql.CollectedField, obj *DNSConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "DNSConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Domain, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _DNSConfig_providers(ctx context.Context, field graphql.CollectedField, obj *DNSConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "DNSConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Providers, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*DNSProvider)
+	fc.Result = res
+	return ec.marshalODNSProvider2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐDNSProvider(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _DNSProvider_domainsInclude(ctx context.Context, field graphql.CollectedField, obj *DNSProvider) (ret graphql.Marshaler) {
@@ -2891,6 +3436,68 @@ func (ec *executionContext) _GardenerConfig_workerCidr(ctx context.Context, fiel
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _GardenerConfig_podsCidr(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "GardenerConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PodsCidr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _GardenerConfig_servicesCidr(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "GardenerConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ServicesCidr, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _GardenerConfig_autoScalerMin(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -3356,7 +3963,7 @@ func (ec *executionContext) _GardenerConfig_controlPlaneFailureTolerance(ctx con
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HibernationStatus_hibernated(ctx context.Context, field graphql.CollectedField, obj *HibernationStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _GardenerConfig_additionalWorkerNodePools(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3364,7 +3971,7 @@ func (ec *executionContext) _HibernationStatus_hibernated(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "HibernationStatus",
+		Object:   "GardenerConfig",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3373,7 +3980,7 @@ func (ec *executionContext) _HibernationStatus_hibernated(ctx context.Context, f
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Hibernated, nil
+		return obj.AdditionalWorkerNodePools, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3382,12 +3989,12 @@ func (ec *executionContext) _HibernationStatus_hibernated(ctx context.Context, f
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.([]*WorkerNodePool)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOWorkerNodePool2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _HibernationStatus_hibernationPossible(ctx context.Context, field graphql.CollectedField, obj *HibernationStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _GardenerConfig_certIssuers(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3395,7 +4002,7 @@ func (ec *executionContext) _HibernationStatus_hibernationPossible(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "HibernationStatus",
+		Object:   "GardenerConfig",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3404,7 +4011,7 @@ func (ec *executionContext) _HibernationStatus_hibernationPossible(ctx context.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HibernationPossible, nil
+		return obj.CertIssuers, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3413,12 +4020,12 @@ func (ec *executionContext) _HibernationStatus_hibernationPossible(ctx context.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.([]*CertIssuer)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOCertIssuer2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KymaConfig_version(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _GardenerConfig_hibernationSchedules(ctx context.Context, field graphql.CollectedField, obj *GardenerConfig) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3426,7 +4033,7 @@ func (ec *executionContext) _KymaConfig_version(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "KymaConfig",
+		Object:   "GardenerConfig",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3435,7 +4042,7 @@ func (ec *executionContext) _KymaConfig_version(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Version, nil
+		return obj.HibernationSchedules, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3444,12 +4051,12 @@ func (ec *executionContext) _KymaConfig_version(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*HibernationSchedule)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOHibernationSchedule2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KymaConfig_profile(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _HibernationSchedule_start(ctx context.Context, field graphql.CollectedField, obj *HibernationSchedule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3457,7 +4064,7 @@ func (ec *executionContext) _KymaConfig_profile(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "KymaConfig",
+		Object:   "HibernationSchedule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3466,7 +4073,7 @@ func (ec *executionContext) _KymaConfig_profile(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Profile, nil
+		return obj.Start, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3475,12 +4082,12 @@ func (ec *executionContext) _KymaConfig_profile(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*KymaProfile)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOKymaProfile2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐKymaProfile(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KymaConfig_components(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _HibernationSchedule_end(ctx context.Context, field graphql.CollectedField, obj *HibernationSchedule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3488,7 +4095,7 @@ func (ec *executionContext) _KymaConfig_components(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "KymaConfig",
+		Object:   "HibernationSchedule",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3497,7 +4104,7 @@ func (ec *executionContext) _KymaConfig_components(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Components, nil
+		return obj.End, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3506,12 +4113,12 @@ func (ec *executionContext) _KymaConfig_components(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*ComponentConfiguration)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOComponentConfiguration2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐComponentConfiguration(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _KymaConfig_configuration(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+func (ec *executionContext) _HibernationSchedule_location(ctx context.Context, field graphql.CollectedField, obj *HibernationSchedule) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3519,7 +4126,193 @@ func (ec *executionContext) _KymaConfig_configuration(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "KymaConfig",
+		Object:   "HibernationSchedule",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Location, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HibernationStatus_hibernated(ctx context.Context, field graphql.CollectedField, obj *HibernationStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "HibernationStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hibernated, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _HibernationStatus_hibernationPossible(ctx context.Context, field graphql.CollectedField, obj *HibernationStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "HibernationStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HibernationPossible, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*bool)
+	fc.Result = res
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KymaConfig_version(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "KymaConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Version, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KymaConfig_profile(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "KymaConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Profile, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*KymaProfile)
+	fc.Result = res
+	return ec.marshalOKymaProfile2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐKymaProfile(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KymaConfig_components(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "KymaConfig",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Components, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*ComponentConfiguration)
+	fc.Result = res
+	return ec.marshalOComponentConfiguration2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐComponentConfiguration(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _KymaConfig_configuration(ctx context.Context, field graphql.CollectedField, obj *KymaConfig) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "KymaConfig",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3837,6 +4630,44 @@ func (ec *executionContext) _Mutation_hibernateRuntime(ctx context.Context, fiel
 	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
 }
 
+func (ec *executionContext) _Mutation_wakeUpRuntime(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Mutation",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Mutation_wakeUpRuntime_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Mutation().WakeUpRuntime(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*OperationStatus)
+	fc.Result = res
+	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
+}
+
 func (ec *executionContext) _Mutation_rollBackUpgradeOperation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -4448,7 +5279,7 @@ func (ec *executionContext) _OperationStatus_lastError(ctx context.Context, fiel
 	return ec.marshalOLastError2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐLastError(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_runtimeStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_endCursor(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4456,23 +5287,16 @@ func (ec *executionContext) _Query_runtimeStatus(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_runtimeStatus_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().RuntimeStatus(rctx, args["id"].(string))
+		return obj.EndCursor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4481,12 +5305,12 @@ func (ec *executionContext) _Query_runtimeStatus(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*RuntimeStatus)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalORuntimeStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeStatus(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_runtimeOperationStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _PageInfo_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *PageInfo) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4494,37 +5318,147 @@ func (ec *executionContext) _Query_runtimeOperationStatus(ctx context.Context, f
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "PageInfo",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_runtimeOperationStatus_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().RuntimeOperationStatus(rctx, args["id"].(string))
+		return obj.HasNextPage, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*OperationStatus)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_runtimeStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_runtimeStatus_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RuntimeStatus(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*RuntimeStatus)
+	fc.Result = res
+	return ec.marshalORuntimeStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_runtimeOperationStatus(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_runtimeOperationStatus_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().RuntimeOperationStatus(rctx, args["id"].(string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*OperationStatus)
+	fc.Result = res
+	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query_runtimes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Query",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_runtimes_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Query().Runtimes(rctx, args["filter"].(*RuntimesFilter), args["first"].(*int), args["after"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*RuntimeConnection)
+	fc.Result = res
+	return ec.marshalORuntimeConnection2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnection(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4681,12 +5615,340 @@ func (ec *executionContext) _RuntimeConfig_kubeconfig(ctx context.Context, field
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeConnection_totalCount(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TotalCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeConnection_edges(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Edges, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]*RuntimeEdge)
+	fc.Result = res
+	return ec.marshalNRuntimeEdge2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeEdgeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnection) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeConnection",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PageInfo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*PageInfo)
+	fc.Result = res
+	return ec.marshalNPageInfo2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐPageInfo(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeConnectionStatus_status(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnectionStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeConnectionStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Status, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(RuntimeAgentConnectionStatus)
+	fc.Result = res
+	return ec.marshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeConnectionStatus_errors(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnectionStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeConnectionStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Errors, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*Error)
+	fc.Result = res
+	return ec.marshalOError2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐErrorᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeEdge_cursor(ctx context.Context, field graphql.CollectedField, obj *RuntimeEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Cursor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeEdge_node(ctx context.Context, field graphql.CollectedField, obj *RuntimeEdge) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeEdge",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Node, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*RuntimeStatus)
+	fc.Result = res
+	return ec.marshalNRuntimeStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeStatus_lastOperationStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastOperationStatus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*OperationStatus)
+	fc.Result = res
+	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeStatus_runtimeConnectionStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RuntimeConnectionStatus, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*RuntimeConnectionStatus)
+	fc.Result = res
+	return ec.marshalORuntimeConnectionStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnectionStatus(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _RuntimeStatus_runtimeConfiguration(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "RuntimeStatus",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RuntimeConfiguration, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*RuntimeConfig)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalORuntimeConfig2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConfig(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeConnectionStatus_status(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnectionStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _RuntimeStatus_hibernationStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4694,7 +5956,7 @@ func (ec *executionContext) _RuntimeConnectionStatus_status(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeConnectionStatus",
+		Object:   "RuntimeStatus",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4703,24 +5965,21 @@ func (ec *executionContext) _RuntimeConnectionStatus_status(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Status, nil
+		return obj.HibernationStatus, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(RuntimeAgentConnectionStatus)
+	res := resTmp.(*HibernationStatus)
 	fc.Result = res
-	return ec.marshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx, field.Selections, res)
+	return ec.marshalOHibernationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationStatus(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeConnectionStatus_errors(ctx context.Context, field graphql.CollectedField, obj *RuntimeConnectionStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _WorkerNodePool_name(ctx context.Context, field graphql.CollectedField, obj *WorkerNodePool) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4728,7 +5987,7 @@ func (ec *executionContext) _RuntimeConnectionStatus_errors(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeConnectionStatus",
+		Object:   "WorkerNodePool",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4737,21 +5996,24 @@ func (ec *executionContext) _RuntimeConnectionStatus_errors(ctx context.Context,
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Errors, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*Error)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOError2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐErrorᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeStatus_lastOperationStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _WorkerNodePool_machineType(ctx context.Context, field graphql.CollectedField, obj *WorkerNodePool) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4759,7 +6021,7 @@ func (ec *executionContext) _RuntimeStatus_lastOperationStatus(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeStatus",
+		Object:   "WorkerNodePool",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4768,21 +6030,24 @@ func (ec *executionContext) _RuntimeStatus_lastOperationStatus(ctx context.Conte
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastOperationStatus, nil
+		return obj.MachineType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*OperationStatus)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOOperationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeStatus_runtimeConnectionStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _WorkerNodePool_volumeSizeGB(ctx context.Context, field graphql.CollectedField, obj *WorkerNodePool) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4790,7 +6055,7 @@ func (ec *executionContext) _RuntimeStatus_runtimeConnectionStatus(ctx context.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeStatus",
+		Object:   "WorkerNodePool",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4799,7 +6064,7 @@ func (ec *executionContext) _RuntimeStatus_runtimeConnectionStatus(ctx context.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RuntimeConnectionStatus, nil
+		return obj.VolumeSizeGb, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4808,12 +6073,12 @@ func (ec *executionContext) _RuntimeStatus_runtimeConnectionStatus(ctx context.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*RuntimeConnectionStatus)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalORuntimeConnectionStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnectionStatus(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeStatus_runtimeConfiguration(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _WorkerNodePool_autoScalerMin(ctx context.Context, field graphql.CollectedField, obj *WorkerNodePool) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4821,7 +6086,7 @@ func (ec *executionContext) _RuntimeStatus_runtimeConfiguration(ctx context.Cont
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeStatus",
+		Object:   "WorkerNodePool",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4830,21 +6095,24 @@ func (ec *executionContext) _RuntimeStatus_runtimeConfiguration(ctx context.Cont
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RuntimeConfiguration, nil
+		return obj.AutoScalerMin, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*RuntimeConfig)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalORuntimeConfig2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConfig(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _RuntimeStatus_hibernationStatus(ctx context.Context, field graphql.CollectedField, obj *RuntimeStatus) (ret graphql.Marshaler) {
+func (ec *executionContext) _WorkerNodePool_autoScalerMax(ctx context.Context, field graphql.CollectedField, obj *WorkerNodePool) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4852,7 +6120,7 @@ func (ec *executionContext) _RuntimeStatus_hibernationStatus(ctx context.Context
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "RuntimeStatus",
+		Object:   "WorkerNodePool",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -4861,18 +6129,21 @@ func (ec *executionContext) _RuntimeStatus_hibernationStatus(ctx context.Context
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HibernationStatus, nil
+		return obj.AutoScalerMax, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*HibernationStatus)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOHibernationStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationStatus(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
@@ -6056,6 +7327,48 @@ func (ec *executionContext) unmarshalInputAzureZoneInput(ctx context.Context, ob
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputCertIssuerInput(ctx context.Context, obj interface{}) (CertIssuerInput, error) {
+	var it CertIssuerInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "server":
+			var err error
+			it.Server, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "email":
+			var err error
+			it.Email, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "privateKeySecretName":
+			var err error
+			it.PrivateKeySecretName, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "domains":
+			var err error
+			it.Domains, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputClusterConfigInput(ctx context.Context, obj interface{}) (ClusterConfigInput, error) {
 	var it ClusterConfigInput
 	var asMap = obj.(map[string]interface{})
@@ -6302,6 +7615,18 @@ func (ec *executionContext) unmarshalInputGardenerConfigInput(ctx context.Contex
 			if err != nil {
 				return it, err
 			}
+		case "podsCidr":
+			var err error
+			it.PodsCidr, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "servicesCidr":
+			var err error
+			it.ServicesCidr, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		case "autoScalerMin":
 			var err error
 			it.AutoScalerMin, err = ec.unmarshalNInt2int(ctx, v)
@@ -6398,6 +7723,24 @@ func (ec *executionContext) unmarshalInputGardenerConfigInput(ctx context.Contex
 			if err != nil {
 				return it, err
 			}
+		case "additionalWorkerNodePools":
+			var err error
+			it.AdditionalWorkerNodePools, err = ec.unmarshalOWorkerNodePoolInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "certIssuers":
+			var err error
+			it.CertIssuers, err = ec.unmarshalOCertIssuerInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hibernationSchedules":
+			var err error
+			it.HibernationSchedules, err = ec.unmarshalOHibernationScheduleInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -6512,6 +7855,48 @@ func (ec *executionContext) unmarshalInputGardenerUpgradeInput(ctx context.Conte
 			if err != nil {
 				return it, err
 			}
+		case "additionalWorkerNodePools":
+			var err error
+			it.AdditionalWorkerNodePools, err = ec.unmarshalOWorkerNodePoolInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hibernationSchedules":
+			var err error
+			it.HibernationSchedules, err = ec.unmarshalOHibernationScheduleInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputHibernationScheduleInput(ctx context.Context, obj interface{}) (HibernationScheduleInput, error) {
+	var it HibernationScheduleInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "start":
+			var err error
+			it.Start, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "end":
+			var err error
+			it.End, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "location":
+			var err error
+			it.Location, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
 		}
 	}
 
@@ -6740,6 +8125,60 @@ func (ec *executionContext) unmarshalInputRuntimeInput(ctx context.Context, obj
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputRuntimesFilter(ctx context.Context, obj interface{}) (RuntimesFilter, error) {
+	var it RuntimesFilter
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "tenant":
+			var err error
+			it.Tenant, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "provider":
+			var err error
+			it.Provider, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "region":
+			var err error
+			it.Region, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "kubernetesVersion":
+			var err error
+			it.KubernetesVersion, err = ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "lastOperationState":
+			var err error
+			it.LastOperationState, err = ec.unmarshalOOperationState2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "hibernated":
+			var err error
+			it.Hibernated, err = ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "runtimeIDs":
+			var err error
+			it.RuntimeIDs, err = ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputUpgradeRuntimeInput(ctx context.Context, obj interface{}) (UpgradeRuntimeInput, error) {
 	var it UpgradeRuntimeInput
 	var asMap = obj.(map[string]interface{})
@@ -6782,6 +8221,48 @@ func (ec *executionContext) unmarshalInputUpgradeShootInput(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputWorkerNodePoolInput(ctx context.Context, obj interface{}) (WorkerNodePoolInput, error) {
+	var it WorkerNodePoolInput
+	var asMap = obj.(map[string]interface{})
+
+	for k, v := range asMap {
+		switch k {
+		case "name":
+			var err error
+			it.Name, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "machineType":
+			var err error
+			it.MachineType, err = ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "volumeSizeGB":
+			var err error
+			it.VolumeSizeGb, err = ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "autoScalerMin":
+			var err error
+			it.AutoScalerMin, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		case "autoScalerMax":
+			var err error
+			it.AutoScalerMax, err = ec.unmarshalNInt2int(ctx, v)
+			if err != nil {
+				return it, err
+			}
+		}
+	}
+
+	return it, nil
+}
+
 // endregion **************************** input.gotpl *****************************
 
 // region    ************************** interface.gotpl ***************************
@@ -6918,27 +8399,68 @@ func (ec *executionContext) _AzureProviderConfig(ctx context.Context, sel ast.Se
 	return out
 }
 
-var azureZoneImplementors = []string{"AzureZone"}
+var azureZoneImplementors = []string{"AzureZone"}
+
+func (ec *executionContext) _AzureZone(ctx context.Context, sel ast.SelectionSet, obj *AzureZone) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, azureZoneImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("AzureZone")
+		case "name":
+			out.Values[i] = ec._AzureZone_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "cidr":
+			out.Values[i] = ec._AzureZone_cidr(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var certIssuerImplementors = []string{"CertIssuer"}
 
-func (ec *executionContext) _AzureZone(ctx context.Context, sel ast.SelectionSet, obj *AzureZone) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, azureZoneImplementors)
+func (ec *executionContext) _CertIssuer(ctx context.Context, sel ast.SelectionSet, obj *CertIssuer) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, certIssuerImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("AzureZone")
+			out.Values[i] = graphql.MarshalString("CertIssuer")
 		case "name":
-			out.Values[i] = ec._AzureZone_name(ctx, field, obj)
+			out.Values[i] = ec._CertIssuer_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "cidr":
-			out.Values[i] = ec._AzureZone_cidr(ctx, field, obj)
+		case "server":
+			out.Values[i] = ec._CertIssuer_server(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "email":
+			out.Values[i] = ec._CertIssuer_email(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
+		case "privateKeySecretName":
+			out.Values[i] = ec._CertIssuer_privateKeySecretName(ctx, field, obj)
+		case "domains":
+			out.Values[i] = ec._CertIssuer_domains(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7177,6 +8699,10 @@ func (ec *executionContext) _GardenerConfig(ctx context.Context, sel ast.Selecti
 			out.Values[i] = ec._GardenerConfig_volumeSizeGB(ctx, field, obj)
 		case "workerCidr":
 			out.Values[i] = ec._GardenerConfig_workerCidr(ctx, field, obj)
+		case "podsCidr":
+			out.Values[i] = ec._GardenerConfig_podsCidr(ctx, field, obj)
+		case "servicesCidr":
+			out.Values[i] = ec._GardenerConfig_servicesCidr(ctx, field, obj)
 		case "autoScalerMin":
 			out.Values[i] = ec._GardenerConfig_autoScalerMin(ctx, field, obj)
 		case "autoScalerMax":
@@ -7207,6 +8733,40 @@ func (ec *executionContext) _GardenerConfig(ctx context.Context, sel ast.Selecti
 			out.Values[i] = ec._GardenerConfig_shootNetworkingFilterDisabled(ctx, field, obj)
 		case "controlPlaneFailureTolerance":
 			out.Values[i] = ec._GardenerConfig_controlPlaneFailureTolerance(ctx, field, obj)
+		case "additionalWorkerNodePools":
+			out.Values[i] = ec._GardenerConfig_additionalWorkerNodePools(ctx, field, obj)
+		case "certIssuers":
+			out.Values[i] = ec._GardenerConfig_certIssuers(ctx, field, obj)
+		case "hibernationSchedules":
+			out.Values[i] = ec._GardenerConfig_hibernationSchedules(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var hibernationScheduleImplementors = []string{"HibernationSchedule"}
+
+func (ec *executionContext) _HibernationSchedule(ctx context.Context, sel ast.SelectionSet, obj *HibernationSchedule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, hibernationScheduleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("HibernationSchedule")
+		case "start":
+			out.Values[i] = ec._HibernationSchedule_start(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._HibernationSchedule_end(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._HibernationSchedule_location(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -7339,6 +8899,8 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 			out.Values[i] = ec._Mutation_upgradeShoot(ctx, field)
 		case "hibernateRuntime":
 			out.Values[i] = ec._Mutation_hibernateRuntime(ctx, field)
+		case "wakeUpRuntime":
+			out.Values[i] = ec._Mutation_wakeUpRuntime(ctx, field)
 		case "rollBackUpgradeOperation":
 			out.Values[i] = ec._Mutation_rollBackUpgradeOperation(ctx, field)
 		case "reconnectRuntimeAgent":
@@ -7491,6 +9053,35 @@ func (ec *executionContext) _OperationStatus(ctx context.Context, sel ast.Select
 	return out
 }
 
+var pageInfoImplementors = []string{"PageInfo"}
+
+func (ec *executionContext) _PageInfo(ctx context.Context, sel ast.SelectionSet, obj *PageInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PageInfo")
+		case "endCursor":
+			out.Values[i] = ec._PageInfo_endCursor(ctx, field, obj)
+		case "hasNextPage":
+			out.Values[i] = ec._PageInfo_hasNextPage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -7528,6 +9119,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_runtimeOperationStatus(ctx, field)
 				return res
 			})
+		case "runtimes":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_runtimes(ctx, field)
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -7571,6 +9173,43 @@ func (ec *executionContext) _RuntimeConfig(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var runtimeConnectionImplementors = []string{"RuntimeConnection"}
+
+func (ec *executionContext) _RuntimeConnection(ctx context.Context, sel ast.SelectionSet, obj *RuntimeConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, runtimeConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RuntimeConnection")
+		case "totalCount":
+			out.Values[i] = ec._RuntimeConnection_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "edges":
+			out.Values[i] = ec._RuntimeConnection_edges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._RuntimeConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var runtimeConnectionStatusImplementors = []string{"RuntimeConnectionStatus"}
 
 func (ec *executionContext) _RuntimeConnectionStatus(ctx context.Context, sel ast.SelectionSet, obj *RuntimeConnectionStatus) graphql.Marshaler {
@@ -7600,6 +9239,38 @@ func (ec *executionContext) _RuntimeConnectionStatus(ctx context.Context, sel as
 	return out
 }
 
+var runtimeEdgeImplementors = []string{"RuntimeEdge"}
+
+func (ec *executionContext) _RuntimeEdge(ctx context.Context, sel ast.SelectionSet, obj *RuntimeEdge) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, runtimeEdgeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RuntimeEdge")
+		case "cursor":
+			out.Values[i] = ec._RuntimeEdge_cursor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "node":
+			out.Values[i] = ec._RuntimeEdge_node(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var runtimeStatusImplementors = []string{"RuntimeStatus"}
 
 func (ec *executionContext) _RuntimeStatus(ctx context.Context, sel ast.SelectionSet, obj *RuntimeStatus) graphql.Marshaler {
@@ -7630,6 +9301,50 @@ func (ec *executionContext) _RuntimeStatus(ctx context.Context, sel ast.Selectio
 	return out
 }
 
+var workerNodePoolImplementors = []string{"WorkerNodePool"}
+
+func (ec *executionContext) _WorkerNodePool(ctx context.Context, sel ast.SelectionSet, obj *WorkerNodePool) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, workerNodePoolImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("WorkerNodePool")
+		case "name":
+			out.Values[i] = ec._WorkerNodePool_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "machineType":
+			out.Values[i] = ec._WorkerNodePool_machineType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "volumeSizeGB":
+			out.Values[i] = ec._WorkerNodePool_volumeSizeGB(ctx, field, obj)
+		case "autoScalerMin":
+			out.Values[i] = ec._WorkerNodePool_autoScalerMin(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "autoScalerMax":
+			out.Values[i] = ec._WorkerNodePool_autoScalerMax(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var __DirectiveImplementors = []string{"__Directive"}
 
 func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
@@ -7972,6 +9687,32 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCertIssuer2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuer(ctx context.Context, sel ast.SelectionSet, v CertIssuer) graphql.Marshaler {
+	return ec._CertIssuer(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCertIssuer2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuer(ctx context.Context, sel ast.SelectionSet, v *CertIssuer) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._CertIssuer(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNCertIssuerInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInput(ctx context.Context, v interface{}) (CertIssuerInput, error) {
+	return ec.unmarshalInputCertIssuerInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNCertIssuerInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInput(ctx context.Context, v interface{}) (*CertIssuerInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNCertIssuerInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) unmarshalNClusterConfigInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐClusterConfigInput(ctx context.Context, v interface{}) (ClusterConfigInput, error) {
 	return ec.unmarshalInputClusterConfigInput(ctx, v)
 }
@@ -8042,6 +9783,32 @@ func (ec *executionContext) unmarshalNGardenerUpgradeInput2ᚖgithubᚗcomᚋkym
 	return &res, err
 }
 
+func (ec *executionContext) marshalNHibernationSchedule2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationSchedule(ctx context.Context, sel ast.SelectionSet, v HibernationSchedule) graphql.Marshaler {
+	return ec._HibernationSchedule(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNHibernationSchedule2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationSchedule(ctx context.Context, sel ast.SelectionSet, v *HibernationSchedule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._HibernationSchedule(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNHibernationScheduleInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInput(ctx context.Context, v interface{}) (HibernationScheduleInput, error) {
+	return ec.unmarshalInputHibernationScheduleInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNHibernationScheduleInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInput(ctx context.Context, v interface{}) (*HibernationScheduleInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNHibernationScheduleInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
 	return graphql.UnmarshalInt(v)
 }
@@ -8086,29 +9853,94 @@ func (ec *executionContext) marshalNOperationType2githubᚗcomᚋkymaᚑproject
 	return v
 }
 
-func (ec *executionContext) unmarshalNProviderSpecificInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx context.Context, v interface{}) (ProviderSpecificInput, error) {
-	return ec.unmarshalInputProviderSpecificInput(ctx, v)
+func (ec *executionContext) marshalNPageInfo2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v PageInfo) graphql.Marshaler {
+	return ec._PageInfo(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPageInfo2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐPageInfo(ctx context.Context, sel ast.SelectionSet, v *PageInfo) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._PageInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNProviderSpecificInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx context.Context, v interface{}) (ProviderSpecificInput, error) {
+	return ec.unmarshalInputProviderSpecificInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNProviderSpecificInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx context.Context, v interface{}) (*ProviderSpecificInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNProviderSpecificInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) unmarshalNProvisionRuntimeInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProvisionRuntimeInput(ctx context.Context, v interface{}) (ProvisionRuntimeInput, error) {
+	return ec.unmarshalInputProvisionRuntimeInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx context.Context, v interface{}) (RuntimeAgentConnectionStatus, error) {
+	var res RuntimeAgentConnectionStatus
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx context.Context, sel ast.SelectionSet, v RuntimeAgentConnectionStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNRuntimeEdge2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeEdge(ctx context.Context, sel ast.SelectionSet, v RuntimeEdge) graphql.Marshaler {
+	return ec._RuntimeEdge(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRuntimeEdge2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeEdgeᚄ(ctx context.Context, sel ast.SelectionSet, v []*RuntimeEdge) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNRuntimeEdge2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeEdge(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
 }
 
-func (ec *executionContext) unmarshalNProviderSpecificInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx context.Context, v interface{}) (*ProviderSpecificInput, error) {
+func (ec *executionContext) marshalNRuntimeEdge2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeEdge(ctx context.Context, sel ast.SelectionSet, v *RuntimeEdge) graphql.Marshaler {
 	if v == nil {
-		return nil, nil
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
 	}
-	res, err := ec.unmarshalNProviderSpecificInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProviderSpecificInput(ctx, v)
-	return &res, err
-}
-
-func (ec *executionContext) unmarshalNProvisionRuntimeInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐProvisionRuntimeInput(ctx context.Context, v interface{}) (ProvisionRuntimeInput, error) {
-	return ec.unmarshalInputProvisionRuntimeInput(ctx, v)
-}
-
-func (ec *executionContext) unmarshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx context.Context, v interface{}) (RuntimeAgentConnectionStatus, error) {
-	var res RuntimeAgentConnectionStatus
-	return res, res.UnmarshalGQL(v)
-}
-
-func (ec *executionContext) marshalNRuntimeAgentConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeAgentConnectionStatus(ctx context.Context, sel ast.SelectionSet, v RuntimeAgentConnectionStatus) graphql.Marshaler {
-	return v
+	return ec._RuntimeEdge(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNRuntimeInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeInput(ctx context.Context, v interface{}) (RuntimeInput, error) {
@@ -8123,6 +9955,20 @@ func (ec *executionContext) unmarshalNRuntimeInput2ᚖgithubᚗcomᚋkymaᚑproj
 	return &res, err
 }
 
+func (ec *executionContext) marshalNRuntimeStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeStatus(ctx context.Context, sel ast.SelectionSet, v RuntimeStatus) graphql.Marshaler {
+	return ec._RuntimeStatus(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRuntimeStatus2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeStatus(ctx context.Context, sel ast.SelectionSet, v *RuntimeStatus) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._RuntimeStatus(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -8174,6 +10020,32 @@ func (ec *executionContext) unmarshalNUpgradeShootInput2githubᚗcomᚋkymaᚑpr
 	return ec.unmarshalInputUpgradeShootInput(ctx, v)
 }
 
+func (ec *executionContext) marshalNWorkerNodePool2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePool(ctx context.Context, sel ast.SelectionSet, v WorkerNodePool) graphql.Marshaler {
+	return ec._WorkerNodePool(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNWorkerNodePool2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePool(ctx context.Context, sel ast.SelectionSet, v *WorkerNodePool) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._WorkerNodePool(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNWorkerNodePoolInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInput(ctx context.Context, v interface{}) (WorkerNodePoolInput, error) {
+	return ec.unmarshalInputWorkerNodePoolInput(ctx, v)
+}
+
+func (ec *executionContext) unmarshalNWorkerNodePoolInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInput(ctx context.Context, v interface{}) (*WorkerNodePoolInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalNWorkerNodePoolInput2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInput(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
 	return ec.___Directive(ctx, sel, &v)
 }
@@ -8530,6 +10402,66 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return ec.marshalOBoolean2bool(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOCertIssuer2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerᚄ(ctx context.Context, sel ast.SelectionSet, v []*CertIssuer) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNCertIssuer2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuer(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOCertIssuerInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInputᚄ(ctx context.Context, v interface{}) ([]*CertIssuerInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*CertIssuerInput, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNCertIssuerInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐCertIssuerInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOComponentConfiguration2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐComponentConfiguration(ctx context.Context, sel ast.SelectionSet, v ComponentConfiguration) graphql.Marshaler {
 	return ec._ComponentConfiguration(ctx, sel, &v)
 }
@@ -8869,6 +10801,66 @@ func (ec *executionContext) marshalOGardenerConfig2ᚖgithubᚗcomᚋkymaᚑproj
 	return ec._GardenerConfig(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOHibernationSchedule2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleᚄ(ctx context.Context, sel ast.SelectionSet, v []*HibernationSchedule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNHibernationSchedule2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationSchedule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOHibernationScheduleInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInputᚄ(ctx context.Context, v interface{}) ([]*HibernationScheduleInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*HibernationScheduleInput, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNHibernationScheduleInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationScheduleInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalOHibernationStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐHibernationStatus(ctx context.Context, sel ast.SelectionSet, v HibernationStatus) graphql.Marshaler {
 	return ec._HibernationStatus(ctx, sel, &v)
 }
@@ -9011,6 +11003,30 @@ func (ec *executionContext) unmarshalOOpenStackProviderConfigInput2ᚖgithubᚗc
 	return &res, err
 }
 
+func (ec *executionContext) unmarshalOOperationState2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx context.Context, v interface{}) (OperationState, error) {
+	var res OperationState
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalOOperationState2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx context.Context, sel ast.SelectionSet, v OperationState) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalOOperationState2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx context.Context, v interface{}) (*OperationState, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOOperationState2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOOperationState2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationState(ctx context.Context, sel ast.SelectionSet, v *OperationState) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) marshalOOperationStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐOperationStatus(ctx context.Context, sel ast.SelectionSet, v OperationStatus) graphql.Marshaler {
 	return ec._OperationStatus(ctx, sel, &v)
 }
@@ -9052,6 +11068,17 @@ func (ec *executionContext) marshalORuntimeConfig2ᚖgithubᚗcomᚋkymaᚑproje
 	return ec._RuntimeConfig(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORuntimeConnection2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnection(ctx context.Context, sel ast.SelectionSet, v RuntimeConnection) graphql.Marshaler {
+	return ec._RuntimeConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalORuntimeConnection2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnection(ctx context.Context, sel ast.SelectionSet, v *RuntimeConnection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RuntimeConnection(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalORuntimeConnectionStatus2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimeConnectionStatus(ctx context.Context, sel ast.SelectionSet, v RuntimeConnectionStatus) graphql.Marshaler {
 	return ec._RuntimeConnectionStatus(ctx, sel, &v)
 }
@@ -9074,6 +11101,18 @@ func (ec *executionContext) marshalORuntimeStatus2ᚖgithubᚗcomᚋkymaᚑproje
 	return ec._RuntimeStatus(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalORuntimesFilter2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimesFilter(ctx context.Context, v interface{}) (RuntimesFilter, error) {
+	return ec.unmarshalInputRuntimesFilter(ctx, v)
+}
+
+func (ec *executionContext) unmarshalORuntimesFilter2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimesFilter(ctx context.Context, v interface{}) (*RuntimesFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalORuntimesFilter2githubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐRuntimesFilter(ctx, v)
+	return &res, err
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -9129,6 +11168,66 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return ec.marshalOString2string(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOWorkerNodePool2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolᚄ(ctx context.Context, sel ast.SelectionSet, v []*WorkerNodePool) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNWorkerNodePool2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePool(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) unmarshalOWorkerNodePoolInput2ᚕᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInputᚄ(ctx context.Context, v interface{}) ([]*WorkerNodePoolInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]*WorkerNodePoolInput, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNWorkerNodePoolInput2ᚖgithubᚗcomᚋkymaᚑprojectᚋcontrolᚑplaneᚋcomponentsᚋprovisionerᚋpkgᚋgqlschemaᚐWorkerNodePoolInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.EnumValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
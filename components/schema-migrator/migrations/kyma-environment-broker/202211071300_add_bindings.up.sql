@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS bindings (
+    id varchar(255) NOT NULL,
+    instance_id varchar(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    role varchar(255) NOT NULL,
+    service_account_name varchar(255) NOT NULL,
+    kubeconfig text NOT NULL,
+    PRIMARY KEY (instance_id, id)
+);
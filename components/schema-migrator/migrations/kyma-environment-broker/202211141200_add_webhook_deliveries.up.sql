@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id varchar(255) PRIMARY KEY,
+    webhook varchar(255) NOT NULL,
+    event varchar(64) NOT NULL,
+    operation_id varchar(255) NOT NULL,
+    instance_id varchar(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    payload text NOT NULL,
+    state varchar(32) NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    response_code integer NOT NULL DEFAULT 0,
+    last_error text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_operation_id ON webhook_deliveries (operation_id);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_state ON webhook_deliveries (state);
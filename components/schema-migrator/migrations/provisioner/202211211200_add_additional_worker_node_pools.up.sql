@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN additional_worker_node_pools jsonb;
+COMMIT;
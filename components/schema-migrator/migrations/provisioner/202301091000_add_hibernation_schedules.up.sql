@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN hibernation_schedules jsonb;
+COMMIT;
@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"text/template"
 
 	"golang.org/x/oauth2"
 
 	"github.com/pkg/errors"
 
 	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/client"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
 	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/runtime"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/credential"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
@@ -27,6 +29,17 @@ type KubeconfigCommand struct {
 	subAccountID    string
 	runtimeID       string
 	outputPath      string
+	exec            bool
+	scope           client.Scope
+
+	targetInputs        []string
+	targetExcludeInputs []string
+	targets             orchestration.TargetSpec
+	parallelism         int
+	outputDir           string
+	merge               bool
+	contextName         string
+	contextTemplate     *template.Template
 }
 
 type kubeconfig struct {
@@ -51,20 +64,41 @@ The Runtime can be specified by one of the following:
   - Global account / Runtime ID pair with the --account and --runtime-id options
   - Shoot cluster name with the --shoot option.
 
-By default, the kubeconfig file is saved to the current directory. The output file name can be specified using the --output option.`,
+By default, the kubeconfig file is saved to the current directory. The output file name can be specified using the --output option.
+With the --target option, the kubeconfig files of all Runtimes matching the targets are downloaded in parallel. Each file is saved as {CLUSTER NAME}.yaml in the directory specified using the --output-dir option.
+The targets are resolved using Gardener, so the --gardener-kubeconfig and --gardener-namespace options are required.
+With the --merge option, the kubeconfig files are merged into one file instead, which is specified using the --output option. The name of the context of each Runtime is built from the --context-name template,
+which can use the following fields of the Runtime: .ShootName, .GlobalAccountID, .SubAccountID, .RuntimeID, .InstanceID, .Plan, .Region.
+The access is granted with the role of your group. Use the --role and --namespace options to request a narrower role or to limit the access to a namespace, if your group allows it.
+With the --exec option, the kubeconfig file does not contain a static token. Instead, it uses the kcp kubeconfig credential command to get short-lived tokens, which are cached until they expire.`,
 		Example: `  kcp kubeconfig -g GAID -s SAID -o /my/path/runtime.config  Downloads the kubeconfig file using global account ID and subaccount ID.
   kcp kubeconfig -g GAID -r RUNTIMEID                    Downloads the kubeconfig file using global account ID and Runtime ID.
-  kcp kubeconfig -c c-178e034                            Downloads the kubeconfig file using a Shoot cluster name.`,
+  kcp kubeconfig -c c-178e034                            Downloads the kubeconfig file using a Shoot cluster name.
+  kcp kubeconfig -c c-178e034 --exec                     Downloads the kubeconfig file which uses short-lived tokens.
+  kcp kubeconfig -c c-178e034 --role viewer -n foo       Downloads the kubeconfig file with the viewer role in the foo namespace.
+  kcp kubeconfig -t account=GAID -d /my/path             Downloads the kubeconfig files of all Runtimes of the global account.
+  kcp kubeconfig -t plan=trial --merge -o trial.yaml --context-name "{{ .Plan }}-{{ .SubAccountID }}"
+    Downloads the kubeconfig files of all trial Runtimes and merges them into one file.`,
 		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
 	}
 	cmd.cobraCmd = cobraCmd
 
-	cobraCmd.Flags().StringVarP(&cmd.outputPath, "output", "o", "", "Path to the file to save the downloaded kubeconfig to. Defaults to {CLUSTER NAME}.yaml in the current directory if not specified.")
+	cobraCmd.Flags().StringVarP(&cmd.outputPath, "output", "o", "", "Path to the file to save the downloaded kubeconfig to. Defaults to {CLUSTER NAME}.yaml, or kubeconfig.yaml with the --merge option, in the current directory if not specified.")
 	cobraCmd.Flags().StringVarP(&cmd.globalAccountID, "account", "g", "", "Global account ID of the specific Kyma Runtime.")
 	cobraCmd.Flags().StringVarP(&cmd.subAccountID, "subaccount", "s", "", "Subccount ID of the specific Kyma Runtime.")
 	cobraCmd.Flags().StringVarP(&cmd.runtimeID, "runtime-id", "r", "", "Runtime ID of the specific Kyma Runtime.")
 	cobraCmd.Flags().StringVarP(&cmd.shoot, "shoot", "c", "", "Shoot cluster name of the specific Kyma Runtime.")
+	cobraCmd.Flags().StringVar(&cmd.scope.Role, "role", "", "Role of the kubeconfig. Defaults to the role of your group if not specified.")
+	cobraCmd.Flags().StringVarP(&cmd.scope.Namespace, "namespace", "n", "", "Namespace the access of the kubeconfig is limited to.")
+	SetRuntimeTargetOpts(cobraCmd, &cmd.targetInputs, &cmd.targetExcludeInputs)
+	cobraCmd.Flags().IntVarP(&cmd.parallelism, "parallelism", "p", 4, "Number of parallel downloads with the --target option.")
+	cobraCmd.Flags().StringVarP(&cmd.outputDir, "output-dir", "d", "", "Directory to save the kubeconfig files of the targets to. Defaults to the current directory if not specified.")
+	cobraCmd.Flags().BoolVar(&cmd.merge, "merge", false, "Merges the kubeconfig files of the targets into one file.")
+	cobraCmd.Flags().StringVar(&cmd.contextName, "context-name", defaultContextNameTemplate, "Template of the context names in the merged kubeconfig file.")
+	cobraCmd.Flags().BoolVar(&cmd.exec, "exec", false, "Downloads the kubeconfig file which uses the kcp credential helper to get short-lived tokens.")
+
+	cobraCmd.AddCommand(NewKubeconfigCredentialCmd())
 
 	return cobraCmd
 }
@@ -75,6 +109,14 @@ func (cmd *KubeconfigCommand) Run() error {
 	cred := CLICredentialManager(cmd.log)
 	client := client.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KubeconfigAPIURL(), cred)
 
+	if len(cmd.targetInputs) > 0 {
+		runtimes, err := resolveRuntimeTargets(cmd.cobraCmd.Context(), cred, cmd.log, cmd.targets)
+		if err != nil {
+			return err
+		}
+		return cmd.runTargets(client, runtimes)
+	}
+
 	// Resolve Global Account / Subaccount, or Shoot name to Global Account / Runtime ID
 	if cmd.globalAccountID == "" || cmd.runtimeID == "" {
 		err := cmd.resolveRuntimeAttributes(cmd.cobraCmd.Context(), cred)
@@ -82,7 +124,7 @@ func (cmd *KubeconfigCommand) Run() error {
 			return errors.Wrap(err, "while resolving runtime")
 		}
 	}
-	kc, err := client.GetKubeConfig(cmd.globalAccountID, cmd.runtimeID)
+	kc, err := cmd.getKubeconfig(client, cmd.globalAccountID, cmd.runtimeID)
 	if err != nil {
 		return errors.Wrap(err, "while getting kubeconfig")
 	}
@@ -90,15 +132,80 @@ func (cmd *KubeconfigCommand) Run() error {
 	return err
 }
 
+func (cmd *KubeconfigCommand) getKubeconfig(kcClient client.Client, globalAccountID, runtimeID string) (string, error) {
+	if cmd.exec {
+		return kcClient.GetExecKubeConfig(globalAccountID, runtimeID, cmd.scope)
+	}
+	return kcClient.GetScopedKubeConfig(globalAccountID, runtimeID, cmd.scope)
+}
+
 // Validate checks the input parameters of the kubeconfig command
 func (cmd *KubeconfigCommand) Validate() error {
 	if GlobalOpts.KubeconfigAPIURL() == "" {
 		return fmt.Errorf("missing required %s option", GlobalOpts.kubeconfigAPIURL)
 	}
+	if len(cmd.targetInputs) > 0 {
+		return cmd.validateTargets()
+	}
+	if cmd.merge || cmd.outputDir != "" {
+		return errors.New("the merge and output-dir options can only be used with the target option")
+	}
 	if cmd.globalAccountID != "" && (cmd.subAccountID != "" || cmd.runtimeID != "") || cmd.shoot != "" {
 		return nil
 	}
-	return errors.New("at least one of the following options have to be specified: account/subaccount, account/runtime-id, shoot")
+	return errors.New("at least one of the following options have to be specified: account/subaccount, account/runtime-id, shoot, target")
+}
+
+func (cmd *KubeconfigCommand) validateTargets() error {
+	if cmd.globalAccountID != "" || cmd.subAccountID != "" || cmd.runtimeID != "" || cmd.shoot != "" {
+		return errors.New("the target option cannot be used together with the account, subaccount, runtime-id, and shoot options")
+	}
+	if GlobalOpts.GardenerKubeconfig() == "" || GlobalOpts.GardenerNamespace() == "" {
+		return fmt.Errorf("missing required %s/%s options", GlobalOpts.gardenerKubeconfig, GlobalOpts.gardenerNamespace)
+	}
+	err := ValidateTransformRuntimeTargetOpts(cmd.targetInputs, cmd.targetExcludeInputs, &cmd.targets)
+	if err != nil {
+		return err
+	}
+	if cmd.parallelism < 1 {
+		return errors.New("parallelism must be at least 1")
+	}
+
+	if cmd.merge {
+		if cmd.outputDir != "" {
+			return errors.New("the output-dir option cannot be used together with the merge option, use the output option instead")
+		}
+		cmd.contextTemplate, err = template.New("context-name").Parse(cmd.contextName)
+		if err != nil {
+			return errors.Wrap(err, "while parsing context name template")
+		}
+		// detect unknown fields before the kubeconfigs are downloaded
+		if err := cmd.contextTemplate.Execute(ioutil.Discard, orchestration.Runtime{}); err != nil {
+			return errors.Wrap(err, "while validating context name template")
+		}
+		if cmd.outputPath == "" {
+			cmd.outputPath = "kubeconfig.yaml"
+		}
+		return nil
+	}
+
+	if cmd.outputPath != "" {
+		return errors.New("the output option cannot be used together with the target option without the merge option, use the output-dir option instead")
+	}
+	if cmd.outputDir == "" {
+		cmd.outputDir, err = os.Getwd()
+		if err != nil {
+			return errors.Wrap(err, "while getting current directory")
+		}
+	}
+	fi, err := os.Stat(cmd.outputDir)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s: not a directory", cmd.outputDir)
+	}
+	return nil
 }
 
 func (cmd *KubeconfigCommand) resolveRuntimeAttributes(ctx context.Context, cred credential.Manager) error {
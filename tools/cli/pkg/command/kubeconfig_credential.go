@@ -0,0 +1,157 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/client"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+	"k8s.io/client-go/util/homedir"
+)
+
+// tokenExpiryDelta is the time before the expiration when the cached token is not used anymore,
+// so that the token does not expire while a request is processed
+const tokenExpiryDelta = 30 * time.Second
+
+var defaultCredentialCacheDir = filepath.Join(homedir.HomeDir(), ".kube", "cache", "kcp")
+
+// KubeconfigCredentialCommand represents an execution of the kcp kubeconfig credential command
+type KubeconfigCredentialCommand struct {
+	cobraCmd        *cobra.Command
+	log             logger.Logger
+	globalAccountID string
+	runtimeID       string
+	scope           client.Scope
+	cacheDir        string
+	out             io.Writer
+	now             func() time.Time
+}
+
+// NewKubeconfigCredentialCmd constructs a new instance of KubeconfigCredentialCommand and configures it in terms of a cobra.Command
+func NewKubeconfigCredentialCmd() *cobra.Command {
+	cmd := KubeconfigCredentialCommand{out: os.Stdout, now: time.Now}
+	cobraCmd := &cobra.Command{
+		Use:   "credential",
+		Short: "Prints a short-lived token for a given Kyma Runtime in the ExecCredential format",
+		Long: `Prints a short-lived token for a given Kyma Runtime in the ExecCredential format.
+The command is the credential helper used by the kubeconfig files downloaded with the kcp kubeconfig --exec command and is usually not called directly.
+The token is cached in the cache directory until it expires.`,
+		Example: `  kcp kubeconfig credential -g GAID -r RUNTIMEID    Prints a token for the Runtime.`,
+		Args:    cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.globalAccountID, "account", "g", "", "Global account ID of the specific Kyma Runtime.")
+	cobraCmd.Flags().StringVarP(&cmd.runtimeID, "runtime-id", "r", "", "Runtime ID of the specific Kyma Runtime.")
+	cobraCmd.Flags().StringVar(&cmd.scope.Role, "role", "", "Role of the token. Defaults to the role of your group if not specified.")
+	cobraCmd.Flags().StringVarP(&cmd.scope.Namespace, "namespace", "n", "", "Namespace the access of the token is limited to.")
+	cobraCmd.Flags().StringVar(&cmd.cacheDir, "cache-dir", defaultCredentialCacheDir, "Path to the directory where the tokens are cached.")
+
+	return cobraCmd
+}
+
+// Run executes the kubeconfig credential command
+func (cmd *KubeconfigCredentialCommand) Run() error {
+	cmd.log = logger.New()
+	cred := CLICredentialManager(cmd.log)
+	kcClient := client.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KubeconfigAPIURL(), cred)
+
+	credential, err := cmd.credential(kcClient)
+	if err != nil {
+		return err
+	}
+	return json.NewEncoder(cmd.out).Encode(credential)
+}
+
+// Validate checks the input parameters of the kubeconfig credential command
+func (cmd *KubeconfigCredentialCommand) Validate() error {
+	if GlobalOpts.KubeconfigAPIURL() == "" {
+		return fmt.Errorf("missing required %s option", GlobalOpts.kubeconfigAPIURL)
+	}
+	if cmd.globalAccountID == "" || cmd.runtimeID == "" {
+		return errors.New("both the account and the runtime-id options have to be specified")
+	}
+	return nil
+}
+
+// credential returns the cached token if it is still valid, otherwise it gets a new token and caches it
+func (cmd *KubeconfigCredentialCommand) credential(kcClient client.Client) (*clientauthenticationv1.ExecCredential, error) {
+	path := filepath.Join(cmd.cacheDir, cmd.cacheFileName())
+	if credential, ok := cmd.cachedCredential(path); ok {
+		return credential, nil
+	}
+
+	credential, err := kcClient.GetToken(cmd.globalAccountID, cmd.runtimeID, cmd.scope)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting token")
+	}
+	credential.APIVersion = clientauthenticationv1.SchemeGroupVersion.String()
+	credential.Kind = "ExecCredential"
+
+	if err := cmd.cacheCredential(path, credential); err != nil {
+		// the token can be used even if it cannot be cached
+		cmd.logWarning("Unable to cache the token: %s", err)
+	}
+	return credential, nil
+}
+
+// cacheFileName returns the name of the cache file of the runtime, the tokens of different scopes are cached separately
+func (cmd *KubeconfigCredentialCommand) cacheFileName() string {
+	name := fmt.Sprintf("%s_%s", cmd.globalAccountID, cmd.runtimeID)
+	if cmd.scope.Role != "" {
+		name = fmt.Sprintf("%s_%s", name, cmd.scope.Role)
+	}
+	if cmd.scope.Namespace != "" {
+		name = fmt.Sprintf("%s_ns-%s", name, cmd.scope.Namespace)
+	}
+	return name + ".json"
+}
+
+func (cmd *KubeconfigCredentialCommand) cachedCredential(path string) (*clientauthenticationv1.ExecCredential, bool) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	credential := &clientauthenticationv1.ExecCredential{}
+	if err := json.Unmarshal(data, credential); err != nil {
+		return nil, false
+	}
+	if credential.Status == nil || credential.Status.Token == "" || credential.Status.ExpirationTimestamp == nil {
+		return nil, false
+	}
+	if !cmd.now().Add(tokenExpiryDelta).Before(credential.Status.ExpirationTimestamp.Time) {
+		return nil, false
+	}
+	return credential, true
+}
+
+func (cmd *KubeconfigCredentialCommand) cacheCredential(path string, credential *clientauthenticationv1.ExecCredential) error {
+	if credential.Status == nil || credential.Status.ExpirationTimestamp == nil {
+		// a token without the expiration is never cached
+		return nil
+	}
+	data, err := json.Marshal(credential)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0600)
+}
+
+func (cmd *KubeconfigCredentialCommand) logWarning(format string, args ...interface{}) {
+	if cmd.log != nil {
+		cmd.log.Warnf(format, args...)
+	}
+}
@@ -0,0 +1,153 @@
+package command
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientauthenticationv1 "k8s.io/client-go/pkg/apis/clientauthentication/v1"
+)
+
+type fakeKubeconfigClient struct {
+	// kubeconfigs by runtime ID
+	kubeconfigs map[string]string
+	tokens      []string
+	expiry      time.Time
+	err         error
+	calls       int
+}
+
+func (c *fakeKubeconfigClient) GetKubeConfig(_, _ string) (string, error) {
+	return "", nil
+}
+
+func (c *fakeKubeconfigClient) GetScopedKubeConfig(_, runtimeID string, _ client.Scope) (string, error) {
+	return c.kubeconfig(runtimeID)
+}
+
+func (c *fakeKubeconfigClient) GetExecKubeConfig(_, runtimeID string, _ client.Scope) (string, error) {
+	return c.kubeconfig(runtimeID)
+}
+
+func (c *fakeKubeconfigClient) kubeconfig(runtimeID string) (string, error) {
+	kubeconfig, found := c.kubeconfigs[runtimeID]
+	if !found {
+		return "", errors.New("runtime not found")
+	}
+	return kubeconfig, nil
+}
+
+func (c *fakeKubeconfigClient) GetToken(_, _ string, _ client.Scope) (*clientauthenticationv1.ExecCredential, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	token := c.tokens[c.calls]
+	c.calls++
+	expiry := metav1.NewTime(c.expiry)
+	return &clientauthenticationv1.ExecCredential{
+		Status: &clientauthenticationv1.ExecCredentialStatus{Token: token, ExpirationTimestamp: &expiry},
+	}, nil
+}
+
+func TestKubeconfigCredentialCommand_Credential(t *testing.T) {
+	now := time.Date(2022, 10, 17, 10, 0, 0, 0, time.UTC)
+
+	t.Run("should cache the token until it expires", func(t *testing.T) {
+		// given
+		cmd := KubeconfigCredentialCommand{globalAccountID: "ga", runtimeID: "runtime", cacheDir: t.TempDir(), now: func() time.Time { return now }}
+		kcClient := &fakeKubeconfigClient{tokens: []string{"first", "second"}, expiry: now.Add(10 * time.Minute)}
+
+		// when
+		first, err := cmd.credential(kcClient)
+		require.NoError(t, err)
+		cached, err := cmd.credential(kcClient)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, "first", first.Status.Token)
+		assert.Equal(t, "ExecCredential", first.Kind)
+		assert.Equal(t, "client.authentication.k8s.io/v1", first.APIVersion)
+		assert.Equal(t, "first", cached.Status.Token)
+		assert.Equal(t, 1, kcClient.calls)
+
+		// when the token is about to expire
+		cmd.now = func() time.Time { return now.Add(10*time.Minute - tokenExpiryDelta) }
+		renewed, err := cmd.credential(kcClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "second", renewed.Status.Token)
+		assert.Equal(t, 2, kcClient.calls)
+	})
+
+	t.Run("should cache the tokens of runtimes separately", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		kcClient := &fakeKubeconfigClient{tokens: []string{"first", "second"}, expiry: now.Add(10 * time.Minute)}
+		cmd := KubeconfigCredentialCommand{globalAccountID: "ga", runtimeID: "runtime-1", cacheDir: dir, now: func() time.Time { return now }}
+		_, err := cmd.credential(kcClient)
+		require.NoError(t, err)
+		cmd.runtimeID = "runtime-2"
+
+		// when
+		credential, err := cmd.credential(kcClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "second", credential.Status.Token)
+		info, err := os.Stat(filepath.Join(dir, "ga_runtime-2.json"))
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+
+	t.Run("should cache the tokens of scopes separately", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		kcClient := &fakeKubeconfigClient{tokens: []string{"first", "second"}, expiry: now.Add(10 * time.Minute)}
+		cmd := KubeconfigCredentialCommand{globalAccountID: "ga", runtimeID: "runtime", cacheDir: dir, now: func() time.Time { return now }}
+		_, err := cmd.credential(kcClient)
+		require.NoError(t, err)
+		cmd.scope = client.Scope{Role: "viewer", Namespace: "foo"}
+
+		// when
+		credential, err := cmd.credential(kcClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "second", credential.Status.Token)
+		assert.FileExists(t, filepath.Join(dir, "ga_runtime_viewer_ns-foo.json"))
+	})
+
+	t.Run("should ignore invalid cache", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ga_runtime.json"), []byte("not a credential"), 0600))
+		cmd := KubeconfigCredentialCommand{globalAccountID: "ga", runtimeID: "runtime", cacheDir: dir, now: func() time.Time { return now }}
+		kcClient := &fakeKubeconfigClient{tokens: []string{"first"}, expiry: now.Add(10 * time.Minute)}
+
+		// when
+		credential, err := cmd.credential(kcClient)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "first", credential.Status.Token)
+	})
+
+	t.Run("should return error when the token cannot be requested", func(t *testing.T) {
+		// given
+		cmd := KubeconfigCredentialCommand{globalAccountID: "ga", runtimeID: "runtime", cacheDir: t.TempDir(), now: func() time.Time { return now }}
+		kcClient := &fakeKubeconfigClient{err: errors.New("forbidden")}
+
+		// when
+		_, err := cmd.credential(kcClient)
+
+		// then
+		assert.EqualError(t, err, "while getting token: forbidden")
+	})
+}
@@ -0,0 +1,163 @@
+package command
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+	"text/template"
+
+	"github.com/kyma-project/control-plane/components/kubeconfig-service/pkg/client"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const defaultContextNameTemplate = "{{ .ShootName }}"
+
+// runtimeKubeconfig is the kubeconfig downloaded for one of the target runtimes
+type runtimeKubeconfig struct {
+	runtime    orchestration.Runtime
+	kubeconfig string
+	err        error
+}
+
+// KubeconfigExportError represents failure in downloading the kubeconfig of one or more runtimes
+type KubeconfigExportError struct {
+	failed int
+	total  int
+}
+
+func (e *KubeconfigExportError) Error() string {
+	return fmt.Sprintf("%d/%d kubeconfig(s) failed", e.failed, e.total)
+}
+
+// runTargets downloads the kubeconfigs of all runtimes matching the targets, and either saves them to separate files or merges them into one file
+func (cmd *KubeconfigCommand) runTargets(kcClient client.Client, runtimes []orchestration.Runtime) error {
+	results := cmd.fetchKubeconfigs(kcClient, runtimes)
+
+	downloaded := make([]runtimeKubeconfig, 0, len(results))
+	for _, result := range results {
+		if result.err != nil {
+			cmd.log.Errorf("while getting kubeconfig of runtime %s (%s): %s", result.runtime.ShootName, result.runtime.RuntimeID, result.err)
+			continue
+		}
+		downloaded = append(downloaded, result)
+	}
+
+	var err error
+	if cmd.merge {
+		err = cmd.saveMergedKubeconfig(downloaded)
+	} else {
+		err = cmd.saveRuntimeKubeconfigs(downloaded)
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed := len(results) - len(downloaded); failed > 0 {
+		return &KubeconfigExportError{failed: failed, total: len(results)}
+	}
+	return nil
+}
+
+// fetchKubeconfigs downloads the kubeconfigs of the runtimes in parallel, the results are sorted by the shoot name
+func (cmd *KubeconfigCommand) fetchKubeconfigs(kcClient client.Client, runtimes []orchestration.Runtime) []runtimeKubeconfig {
+	results := make([]runtimeKubeconfig, len(runtimes))
+	workers := make(chan struct{}, cmd.parallelism)
+	var wg sync.WaitGroup
+	for i, rt := range runtimes {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, rt orchestration.Runtime) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			kubeconfig, err := cmd.getKubeconfig(kcClient, rt.GlobalAccountID, rt.RuntimeID)
+			results[i] = runtimeKubeconfig{runtime: rt, kubeconfig: kubeconfig, err: err}
+		}(i, rt)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].runtime.ShootName < results[j].runtime.ShootName
+	})
+	return results
+}
+
+func (cmd *KubeconfigCommand) saveRuntimeKubeconfigs(kubeconfigs []runtimeKubeconfig) error {
+	for _, kc := range kubeconfigs {
+		path := filepath.Join(cmd.outputDir, fmt.Sprintf("%s.yaml", kc.runtime.ShootName))
+		err := ioutil.WriteFile(path, []byte(kc.kubeconfig), 0600)
+		if err != nil {
+			return errors.Wrapf(err, "while saving kubeconfig of runtime %s", kc.runtime.ShootName)
+		}
+	}
+	fmt.Printf("%d kubeconfig(s) saved to %s\n", len(kubeconfigs), cmd.outputDir)
+	return nil
+}
+
+func (cmd *KubeconfigCommand) saveMergedKubeconfig(kubeconfigs []runtimeKubeconfig) error {
+	merged, err := mergeKubeconfigs(kubeconfigs, cmd.contextTemplate)
+	if err != nil {
+		return errors.Wrap(err, "while merging kubeconfigs")
+	}
+	err = clientcmd.WriteToFile(*merged, cmd.outputPath)
+	if err != nil {
+		return errors.Wrap(err, "while saving kubeconfig")
+	}
+	fmt.Printf("%d context(s) saved to %s\n", len(merged.Contexts), cmd.outputPath)
+	return nil
+}
+
+// mergeKubeconfigs merges the current contexts of the kubeconfigs into one kubeconfig.
+// The context, the cluster, and the user of each runtime are named by the context name template.
+func mergeKubeconfigs(kubeconfigs []runtimeKubeconfig, contextTemplate *template.Template) (*clientcmdapi.Config, error) {
+	merged := clientcmdapi.NewConfig()
+	for _, kc := range kubeconfigs {
+		name, err := contextName(contextTemplate, kc.runtime)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := merged.Contexts[name]; exists {
+			return nil, fmt.Errorf("context name %s of runtime %s is not unique", name, kc.runtime.RuntimeID)
+		}
+
+		config, err := clientcmd.Load([]byte(kc.kubeconfig))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing kubeconfig of runtime %s", kc.runtime.ShootName)
+		}
+		context, found := config.Contexts[config.CurrentContext]
+		if !found {
+			return nil, fmt.Errorf("kubeconfig of runtime %s has no current context", kc.runtime.ShootName)
+		}
+		cluster, found := config.Clusters[context.Cluster]
+		if !found {
+			return nil, fmt.Errorf("kubeconfig of runtime %s has no cluster %s", kc.runtime.ShootName, context.Cluster)
+		}
+		authInfo, found := config.AuthInfos[context.AuthInfo]
+		if !found {
+			return nil, fmt.Errorf("kubeconfig of runtime %s has no user %s", kc.runtime.ShootName, context.AuthInfo)
+		}
+
+		merged.Clusters[name] = cluster
+		merged.AuthInfos[name] = authInfo
+		merged.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name, Namespace: context.Namespace}
+	}
+	return merged, nil
+}
+
+func contextName(contextTemplate *template.Template, rt orchestration.Runtime) (string, error) {
+	var name bytes.Buffer
+	if err := contextTemplate.Execute(&name, rt); err != nil {
+		return "", errors.Wrapf(err, "while building context name of runtime %s", rt.ShootName)
+	}
+	if name.Len() == 0 {
+		return "", fmt.Errorf("context name of runtime %s is empty", rt.ShootName)
+	}
+	return name.String(), nil
+}
@@ -0,0 +1,124 @@
+package command
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const runtimeKubeconfigFmt = `apiVersion: v1
+kind: Config
+current-context: %[1]s
+clusters:
+- name: %[1]s
+  cluster:
+    server: https://api.%[1]s.kyma.example.com
+contexts:
+- name: %[1]s
+  context:
+    cluster: %[1]s
+    user: %[1]s-token
+users:
+- name: %[1]s-token
+  user:
+    token: token-of-%[1]s
+`
+
+func testRuntimes() ([]orchestration.Runtime, map[string]string) {
+	runtimes := []orchestration.Runtime{
+		{RuntimeID: "runtime-2", GlobalAccountID: "ga", SubAccountID: "sa-2", ShootName: "c-2", Plan: "trial"},
+		{RuntimeID: "runtime-1", GlobalAccountID: "ga", SubAccountID: "sa-1", ShootName: "c-1", Plan: "azure"},
+		{RuntimeID: "runtime-3", GlobalAccountID: "ga", SubAccountID: "sa-3", ShootName: "c-3", Plan: "aws"},
+	}
+	kubeconfigs := map[string]string{
+		"runtime-1": fmt.Sprintf(runtimeKubeconfigFmt, "c-1"),
+		"runtime-2": fmt.Sprintf(runtimeKubeconfigFmt, "c-2"),
+	}
+	return runtimes, kubeconfigs
+}
+
+func TestKubeconfigCommand_RunTargets(t *testing.T) {
+	t.Run("should save the kubeconfig of each runtime", func(t *testing.T) {
+		// given
+		runtimes, kubeconfigs := testRuntimes()
+		dir := t.TempDir()
+		cmd := KubeconfigCommand{log: logger.New(), parallelism: 2, outputDir: dir}
+
+		// when
+		err := cmd.runTargets(&fakeKubeconfigClient{kubeconfigs: kubeconfigs}, runtimes)
+
+		// then
+		assert.EqualError(t, err, "1/3 kubeconfig(s) failed")
+		for _, shoot := range []string{"c-1", "c-2"} {
+			config, err := clientcmd.LoadFromFile(filepath.Join(dir, shoot+".yaml"))
+			require.NoError(t, err)
+			assert.Equal(t, shoot, config.CurrentContext)
+		}
+		assert.NoFileExists(t, filepath.Join(dir, "c-3.yaml"))
+	})
+
+	t.Run("should merge the kubeconfigs", func(t *testing.T) {
+		// given
+		runtimes, kubeconfigs := testRuntimes()
+		kubeconfigs["runtime-3"] = fmt.Sprintf(runtimeKubeconfigFmt, "c-3")
+		path := filepath.Join(t.TempDir(), "merged.yaml")
+		cmd := KubeconfigCommand{
+			log:             logger.New(),
+			parallelism:     1,
+			merge:           true,
+			outputPath:      path,
+			contextTemplate: template.Must(template.New("context-name").Parse("{{ .Plan }}-{{ .SubAccountID }}")),
+		}
+
+		// when
+		err := cmd.runTargets(&fakeKubeconfigClient{kubeconfigs: kubeconfigs}, runtimes)
+
+		// then
+		require.NoError(t, err)
+		config, err := clientcmd.LoadFromFile(path)
+		require.NoError(t, err)
+		assert.Len(t, config.Contexts, 3)
+		context := config.Contexts["trial-sa-2"]
+		require.NotNil(t, context)
+		assert.Equal(t, "trial-sa-2", context.Cluster)
+		assert.Equal(t, "trial-sa-2", context.AuthInfo)
+		assert.Equal(t, "https://api.c-2.kyma.example.com", config.Clusters["trial-sa-2"].Server)
+		assert.Equal(t, "token-of-c-2", config.AuthInfos["trial-sa-2"].Token)
+	})
+}
+
+func TestMergeKubeconfigs(t *testing.T) {
+	t.Run("should return error when the context names are not unique", func(t *testing.T) {
+		// given
+		runtimes, kubeconfigs := testRuntimes()
+		downloaded := []runtimeKubeconfig{
+			{runtime: runtimes[0], kubeconfig: kubeconfigs["runtime-2"]},
+			{runtime: runtimes[1], kubeconfig: kubeconfigs["runtime-1"]},
+		}
+
+		// when
+		_, err := mergeKubeconfigs(downloaded, template.Must(template.New("context-name").Parse("{{ .GlobalAccountID }}")))
+
+		// then
+		assert.EqualError(t, err, "context name ga of runtime runtime-1 is not unique")
+	})
+
+	t.Run("should return error when the kubeconfig has no current context", func(t *testing.T) {
+		// given
+		runtimes, _ := testRuntimes()
+		downloaded := []runtimeKubeconfig{{runtime: runtimes[0], kubeconfig: "apiVersion: v1\nkind: Config\n"}}
+
+		// when
+		_, err := mergeKubeconfigs(downloaded, template.Must(template.New("context-name").Parse(defaultContextNameTemplate)))
+
+		// then
+		assert.EqualError(t, err, "kubeconfig of runtime c-2 has no current context")
+	})
+}
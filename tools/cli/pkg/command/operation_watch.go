@@ -0,0 +1,147 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// OperationWatchCommand represents an execution of the kcp operation watch command
+type OperationWatchCommand struct {
+	cobraCmd    *cobra.Command
+	log         logger.Logger
+	output      string
+	operationID string
+	runtimeID   string
+	out         io.Writer
+}
+
+// NewRuntimeOperationCmd constructs the kcp operation command which groups the commands for the Kyma Runtime operations
+func NewRuntimeOperationCmd() *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:     "operation",
+		Aliases: []string{"op"},
+		Short:   "Manages Kyma Runtime operations.",
+		Long:    "Manages the operations processed by Kyma Environment Broker for Kyma Runtimes.",
+	}
+	cobraCmd.AddCommand(NewOperationWatchCmd())
+
+	return cobraCmd
+}
+
+// NewOperationWatchCmd constructs a new instance of OperationWatchCommand and configures it in terms of a cobra.Command
+func NewOperationWatchCmd() *cobra.Command {
+	cmd := OperationWatchCommand{out: os.Stdout}
+	cobraCmd := &cobra.Command{
+		Use:   "watch [OPERATION_ID]",
+		Short: "Displays the progress of an operation live.",
+		Long: `Displays the state changes, the processed steps, and the tracing events of an operation as they happen.
+The command finishes when the operation is finished and fails if the operation failed.
+With the --runtime-id option, the command follows all operations of the given Runtime until it is interrupted.`,
+		Example: `  kcp operation watch 0f9a6a13-796b-4b6f-ac11-2d5cd2c1f1c5     Follow the operation until it is finished.
+  kcp operation watch -r 2b3c4d5e-6f70-4a8b-9c0d-1e2f3a4b5c6d  Follow all operations of the Runtime.
+  kcp op watch 0f9a6a13-796b-4b6f-ac11-2d5cd2c1f1c5 -o json    Display the events in the JSON format, one event per line.`,
+		Args:    cobra.MaximumNArgs(1),
+		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of displayed events. The possible values are: %s, %s.", tableOutput, jsonOutput))
+	cobraCmd.Flags().StringVarP(&cmd.runtimeID, "runtime-id", "r", "", "Runtime ID. Follows all operations of the Runtime instead of a single operation.")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the operation watch command
+func (cmd *OperationWatchCommand) Validate(args []string) error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	if len(args) > 0 {
+		cmd.operationID = args[0]
+	}
+	if cmd.operationID == "" && cmd.runtimeID == "" {
+		return errors.New("either the operation ID or the --runtime-id option must be provided")
+	}
+	if cmd.operationID != "" && cmd.runtimeID != "" {
+		return errors.New("the operation ID and the --runtime-id option cannot be used together")
+	}
+	return nil
+}
+
+// Run executes the operation watch command
+func (cmd *OperationWatchCommand) Run() error {
+	cmd.log = logger.New()
+	ctx := cmd.cobraCmd.Context()
+	httpClient := oauth2.NewClient(ctx, CLICredentialManager(cmd.log))
+	client := watch.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	var last watch.Event
+	handler := func(e watch.Event) error {
+		if e.Type == watch.OperationEventType {
+			last = e
+		}
+		return cmd.printEvent(e)
+	}
+
+	if cmd.runtimeID != "" {
+		return errors.Wrap(client.WatchRuntime(ctx, cmd.runtimeID, handler), "while watching runtime")
+	}
+	err := client.WatchOperation(ctx, cmd.operationID, handler)
+	if err != nil {
+		return errors.Wrap(err, "while watching operation")
+	}
+	if !last.IsFinished() {
+		return fmt.Errorf("connection closed before operation %s finished", cmd.operationID)
+	}
+	if last.Operation.State == "failed" {
+		return fmt.Errorf("operation %s failed: %s", cmd.operationID, last.Operation.Description)
+	}
+	return nil
+}
+
+func (cmd *OperationWatchCommand) printEvent(e watch.Event) error {
+	if cmd.output == jsonOutput {
+		data, err := json.Marshal(e)
+		if err != nil {
+			return errors.Wrap(err, "while encoding event")
+		}
+		_, err = fmt.Fprintln(cmd.out, string(data))
+		return err
+	}
+	_, err := fmt.Fprintln(cmd.out, formatEvent(e))
+	return err
+}
+
+func formatEvent(e watch.Event) string {
+	timestamp := e.Time
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	prefix := fmt.Sprintf("%s  %s", timestamp.Local().Format("15:04:05"), e.OperationID)
+
+	switch {
+	case e.Type == watch.OperationEventType && e.Operation != nil:
+		return fmt.Sprintf("%s  %-9s %s: %s", prefix, e.Operation.Type, e.Operation.State, e.Operation.Description)
+	case e.Type == watch.StepEventType && e.Step != nil:
+		details := fmt.Sprintf("finished in %s", e.Step.Duration.Round(time.Millisecond))
+		if e.Step.Error != "" {
+			details = fmt.Sprintf("failed after %s: %s", e.Step.Duration.Round(time.Millisecond), e.Step.Error)
+		} else if e.Step.RetryIn > 0 {
+			details = fmt.Sprintf("%s, retry in %s", details, e.Step.RetryIn)
+		}
+		return fmt.Sprintf("%s  %-9s %s %s", prefix, "step", e.Step.Name, details)
+	case e.Type == watch.TraceEventType && e.Event != nil:
+		return fmt.Sprintf("%s  %-9s %s", prefix, e.Event.Level, e.Event.Message)
+	}
+	return fmt.Sprintf("%s  %s", prefix, e.Type)
+}
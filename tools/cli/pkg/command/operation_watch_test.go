@@ -0,0 +1,68 @@
+package command
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/events"
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/watch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationWatchCommand_Validate(t *testing.T) {
+	cmd := OperationWatchCommand{output: tableOutput}
+	require.NoError(t, cmd.Validate([]string{"op-id"}))
+	assert.Equal(t, "op-id", cmd.operationID)
+
+	cmd = OperationWatchCommand{output: tableOutput}
+	assert.Error(t, cmd.Validate(nil))
+
+	cmd = OperationWatchCommand{output: tableOutput, runtimeID: "runtime-id"}
+	assert.NoError(t, cmd.Validate(nil))
+	assert.Error(t, cmd.Validate([]string{"op-id"}))
+
+	cmd = OperationWatchCommand{output: "yaml"}
+	assert.Error(t, cmd.Validate([]string{"op-id"}))
+}
+
+func TestOperationWatchCommand_PrintEvent(t *testing.T) {
+	ts := time.Date(2022, 11, 14, 12, 0, 0, 0, time.Local)
+	level := events.InfoEventLevel
+
+	for name, tc := range map[string]struct {
+		event    watch.Event
+		expected string
+	}{
+		"operation": {
+			event:    watch.Event{Type: watch.OperationEventType, Time: ts, OperationID: "op-id", Operation: &watch.OperationState{Type: "provision", State: "in progress", Description: "Operation created"}},
+			expected: "12:00:00  op-id  provision in progress: Operation created\n",
+		},
+		"step with retry": {
+			event:    watch.Event{Type: watch.StepEventType, Time: ts, OperationID: "op-id", Step: &watch.StepProgress{Name: "Check_Runtime", Duration: 1500 * time.Millisecond, RetryIn: time.Minute}},
+			expected: "12:00:00  op-id  step      Check_Runtime finished in 1.5s, retry in 1m0s\n",
+		},
+		"failed step": {
+			event:    watch.Event{Type: watch.StepEventType, Time: ts, OperationID: "op-id", Step: &watch.StepProgress{Name: "Create_Runtime", Duration: time.Second, Error: "provisioner error"}},
+			expected: "12:00:00  op-id  step      Create_Runtime failed after 1s: provisioner error\n",
+		},
+		"tracing event": {
+			event:    watch.Event{Type: watch.TraceEventType, Time: ts, OperationID: "op-id", Event: &events.EventDTO{Level: level, Message: "step Check_Runtime sleeping for 1m0s"}},
+			expected: "12:00:00  op-id  info      step Check_Runtime sleeping for 1m0s\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			out := &bytes.Buffer{}
+			cmd := OperationWatchCommand{output: tableOutput, out: out}
+
+			// when
+			err := cmd.printEvent(tc.event)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, out.String())
+		})
+	}
+}
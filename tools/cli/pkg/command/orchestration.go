@@ -17,6 +17,8 @@ import (
 
 const (
 	cancelCommand     = "cancel"
+	pauseCommand      = "pause"
+	resumeCommand     = "resume"
 	retryCommand      = "retry"
 	operationsCommand = "operations"
 	opsCommand        = "ops"
@@ -32,6 +34,7 @@ type OrchestrationCommand struct {
 	operations []string
 	subCommand string
 	now        bool
+	diff       bool
 	listParams orchestration.ListParameters
 }
 
@@ -42,6 +45,7 @@ var cliStates = map[string]string{
 	"inprogress": orchestration.InProgress,
 	"canceled":   orchestration.Canceled,
 	"canceling":  orchestration.Canceling,
+	"paused":     orchestration.Paused,
 	"retrying":   orchestration.Retrying,
 }
 
@@ -99,6 +103,33 @@ var operationColumns = []printer.Column{
 	},
 }
 
+var operationDiffColumns = []printer.Column{
+	{
+		Header:    "OPERATION ID",
+		FieldSpec: "{.OperationID}",
+	},
+	{
+		Header:    "SHOOT",
+		FieldSpec: "{.ShootName}",
+	},
+	{
+		Header:    "STATE",
+		FieldSpec: "{.State}",
+	},
+	{
+		Header:         "K8S VERSION",
+		FieldFormatter: operationKubernetesVersionDiff,
+	},
+	{
+		Header:         "MACHINE IMAGE",
+		FieldFormatter: operationMachineImageDiff,
+	},
+	{
+		Header:         "ERRORS",
+		FieldFormatter: operationDiffErrors,
+	},
+}
+
 var orchestrationDetailsTpl = `Orchestration ID: {{.OrchestrationID}}
 Type:             {{.Type}}
 Created At:       {{.CreatedAt}}
@@ -109,6 +140,10 @@ Description:      {{.Description}}
 Strategy:         {{.Parameters.Strategy.Type}}
 Schedule:         {{.Parameters.Strategy.Schedule}}
 Workers:          {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary Waves:     first {{.Parameters.Strategy.Canary.FirstWaveSize}}, next {{.Parameters.Strategy.Canary.WaveSize}}
+Failure Ratio:    {{.Parameters.Strategy.Canary.FailureThreshold}}
+{{- end }}
 {{- if eq .Type "upgradeKyma" }}
 Kyma Version:     {{with .Parameters.Kyma}}{{.Version}}{{end}}
 {{- else if eq .Type "upgradeCluster" }}
@@ -138,6 +173,10 @@ var kymaUpgradePreviewTpl = `Kyma Upgrade Preview
 Strategy:         {{.Parameters.Strategy.Type}}
 Schedule:         {{.Parameters.Strategy.Schedule}}
 Workers:          {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary Waves:     first {{.Parameters.Strategy.Canary.FirstWaveSize}}, next {{.Parameters.Strategy.Canary.WaveSize}}
+Failure Ratio:    {{.Parameters.Strategy.Canary.FailureThreshold}}
+{{- end }}
 {{- if eq .Parameters.Kyma.Version "" }}
 Kyma Version:     <determined after start>
 {{- else }}
@@ -159,6 +198,10 @@ var kubernetesUpgradePreviewTpl = `Kubernetes Upgrade Preview
 Strategy:         {{.Parameters.Strategy.Type}}
 Schedule:         {{.Parameters.Strategy.Schedule}}
 Workers:          {{.Parameters.Strategy.Parallel.Workers}}
+{{- if eq .Parameters.Strategy.Type "canary" }}
+Canary Waves:     first {{.Parameters.Strategy.Canary.FirstWaveSize}}, next {{.Parameters.Strategy.Canary.WaveSize}}
+Failure Ratio:    {{.Parameters.Strategy.Canary.FailureThreshold}}
+{{- end }}
 K8s Version:      <determined after start>
 Targets:
 {{- range $i, $t := .Parameters.Targets.Include }}
@@ -200,7 +243,7 @@ Message:            {{ .Msg }}
 func NewOrchestrationCmd() *cobra.Command {
 	cmd := OrchestrationCommand{}
 	cobraCmd := &cobra.Command{
-		Use:     "orchestrations [id] [ops|operations] [cancel] [retry]",
+		Use:     "orchestrations [id] [ops|operations] [cancel] [retry] [pause] [resume]",
 		Aliases: []string{"orchestration", "o"},
 		Short:   "Displays Kyma Control Plane (KCP) orchestrations.",
 		Long: `Displays KCP orchestrations and their primary attributes, such as identifiers, type, state, parameters, or Runtime operations.
@@ -208,8 +251,11 @@ The command has the following modes:
   - Without specifying an orchestration ID as an argument. In this mode, the command lists all orchestrations, or orchestrations matching the --state option, if provided.
   - When specifying an orchestration ID as an argument. In this mode, the command displays details about the specific orchestration.
       If the optional --operation flag is provided, it displays details of the specified Runtime operation within the orchestration.
+      If the optional --diff flag is provided for a dry run of the cluster upgrade, it displays the changes of the cluster configuration of every Runtime and the reasons why the upgrade is not valid for the Runtime.
   - When specifying an orchestration ID and ` + "`operations` or `ops`" + ` as arguments. In this mode, the command displays the Runtime operations for the given orchestration.
   - When specifying an orchestration ID and ` + "`cancel`" + ` as arguments. In this mode, the command cancels the orchestration and all pending Runtime operations.
+  - When specifying an orchestration ID and ` + "`pause`" + ` as arguments. In this mode, the command pauses the orchestration. No new Runtime operations are started, the in progress ones are still completed.
+  - When specifying an orchestration ID and ` + "`resume`" + ` as arguments. In this mode, the command resumes the paused orchestration.
   - When specifying an orchestration ID and ` + "`retry`" + ` as arguments. In this mode, the command retries all failed Runtime operations of the given orchestration. The ` + "`retry` " + `command only applies to the failed or in progress orchestration.
       If the optional --operation flag is provided, it retries the specified Runtime operation of the given orchestration.`,
 		Example: `  kcp orchestrations --state inprogress                                              Display all orchestrations which are in progress.
@@ -218,7 +264,10 @@ The command has the following modes:
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00                             Display details about a specific orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --operation OID1,OID2       Display details of the specified Runtime operation within the orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 operations                  Display the operations of the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 --diff                      Display the changes of the cluster configuration computed by the given dry run orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 cancel                      Cancel the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 pause                       Pause the given orchestration.
+  kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 resume                      Resume the given paused orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry                       Retry all failed operations of the given orchestration.
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --operation OID1,OID2 Retry the given operations of the given orchestration
   kcp orchestration 0c4357f5-83e0-4b72-9472-49b5cd417c00 retry --now --operation OID1 Retry the given operations of the given orchestration schedule immediately`,
@@ -232,6 +281,7 @@ The command has the following modes:
 	cobraCmd.Flags().StringSliceVarP(&cmd.states, "state", "s", nil, fmt.Sprintf("Filter output by state. You can provide multiple values, either separated by a comma (e.g. failed,inprogress), or by specifying the option multiple times. The possible values are: %s.", strings.Join(cliOrchestrationStates(), ", ")))
 	cobraCmd.Flags().StringSliceVar(&cmd.operations, "operation", nil, "Option that displays details of the specified Runtime operation when a given orchestration is selected.")
 	cobraCmd.Flags().BoolVarP(&cmd.now, "now", "n", false, "retry failed operations with schedule immediate.")
+	cobraCmd.Flags().BoolVar(&cmd.diff, "diff", false, "Option that displays the changes of the cluster configuration computed by the dry run of the given cluster upgrade orchestration.")
 	return cobraCmd
 }
 
@@ -278,6 +328,9 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 		return cmd.showOrchestrations()
 	case 1:
 		// Called with orchestration ID but without subcommand
+		if cmd.diff {
+			return cmd.showDiff(args[0])
+		}
 		if len(cmd.operations) == 0 {
 			return cmd.showOneOrchestration(args[0])
 		}
@@ -289,6 +342,10 @@ func (cmd *OrchestrationCommand) Run(args []string) error {
 			return cmd.cancelOrchestration(args[0])
 		case retryCommand:
 			return cmd.retryOrchestration(args[0])
+		case pauseCommand:
+			return cmd.pauseOrchestration(args[0])
+		case resumeCommand:
+			return cmd.resumeOrchestration(args[0])
 		case operationsCommand, opsCommand:
 			return cmd.showOperations(args[0])
 		}
@@ -315,11 +372,17 @@ func (cmd *OrchestrationCommand) Validate(args []string) error {
 	if len(cmd.operations) != 0 && len(cmd.states) > 0 {
 		return errors.New("--state should not be used together with --operation")
 	}
+	if cmd.diff && len(args) != 1 {
+		return errors.New("--diff should only be used when only orchestration id is given as an argument")
+	}
+	if cmd.diff && len(cmd.operations) != 0 {
+		return errors.New("--diff should not be used together with --operation")
+	}
 
 	if len(args) == 2 {
 		cmd.subCommand = args[1]
 		switch cmd.subCommand {
-		case cancelCommand, retryCommand, operationsCommand, opsCommand:
+		case cancelCommand, retryCommand, pauseCommand, resumeCommand, operationsCommand, opsCommand:
 		default:
 			return fmt.Errorf("invalid subcommand: %s", cmd.subCommand)
 		}
@@ -413,6 +476,37 @@ func (cmd *OrchestrationCommand) showOperations(orchestrationID string) error {
 	return nil
 }
 
+func (cmd *OrchestrationCommand) showDiff(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.Type != orchestration.UpgradeClusterOrchestration || !sr.Parameters.DryRun {
+		return errors.New("the diff is computed only by the dry run of the cluster upgrade orchestration")
+	}
+
+	orl, err := cmd.client.ListOperations(orchestrationID, cmd.listParams)
+	if err != nil {
+		return errors.Wrap(err, "while listing operations")
+	}
+
+	switch cmd.output {
+	case tableOutput:
+		if len(orl.Data) > 0 {
+			tp, err := printer.NewTablePrinter(operationDiffColumns, false)
+			if err != nil {
+				return err
+			}
+			return tp.PrintObj(orl.Data)
+		}
+	case jsonOutput:
+		jp := printer.NewJSONPrinter("  ")
+		jp.PrintObj(orl)
+	}
+
+	return nil
+}
+
 func (cmd *OrchestrationCommand) showOperationsDetails(orchestrationID string) error {
 	odrs := []orchestration.OperationDetailResponse{}
 
@@ -465,6 +559,40 @@ func (cmd *OrchestrationCommand) cancelOrchestration(orchestrationID string) err
 
 }
 
+func (cmd *OrchestrationCommand) pauseOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	switch sr.State {
+	case orchestration.Paused:
+		fmt.Println("Orchestration is already paused.")
+		return nil
+	case orchestration.InProgress:
+	default:
+		return fmt.Errorf("orchestration in state %s cannot be paused", sr.State)
+	}
+
+	if !PromptUser(fmt.Sprintf("%d pending operation(s) will wait until the orchestration is resumed, %d in progress operation(s) will still be completed. \n Do you want to pause?", sr.OperationStats[orchestration.Pending], sr.OperationStats[orchestration.InProgress])) {
+		fmt.Println("pause is not run.")
+		return nil
+	}
+
+	return cmd.client.PauseOrchestration(orchestrationID)
+}
+
+func (cmd *OrchestrationCommand) resumeOrchestration(orchestrationID string) error {
+	sr, err := cmd.client.GetOrchestration(orchestrationID)
+	if err != nil {
+		return errors.Wrap(err, "while getting orchestration")
+	}
+	if sr.State != orchestration.Paused {
+		return fmt.Errorf("orchestration is %s, only paused orchestration can be resumed", sr.State)
+	}
+
+	return cmd.client.ResumeOrchestration(orchestrationID)
+}
+
 func (cmd *OrchestrationCommand) retryOrchestration(orchestrationID string) error {
 	sr, err := cmd.client.GetOrchestration(orchestrationID)
 	if err != nil {
@@ -588,6 +716,44 @@ func orchestrationDetails(obj interface{}) string {
 	return sb.String()
 }
 
+// operationKubernetesVersionDiff returns the change of the Kubernetes version computed by the dry run,
+// "-" is returned if the operation has not computed the diff yet
+func operationKubernetesVersionDiff(obj interface{}) string {
+	or := obj.(orchestration.OperationResponse)
+	if or.ClusterUpgradeDiff == nil {
+		return "-"
+	}
+	return valueDiff(or.ClusterUpgradeDiff.KubernetesVersion, or.ClusterUpgradeDiff.KubernetesVersion.Changed())
+}
+
+func operationMachineImageDiff(obj interface{}) string {
+	or := obj.(orchestration.OperationResponse)
+	if or.ClusterUpgradeDiff == nil {
+		return "-"
+	}
+	diff := or.ClusterUpgradeDiff
+	image := orchestration.ValueDiff{
+		Current: strings.TrimSpace(diff.MachineImage.Current + " " + diff.MachineImageVersion.Current),
+		Target:  strings.TrimSpace(diff.MachineImage.Target + " " + diff.MachineImageVersion.Target),
+	}
+	return valueDiff(image, diff.MachineImage.Changed() || diff.MachineImageVersion.Changed())
+}
+
+func operationDiffErrors(obj interface{}) string {
+	or := obj.(orchestration.OperationResponse)
+	if or.ClusterUpgradeDiff == nil || or.ClusterUpgradeDiff.Valid() {
+		return "-"
+	}
+	return strings.Join(or.ClusterUpgradeDiff.Errors, ", ")
+}
+
+func valueDiff(diff orchestration.ValueDiff, changed bool) string {
+	if !changed {
+		return diff.Current
+	}
+	return fmt.Sprintf("%s -> %s", diff.Current, diff.Target)
+}
+
 func PromptUser(msg string) bool {
 	fmt.Printf("%s%s", "? ", msg)
 	for {
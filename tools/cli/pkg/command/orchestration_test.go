@@ -47,6 +47,42 @@ func TestRetryOrchestrationOutput(t *testing.T) {
 
 }
 
+func TestOperationDiffColumns(t *testing.T) {
+	or := orchestration.OperationResponse{
+		ClusterUpgradeDiff: &orchestration.ClusterUpgradeDiff{
+			KubernetesVersion:   orchestration.ValueDiff{Current: "1.24.8", Target: "1.25.4"},
+			MachineImage:        orchestration.ValueDiff{Current: "gardenlinux", Target: "gardenlinux"},
+			MachineImageVersion: orchestration.ValueDiff{Current: "576.12.0", Target: "318.9.0"},
+			Errors:              []string{"machine image version cannot be downgraded from 576.12.0 to 318.9.0"},
+		},
+	}
+
+	require.Equal(t, "1.24.8 -> 1.25.4", operationKubernetesVersionDiff(or))
+	require.Equal(t, "gardenlinux 576.12.0 -> gardenlinux 318.9.0", operationMachineImageDiff(or))
+	require.Equal(t, "machine image version cannot be downgraded from 576.12.0 to 318.9.0", operationDiffErrors(or))
+
+	or.ClusterUpgradeDiff.MachineImageVersion.Target = "576.12.0"
+	or.ClusterUpgradeDiff.Errors = nil
+	require.Equal(t, "gardenlinux 576.12.0", operationMachineImageDiff(or))
+	require.Equal(t, "-", operationDiffErrors(or))
+
+	// the diff is not computed before the operation is processed
+	or.ClusterUpgradeDiff = nil
+	require.Equal(t, "-", operationKubernetesVersionDiff(or))
+	require.Equal(t, "-", operationMachineImageDiff(or))
+}
+
+func TestValidateDiff(t *testing.T) {
+	cmd := OrchestrationCommand{output: tableOutput, diff: true}
+
+	require.NoError(t, cmd.Validate([]string{"orchestration_id_0"}))
+	require.Error(t, cmd.Validate(nil))
+	require.Error(t, cmd.Validate([]string{"orchestration_id_0", operationsCommand}))
+
+	cmd.operations = []string{"operation_id_0"}
+	require.Error(t, cmd.Validate([]string{"orchestration_id_0"}))
+}
+
 func fixOrchestrationCommand() *OrchestrationCommand {
 	cmd := OrchestrationCommand{}
 	cmd.operations = []string{"operation_id_0", "operation_id_1"}
@@ -58,6 +58,7 @@ See the **Global Options** section of each command for the description of these
 		NewCompletionCommand(),
 		NewReconciliationCmd(),
 		NewDeprovisionCmd(),
+		NewRuntimeOperationCmd(),
 	)
 	return cmd
 }
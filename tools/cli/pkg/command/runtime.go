@@ -85,6 +85,7 @@ https://github.com/kyma-project/control-plane/blob/main/docs/kyma-environment-br
 		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
 	}
 	cmd.cobraCmd = cobraCmd
+	cobraCmd.AddCommand(NewRuntimeEstimateCmd())
 
 	SetOutputOpt(cobraCmd, &cmd.output)
 	cobraCmd.Flags().StringSliceVarP(&cmd.params.Shoots, "shoot", "c", nil, "Filter by Shoot cluster name. You can provide multiple values, either separated by a comma (e.g. shoot1,shoot2), or by specifying the option multiple times.")
@@ -0,0 +1,155 @@
+package command
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+)
+
+// RuntimeEstimateCommand represents an execution of the kcp runtimes estimate command
+type RuntimeEstimateCommand struct {
+	cobraCmd      *cobra.Command
+	log           logger.Logger
+	output        string
+	params        estimate.Parameters
+	autoScalerMin int
+	autoScalerMax int
+	volumeSizeGb  int
+	out           io.Writer
+}
+
+// NewRuntimeEstimateCmd constructs a new instance of RuntimeEstimateCommand and configures it in terms of a cobra.Command
+func NewRuntimeEstimateCmd() *cobra.Command {
+	cmd := RuntimeEstimateCommand{out: os.Stdout}
+	cobraCmd := &cobra.Command{
+		Use:   "estimate",
+		Short: "Estimates the resource consumption of a Kyma Runtime.",
+		Long: `Estimates the node hours, vCPUs, memory, storage, and costs of a Kyma Runtime at the minimal and the maximal scale of the autoscaler.
+For a new Runtime, the values not provided are taken from the defaults of the plan.
+For an existing Runtime, the values are taken from the provisioning parameters of the Runtime, and the current consumption is estimated from the live node counts collected by Kyma Metrics Collector.
+The costs are displayed only if the price table configured in Kyma Environment Broker contains all the prices.`,
+		Example: `  kcp runtimes estimate -p aws                                        Estimate the monthly consumption of a Runtime with the defaults of the aws plan.
+  kcp runtimes estimate -p azure --machine-type Standard_D8_v3 --autoscaler-max 5 --hours 24
+                                                                      Estimate the daily consumption of a Runtime with the given parameters.
+  kcp runtimes estimate -r 2b3c4d5e-6f70-4a8b-9c0d-1e2f3a4b5c6d -o json  Estimate the monthly consumption of an existing Runtime in the JSON format.`,
+		Args:    cobra.NoArgs,
+		PreRunE: func(_ *cobra.Command, _ []string) error { return cmd.Validate() },
+		RunE:    func(_ *cobra.Command, _ []string) error { return cmd.Run() },
+	}
+	cmd.cobraCmd = cobraCmd
+
+	cobraCmd.Flags().StringVarP(&cmd.output, "output", "o", tableOutput, fmt.Sprintf("Output type of the estimation. The possible values are: %s, %s.", tableOutput, jsonOutput))
+	cobraCmd.Flags().StringVarP(&cmd.params.Plan, "plan", "p", "", "Name or ID of the service plan of the Runtime.")
+	cobraCmd.Flags().StringVarP(&cmd.params.Region, "region", "R", "", "Provider region of the Runtime. The region affects only the costs.")
+	cobraCmd.Flags().StringVar(&cmd.params.MachineType, "machine-type", "", "Machine type of the worker nodes.")
+	cobraCmd.Flags().IntVar(&cmd.autoScalerMin, "autoscaler-min", 0, "Minimal number of the worker nodes.")
+	cobraCmd.Flags().IntVar(&cmd.autoScalerMax, "autoscaler-max", 0, "Maximal number of the worker nodes.")
+	cobraCmd.Flags().IntVar(&cmd.volumeSizeGb, "volume-size", 0, "Size of the volume of a worker node in GB.")
+	cobraCmd.Flags().IntVar(&cmd.params.Hours, "hours", 0, "Period of the estimation in hours. By default, a month is estimated.")
+	cobraCmd.Flags().StringVarP(&cmd.params.RuntimeID, "runtime-id", "r", "", "ID of an existing Runtime. Cannot be used together with the other parameters except --hours.")
+
+	return cobraCmd
+}
+
+// Validate checks the input parameters of the runtimes estimate command
+func (cmd *RuntimeEstimateCommand) Validate() error {
+	if cmd.output != tableOutput && cmd.output != jsonOutput {
+		return fmt.Errorf("invalid value for output: %s", cmd.output)
+	}
+	if cmd.params.Plan == "" && cmd.params.RuntimeID == "" {
+		return errors.New("either the --plan or the --runtime-id option must be provided")
+	}
+
+	flags := cmd.cobraCmd.Flags()
+	if flags.Changed("hours") && cmd.params.Hours <= 0 {
+		return errors.New("the --hours option must be greater than 0")
+	}
+	for _, option := range []struct {
+		name  string
+		value int
+		param **int
+	}{
+		{"autoscaler-min", cmd.autoScalerMin, &cmd.params.AutoScalerMin},
+		{"autoscaler-max", cmd.autoScalerMax, &cmd.params.AutoScalerMax},
+		{"volume-size", cmd.volumeSizeGb, &cmd.params.VolumeSizeGb},
+	} {
+		if !flags.Changed(option.name) {
+			continue
+		}
+		if option.value < 0 {
+			return fmt.Errorf("the --%s option must not be negative", option.name)
+		}
+		value := option.value
+		*option.param = &value
+	}
+
+	if cmd.params.RuntimeID != "" {
+		for _, name := range []string{"plan", "region", "machine-type", "autoscaler-min", "autoscaler-max", "volume-size"} {
+			if flags.Changed(name) {
+				return fmt.Errorf("the --runtime-id option cannot be used together with the --%s option", name)
+			}
+		}
+	}
+	return nil
+}
+
+// Run executes the runtimes estimate command
+func (cmd *RuntimeEstimateCommand) Run() error {
+	cmd.log = logger.New()
+	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), CLICredentialManager(cmd.log))
+	client := estimate.NewClient(GlobalOpts.KEBAPIURL(), httpClient)
+
+	result, err := client.GetEstimate(cmd.params)
+	if err != nil {
+		return errors.Wrap(err, "while estimating runtime")
+	}
+	return cmd.printEstimate(result)
+}
+
+func (cmd *RuntimeEstimateCommand) printEstimate(result estimate.Estimate) error {
+	if cmd.output == jsonOutput {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "while encoding estimation")
+		}
+		_, err = fmt.Fprintln(cmd.out, string(data))
+		return err
+	}
+
+	pools := make([]string, 0, len(result.WorkerPools))
+	for _, pool := range result.WorkerPools {
+		pools = append(pools, fmt.Sprintf("%s (%s, %d-%d nodes, %d GB volume)", pool.Name, pool.MachineType, pool.AutoScalerMin, pool.AutoScalerMax, pool.VolumeSizeGb))
+	}
+	fmt.Fprintf(cmd.out, "Plan: %s, provider: %s, region: %s, period: %d hours\n", result.PlanName, result.Provider, result.Region, result.Hours)
+	fmt.Fprintf(cmd.out, "Worker pools: %s\n\n", strings.Join(pools, ", "))
+
+	w := tabwriter.NewWriter(cmd.out, 0, 0, 3, ' ', 0)
+	costHeader := "COST"
+	if result.Currency != "" {
+		costHeader = fmt.Sprintf("COST (%s)", result.Currency)
+	}
+	fmt.Fprintf(w, "SCALE\tNODES\tNODE HOURS\tVCPU\tMEMORY (GB)\tSTORAGE (GB)\t%s\n", costHeader)
+	printResources(w, "min", result.Min)
+	printResources(w, "max", result.Max)
+	if result.Current != nil {
+		printResources(w, "current", *result.Current)
+	}
+	return w.Flush()
+}
+
+func printResources(w io.Writer, scale string, resources estimate.Resources) {
+	cost := "-"
+	if resources.Cost != nil {
+		cost = fmt.Sprintf("%.2f", *resources.Cost)
+	}
+	fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%g\t%d\t%s\n", scale, resources.Nodes, resources.NodeHours, resources.VCPU, resources.MemoryGb, resources.StorageGb, cost)
+}
@@ -0,0 +1,74 @@
+package command
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/estimate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuntimeEstimateCommand_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		args []string
+		err  string
+	}{
+		"plan":                 {args: []string{"-p", "aws", "--autoscaler-max", "5"}},
+		"runtime":              {args: []string{"-r", "runtime-id", "--hours", "24"}},
+		"no plan and runtime":  {args: []string{"--hours", "24"}, err: "either the --plan or the --runtime-id option must be provided"},
+		"runtime with plan":    {args: []string{"-r", "runtime-id", "-p", "aws"}, err: "the --runtime-id option cannot be used together with the --plan option"},
+		"zero hours":           {args: []string{"-p", "aws", "--hours", "0"}, err: "the --hours option must be greater than 0"},
+		"negative volume size": {args: []string{"-p", "aws", "--volume-size", "-1"}, err: "the --volume-size option must not be negative"},
+		"invalid output":       {args: []string{"-p", "aws", "-o", "yaml"}, err: "invalid value for output: yaml"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			cobraCmd := NewRuntimeEstimateCmd()
+			require.NoError(t, cobraCmd.Flags().Parse(tc.args))
+			cmd := RuntimeEstimateCommand{cobraCmd: cobraCmd}
+			cmd.output, _ = cobraCmd.Flags().GetString("output")
+			cmd.params.Plan, _ = cobraCmd.Flags().GetString("plan")
+			cmd.params.RuntimeID, _ = cobraCmd.Flags().GetString("runtime-id")
+			cmd.params.Hours, _ = cobraCmd.Flags().GetInt("hours")
+			cmd.volumeSizeGb, _ = cobraCmd.Flags().GetInt("volume-size")
+			cmd.autoScalerMax, _ = cobraCmd.Flags().GetInt("autoscaler-max")
+
+			// when
+			err := cmd.Validate()
+
+			// then
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestRuntimeEstimateCommand_PrintEstimate(t *testing.T) {
+	// given
+	out := &bytes.Buffer{}
+	cmd := RuntimeEstimateCommand{output: tableOutput, out: out}
+	cost := 41.0
+	result := estimate.Estimate{
+		PlanName: "aws", Provider: "aws", Region: "eu-central-1", Hours: 10, Currency: "EUR",
+		WorkerPools: []estimate.WorkerPool{{Name: "cpu-worker-0", MachineType: "m5.xlarge", VolumeSizeGb: 50, AutoScalerMin: 3, AutoScalerMax: 20}},
+		Min:         estimate.Resources{Nodes: 3, NodeHours: 30, VCPU: 12, MemoryGb: 48, StorageGb: 150},
+		Max:         estimate.Resources{Nodes: 20, NodeHours: 200, VCPU: 80, MemoryGb: 320, StorageGb: 1000, Cost: &cost},
+	}
+
+	// when
+	err := cmd.printEstimate(result)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, `Plan: aws, provider: aws, region: eu-central-1, period: 10 hours
+Worker pools: cpu-worker-0 (m5.xlarge, 3-20 nodes, 50 GB volume)
+
+SCALE   NODES   NODE HOURS   VCPU   MEMORY (GB)   STORAGE (GB)   COST (EUR)
+min     3       30           12     48            150            -
+max     20      200          80     320           1000           41.00
+`, out.String())
+}
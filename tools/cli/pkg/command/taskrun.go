@@ -2,6 +2,7 @@ package command
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -24,10 +25,14 @@ import (
 	"github.com/kyma-project/control-plane/tools/cli/pkg/credential"
 	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
+// outputWaitDelay is the time the output of a killed command is awaited
+const outputWaitDelay = 5 * time.Second
+
 // TaskRunCommand represents an execution of the kcp taskrun command
 type TaskRunCommand struct {
 	cobraCmd            *cobra.Command
@@ -44,6 +49,12 @@ type TaskRunCommand struct {
 	noPrefixOutput      bool
 	taskCommand         *exec.Cmd
 	shell               string
+	command             []string
+	timeout             time.Duration
+	reportPath          string
+	junitReportPath     string
+	resumePath          string
+	resumeReport        *TaskRunReport
 }
 
 // RuntimeLister implements the interface to obtains runtimes info from KEB for resolver
@@ -59,9 +70,11 @@ type RuntimeTask struct {
 
 // RuntimeTaskMakager implements Executor interface needed by strategy to execute the runtime task operations.
 type RuntimeTaskMakager struct {
+	ctx              context.Context
 	cmd              *TaskRunCommand
 	tasks            map[string]*RuntimeTask
 	kubeconfigClient client.Client
+	report           *reportWriter
 }
 
 // TaskRunError represents failure in task execution for one or more runtimes
@@ -78,7 +91,7 @@ func (e *TaskRunError) Error() string {
 func NewTaskRunCmd() *cobra.Command {
 	cmd := TaskRunCommand{}
 	cobraCmd := &cobra.Command{
-		Use:     "taskrun --target {TARGET SPEC} ... [--target-exclude {TARGET SPEC} ...] -- COMMAND [ARGS ...]",
+		Use:     "taskrun {--target {TARGET SPEC} ... [--target-exclude {TARGET SPEC} ...] | --resume {REPORT}} -- COMMAND [ARGS ...]",
 		Aliases: []string{"task", "t"},
 		Short:   "Runs generic tasks on one or more Kyma Runtimes.",
 		Long: `Runs a command, which can be a script or a program with arbitrary arguments, on targets of Kyma Runtimes.
@@ -92,7 +105,12 @@ For each subprocess, the following Runtime-specific data are passed as environme
   - RUNTIME_ID       : Runtime ID of the Runtime
   - INSTANCE_ID      : Instance ID of the Runtime
 
-  If all subprocesses finish successfully with the zero status code, the exit status is zero (0). If one or more subprocesses exit with a non-zero status, the command will also exit with a non-zero status.`,
+  If all subprocesses finish successfully with the zero status code, the exit status is zero (0). If one or more subprocesses exit with a non-zero status, the command will also exit with a non-zero status.
+
+With the --report option, the exit code, the duration, and the end of the stdout and stderr of the command on each Runtime are saved in a JSON report file, which is updated during the execution.
+The --junit-report option saves the same results in the JUnit XML format at the end of the execution.
+With the --resume option, the command is executed only on the Runtimes which failed or were not visited in the given report, and the report is updated with the new results.
+The command saved in the report is executed if no command is specified.`,
 		Example: `  kcp taskrun --target all -- kubectl patch deployment valid-deployment -p '{"metadata":{"labels":{"my-label": "my-value"}}}'
     Execute a kubectl patch operation for all Runtimes.
   kcp taskrun --target account=CA4836781TID000000000123456789 /usr/local/bin/awesome-script.sh
@@ -100,8 +118,12 @@ For each subprocess, the following Runtime-specific data are passed as environme
   kcp taskrun --target all -- helm upgrade -i -n kyma-system my-kyma-addon --values overrides.yaml
     Deploy a Helm chart on all Runtimes.
   kcp taskrun -t all -s "/bin/bash -i -c" -- kc get ns
-    Run an alias command (kc for kubectl) defined in user's .bashrc invocation script`,
-		Args:    cobra.MinimumNArgs(1),
+    Run an alias command (kc for kubectl) defined in user's .bashrc invocation script
+  kcp taskrun -t all --report report.json --timeout 10m -- /usr/local/bin/awesome-script.sh
+    Run a maintenance script for all Runtimes with a timeout, and save the results in a report.
+  kcp taskrun --resume report.json
+    Run the maintenance script again for the Runtimes which failed or were not visited.`,
+		Args:    cobra.ArbitraryArgs,
 		PreRunE: func(_ *cobra.Command, args []string) error { return cmd.Validate(args) },
 		RunE:    func(_ *cobra.Command, args []string) error { return cmd.Run(args) },
 	}
@@ -115,6 +137,10 @@ For each subprocess, the following Runtime-specific data are passed as environme
 	cobraCmd.Flags().BoolVar(&cmd.noPrefixOutput, "no-prefix-output", false, "Option that omits the prefixing of each output line with the Runtime name. By default, all output lines are prepended for better traceability.")
 	cobraCmd.Flags().StringP("shell", "s", "", "Invoke the task command using the given shell and it's options. Useful when the task command uses alias(es) defined in the shell's invocation scripts. Can also be set in the KCP configuration file or with the KCP_SHELL environment variable.")
 	viper.BindPFlag("shell", cobraCmd.Flags().Lookup("shell"))
+	cobraCmd.Flags().DurationVar(&cmd.timeout, "timeout", 0, "Timeout of the command on each Runtime, e.g. 10m. The command is killed together with the processes it started when the timeout expires. By default, there is no timeout.")
+	cobraCmd.Flags().StringVar(&cmd.reportPath, "report", "", "Path to the JSON report file with the results of the command on each Runtime. Defaults to the file given with the --resume option.")
+	cobraCmd.Flags().StringVar(&cmd.junitReportPath, "junit-report", "", "Path to the report file with the results of the command on each Runtime in the JUnit XML format.")
+	cobraCmd.Flags().StringVar(&cmd.resumePath, "resume", "", "Path to the JSON report file of a previous execution. The command is executed only on the Runtimes which failed or were not visited.")
 	return cobraCmd
 }
 
@@ -124,12 +150,20 @@ func (cmd *TaskRunCommand) Run(args []string) error {
 	cmd.cred = CLICredentialManager(cmd.log)
 	defer cmd.cleanupTempKubeConfigDir()
 
-	operations, err := cmd.resolveOperations()
+	operations, report, err := cmd.resolveOperations()
 	if err != nil {
 		return err
 	}
 
-	mgr := NewRuntimeTaskMakager(cmd, operations)
+	mgr := NewRuntimeTaskMakager(cmd, operations, newReportWriter(report, cmd.reportPath, cmd.junitReportPath))
+	if len(operations) == 0 {
+		cmd.log.Info("No runtimes to execute the task on")
+		return mgr.report.finish()
+	}
+	if err := mgr.report.flush(); err != nil {
+		return err
+	}
+
 	strategy := strategies.NewParallelOrchestrationStrategy(mgr, cmd.log, 0)
 	execID, err := strategy.Execute(operations, orchestration.StrategySpec{
 		Type:     orchestration.ParallelStrategy,
@@ -141,6 +175,9 @@ func (cmd *TaskRunCommand) Run(args []string) error {
 	}
 	strategy.Wait(execID)
 
+	if err := mgr.report.finish(); err != nil {
+		return err
+	}
 	return mgr.exitStatus()
 }
 
@@ -151,15 +188,41 @@ func (cmd *TaskRunCommand) Validate(args []string) error {
 		return fmt.Errorf("missing required %s option", GlobalOpts.kubeconfigAPIURL)
 	}
 
-	// Validate gardener-kubeconfig global option
-	if GlobalOpts.GardenerKubeconfig() == "" || GlobalOpts.GardenerNamespace() == "" {
-		return fmt.Errorf("missing required %s/%s options", GlobalOpts.gardenerKubeconfig, GlobalOpts.gardenerNamespace)
+	var err error
+	if cmd.resumePath != "" {
+		// Validate resume options, the runtimes and the command are read from the report
+		if len(cmd.targetInputs) > 0 || len(cmd.targetExcludeInputs) > 0 {
+			return errors.New("the target options cannot be used together with the resume option")
+		}
+		cmd.resumeReport, err = LoadTaskRunReport(cmd.resumePath)
+		if err != nil {
+			return err
+		}
+		if len(args) == 0 {
+			args = cmd.resumeReport.Command
+		}
+		if cmd.reportPath == "" {
+			cmd.reportPath = cmd.resumePath
+		}
+	} else {
+		// Validate gardener-kubeconfig global option
+		if GlobalOpts.GardenerKubeconfig() == "" || GlobalOpts.GardenerNamespace() == "" {
+			return fmt.Errorf("missing required %s/%s options", GlobalOpts.gardenerKubeconfig, GlobalOpts.gardenerNamespace)
+		}
+
+		// Validate target options
+		err = ValidateTransformRuntimeTargetOpts(cmd.targetInputs, cmd.targetExcludeInputs, &cmd.targets)
+		if err != nil {
+			return err
+		}
+	}
+	if len(args) == 0 {
+		return errors.New("the task command must be specified")
 	}
+	cmd.command = args
 
-	// Validate target options
-	err := ValidateTransformRuntimeTargetOpts(cmd.targetInputs, cmd.targetExcludeInputs, &cmd.targets)
-	if err != nil {
-		return err
+	if cmd.timeout < 0 {
+		return errors.New("timeout must not be negative")
 	}
 
 	// Validate kubeconfig directory
@@ -198,25 +261,26 @@ func (cmd *TaskRunCommand) Validate(args []string) error {
 	return nil
 }
 
-func (cmd *TaskRunCommand) resolveOperations() ([]orchestration.RuntimeOperation, error) {
-	gardenCfg, err := gardener.NewGardenerClusterConfig(GlobalOpts.GardenerKubeconfig())
-	if err != nil {
-		return nil, errors.Wrap(err, "while getting Gardener kubeconfig")
-	}
-	dynamicGardener, err := dynamic.NewForConfig(gardenCfg)
-	if err != nil {
-		return nil, errors.Wrap(err, "while getting Gardener client")
-	}
-
-	httpClient := oauth2.NewClient(cmd.cobraCmd.Context(), cmd.cred)
-	lister := NewRuntimeLister(runtime.NewClient(GlobalOpts.KEBAPIURL(), httpClient))
-	resolver := orchestration.NewGardenerRuntimeResolver(dynamicGardener, GlobalOpts.GardenerNamespace(), lister, cmd.log)
-	runtimes, err := resolver.Resolve(cmd.targets)
-	if err != nil {
-		return nil, errors.Wrap(err, "while resolving targets")
+// resolveOperations returns the operations of the runtimes matching the targets, or of the unfinished runtimes of the resumed report
+func (cmd *TaskRunCommand) resolveOperations() ([]orchestration.RuntimeOperation, *TaskRunReport, error) {
+	var runtimes []orchestration.Runtime
+	var report *TaskRunReport
+	if cmd.resumeReport != nil {
+		report = cmd.resumeReport
+		report.Command = cmd.command
+		report.FinishedAt = nil
+		runtimes = report.Unfinished()
+		cmd.log.Infof("Resuming %d of %d runtimes\n", len(runtimes), len(report.Runtimes))
+	} else {
+		var err error
+		runtimes, err = resolveRuntimeTargets(cmd.cobraCmd.Context(), cmd.cred, cmd.log, cmd.targets)
+		if err != nil {
+			return nil, nil, err
+		}
+		report = NewTaskRunReport(cmd.command, runtimes)
+		report.StartedAt = time.Now().UTC()
 	}
 
-	cmd.log.Infof("Number of resolved runtimes: %d\n", len(runtimes))
 	operations := make([]orchestration.RuntimeOperation, 0, len(runtimes))
 	for _, rt := range runtimes {
 		operations = append(operations, orchestration.RuntimeOperation{
@@ -225,7 +289,7 @@ func (cmd *TaskRunCommand) resolveOperations() ([]orchestration.RuntimeOperation
 		})
 	}
 
-	return operations, nil
+	return operations, report, nil
 }
 
 func (cmd *TaskRunCommand) cleanupTempKubeConfigDir() error {
@@ -237,6 +301,29 @@ func (cmd *TaskRunCommand) cleanupTempKubeConfigDir() error {
 	return err
 }
 
+// resolveRuntimeTargets resolves the runtimes matching the target spec using the Gardener and KEB APIs
+func resolveRuntimeTargets(ctx context.Context, cred credential.Manager, log logger.Logger, targets orchestration.TargetSpec) ([]orchestration.Runtime, error) {
+	gardenCfg, err := gardener.NewGardenerClusterConfig(GlobalOpts.GardenerKubeconfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting Gardener kubeconfig")
+	}
+	dynamicGardener, err := dynamic.NewForConfig(gardenCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "while getting Gardener client")
+	}
+
+	httpClient := oauth2.NewClient(ctx, cred)
+	lister := NewRuntimeLister(runtime.NewClient(GlobalOpts.KEBAPIURL(), httpClient))
+	resolver := orchestration.NewGardenerRuntimeResolver(dynamicGardener, GlobalOpts.GardenerNamespace(), lister, log)
+	runtimes, err := resolver.Resolve(targets)
+	if err != nil {
+		return nil, errors.Wrap(err, "while resolving targets")
+	}
+
+	log.Infof("Number of resolved runtimes: %d\n", len(runtimes))
+	return runtimes, nil
+}
+
 // NewRuntimeLister constructs a RuntimeLister with the given runtime.Client
 func NewRuntimeLister(client runtime.Client) *RuntimeLister {
 	return &RuntimeLister{client: client}
@@ -253,11 +340,13 @@ func (rl RuntimeLister) ListAllRuntimes() ([]runtime.RuntimeDTO, error) {
 }
 
 // NewRuntimeTaskMakager constructs a new RuntimeTaskMakager for the given runtime operations
-func NewRuntimeTaskMakager(cmd *TaskRunCommand, operations []orchestration.RuntimeOperation) *RuntimeTaskMakager {
+func NewRuntimeTaskMakager(cmd *TaskRunCommand, operations []orchestration.RuntimeOperation, report *reportWriter) *RuntimeTaskMakager {
 	mgr := &RuntimeTaskMakager{
+		ctx:              cmd.cobraCmd.Context(),
 		cmd:              cmd,
 		tasks:            make(map[string]*RuntimeTask, len(operations)),
 		kubeconfigClient: client.NewClient(cmd.cobraCmd.Context(), GlobalOpts.KubeconfigAPIURL(), cmd.cred),
+		report:           report,
 	}
 	for _, op := range operations {
 		mgr.tasks[op.ID] = &RuntimeTask{
@@ -273,14 +362,51 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 	task := mgr.tasks[operationID]
 	log := mgr.cmd.log.WithField("shoot", task.operation.ShootName)
 
+	startedAt := time.Now()
+	stdout := &tailBuffer{max: maxReportOutput}
+	stderr := &tailBuffer{max: maxReportOutput}
+	exitCode, err := mgr.execute(task, log, stdout, stderr)
+	task.result = err
+
+	result := RuntimeTaskReport{
+		Runtime:   task.operation.Runtime,
+		Status:    TaskSucceeded,
+		StartedAt: &startedAt,
+		Duration:  time.Since(startedAt).Round(time.Millisecond).String(),
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+	}
+	if exitCode >= 0 {
+		result.ExitCode = &exitCode
+	}
+	if err != nil {
+		result.Status = TaskFailed
+		result.Error = err.Error()
+	}
+	if reportErr := mgr.report.update(result); reportErr != nil {
+		log.Errorf("Error: while updating report: %s\n", reportErr)
+	}
+
+	return 0, err
+}
+
+// execute runs the task command and returns its exit code, -1 if the command did not exit
+func (mgr *RuntimeTaskMakager) execute(task *RuntimeTask, log logrus.FieldLogger, stdoutCapture, stderrCapture io.Writer) (int, error) {
 	kubeconfigPath, err := mgr.getKubeconfig(task)
 	if err != nil {
 		log.Errorf("Error: while getting kubeconfig: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return -1, errors.Wrap(err, "while getting kubeconfig")
 	}
 
-	command := *mgr.cmd.taskCommand
+	ctx := mgr.ctx
+	if mgr.cmd.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, mgr.cmd.timeout)
+		defer cancel()
+	}
+	command := exec.Command(mgr.cmd.taskCommand.Path)
+	command.Args = mgr.cmd.taskCommand.Args
+	setProcessGroup(command)
 
 	// Prepare environment variables
 	command.Env = os.Environ()
@@ -299,49 +425,69 @@ func (mgr *RuntimeTaskMakager) Execute(operationID string) (time.Duration, error
 	stdout, err := command.StdoutPipe()
 	if err != nil {
 		log.Errorf("Error: while creating stdout: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return -1, err
 	}
 	stderr, err := command.StderrPipe()
 	if err != nil {
 		log.Errorf("Error: while creating stderr: %s\n", err.Error())
-		task.result = err
-		return 0, err
+		return -1, err
 	}
 
-	// Prepare echoer stdout / stderr writers
+	// Prepare echoer stdout / stderr writers, the output is also captured for the report
 	echoerWg := sync.WaitGroup{}
-	echoer := func(src io.Reader, dst io.Writer) {
+	echoer := func(src io.Reader, dst io.Writer, capture io.Writer) {
 		scanner := bufio.NewScanner(src)
 		for scanner.Scan() {
 			if !mgr.cmd.noPrefixOutput {
 				fmt.Fprintf(dst, "%s ", task.operation.ShootName)
 			}
 			fmt.Fprintln(dst, scanner.Text())
+			fmt.Fprintln(capture, scanner.Text())
 		}
 		if err := scanner.Err(); err != nil {
 			log.Errorf("Error: while reading from child process: %s\n", err)
 		}
 		echoerWg.Done()
 	}
-	echoerWg.Add(2)
-	go echoer(stdout, os.Stdout)
-	go echoer(stderr, os.Stderr)
 
 	// Start execution of the command
 	err = command.Start()
 	if err != nil {
 		log.Errorf("Error: command started with error: %s\n", err.Error())
+		return -1, err
+	}
+	echoerWg.Add(2)
+	go echoer(stdout, os.Stdout, stdoutCapture)
+	go echoer(stderr, os.Stderr, stderrCapture)
+
+	// Wait for the command subprocess to finish, when the context is done the whole process group is killed
+	// and the output is not awaited longer than outputWaitDelay, as it can be still held by orphaned processes
+	echoerDone := make(chan struct{})
+	go func() {
+		echoerWg.Wait()
+		close(echoerDone)
+	}()
+	select {
+	case <-echoerDone:
+	case <-ctx.Done():
+		if killErr := killProcessGroup(command); killErr != nil {
+			log.Errorf("Error: while killing the command: %s\n", killErr)
+		}
+		select {
+		case <-echoerDone:
+		case <-time.After(outputWaitDelay):
+			log.Warnf("Warning: output of the command is not closed %s after it was killed\n", outputWaitDelay)
+		}
 	}
-	// Wait for the command subprocess to finish
-	echoerWg.Wait()
 	err = command.Wait()
+	if ctx.Err() == context.DeadlineExceeded {
+		err = fmt.Errorf("command timed out after %s", mgr.cmd.timeout)
+	}
 	if err != nil {
 		log.Errorf("Error: command exited with error: %s\n", err.Error())
 	}
-	task.result = err
 
-	return 0, err
+	return command.ProcessState.ExitCode(), err
 }
 
 func (mgr *RuntimeTaskMakager) Reschedule(operationID string, maintenanceWindowBegin, maintenanceWindowEnd time.Time) error {
@@ -0,0 +1,263 @@
+package command
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/pkg/errors"
+)
+
+// TaskStatus is the status of the task on a runtime in the taskrun report
+type TaskStatus string
+
+const (
+	// TaskPending is the status of the tasks which were not executed yet
+	TaskPending TaskStatus = "pending"
+	// TaskSucceeded is the status of the tasks which exited with the zero status code
+	TaskSucceeded TaskStatus = "succeeded"
+	// TaskFailed is the status of the tasks which failed or exited with a non-zero status code
+	TaskFailed TaskStatus = "failed"
+)
+
+// maxReportOutput is the maximal size of the stdout and the stderr of a task kept in the report, the end of the output is kept
+const maxReportOutput = 16 * 1024
+
+// reportFlushInterval is the minimal interval between the writes of the report during the execution,
+// so that the report of thousands of runtimes is not rewritten after each task
+const reportFlushInterval = 5 * time.Second
+
+// TaskRunReport is the result of the taskrun command, which can be used to resume the execution
+type TaskRunReport struct {
+	Command    []string            `json:"command"`
+	StartedAt  time.Time           `json:"startedAt"`
+	FinishedAt *time.Time          `json:"finishedAt,omitempty"`
+	Runtimes   []RuntimeTaskReport `json:"runtimes"`
+}
+
+// RuntimeTaskReport is the result of the task on one runtime
+type RuntimeTaskReport struct {
+	orchestration.Runtime
+	Status    TaskStatus `json:"status"`
+	ExitCode  *int       `json:"exitCode,omitempty"`
+	Error     string     `json:"error,omitempty"`
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	Duration  string     `json:"duration,omitempty"`
+	Stdout    string     `json:"stdout,omitempty"`
+	Stderr    string     `json:"stderr,omitempty"`
+}
+
+// NewTaskRunReport returns the report of the runtimes with all tasks pending
+func NewTaskRunReport(command []string, runtimes []orchestration.Runtime) *TaskRunReport {
+	report := &TaskRunReport{Command: command, Runtimes: make([]RuntimeTaskReport, 0, len(runtimes))}
+	for _, rt := range runtimes {
+		report.Runtimes = append(report.Runtimes, RuntimeTaskReport{Runtime: rt, Status: TaskPending})
+	}
+	return report
+}
+
+// LoadTaskRunReport reads the JSON report written by the previous execution
+func LoadTaskRunReport(path string) (*TaskRunReport, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading report")
+	}
+	report := &TaskRunReport{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, errors.Wrapf(err, "while parsing report %s", path)
+	}
+	return report, nil
+}
+
+// Unfinished returns the runtimes whose tasks failed or were not executed
+func (r *TaskRunReport) Unfinished() []orchestration.Runtime {
+	runtimes := make([]orchestration.Runtime, 0)
+	for _, rt := range r.Runtimes {
+		if rt.Status != TaskSucceeded {
+			runtimes = append(runtimes, rt.Runtime)
+		}
+	}
+	return runtimes
+}
+
+// Save writes the report as JSON, the file is replaced atomically so that an interrupted write does not corrupt the previous report
+func (r *TaskRunReport) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data)
+}
+
+// SaveJUnit writes the report in the JUnit XML format, each runtime is a test case
+func (r *TaskRunReport) SaveJUnit(path string) error {
+	suite := junitTestSuite{Name: "kcp taskrun", Tests: len(r.Runtimes)}
+	var total time.Duration
+	for _, rt := range r.Runtimes {
+		duration, _ := time.ParseDuration(rt.Duration)
+		total += duration
+		testCase := junitTestCase{
+			Name:      rt.ShootName,
+			ClassName: rt.GlobalAccountID,
+			Time:      duration.Seconds(),
+			SystemOut: rt.Stdout,
+			SystemErr: rt.Stderr,
+		}
+		switch rt.Status {
+		case TaskFailed:
+			suite.Failures++
+			testCase.Failure = &junitFailure{Message: rt.Error}
+		case TaskPending:
+			suite.Skipped++
+			testCase.Skipped = &junitSkipped{Message: "not executed"}
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+	suite.Time = total.Seconds()
+
+	data, err := xml.MarshalIndent(junitTestSuites{Suites: []junitTestSuite{suite}}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, append([]byte(xml.Header), data...))
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      float64         `xml:"time,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+	SystemErr string        `xml:"system-err,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// reportWriter updates the report with the results of the tasks executed in parallel and writes it periodically
+type reportWriter struct {
+	mu        sync.Mutex
+	report    *TaskRunReport
+	index     map[string]int
+	path      string
+	junitPath string
+	lastFlush time.Time
+	now       func() time.Time
+}
+
+func newReportWriter(report *TaskRunReport, path, junitPath string) *reportWriter {
+	index := make(map[string]int, len(report.Runtimes))
+	for i, rt := range report.Runtimes {
+		index[rt.RuntimeID] = i
+	}
+	return &reportWriter{report: report, index: index, path: path, junitPath: junitPath, now: time.Now}
+}
+
+// update stores the result of the task of the runtime, the report is written if it was not written within the flush interval
+func (w *reportWriter) update(result RuntimeTaskReport) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	i, found := w.index[result.RuntimeID]
+	if !found {
+		return fmt.Errorf("runtime %s is not in the report", result.RuntimeID)
+	}
+	w.report.Runtimes[i] = result
+	if w.now().Sub(w.lastFlush) < reportFlushInterval {
+		return nil
+	}
+	return w.flush()
+}
+
+// finish writes the final report
+func (w *reportWriter) finish() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	finishedAt := w.now().UTC()
+	w.report.FinishedAt = &finishedAt
+	if err := w.flush(); err != nil {
+		return err
+	}
+	if w.junitPath == "" {
+		return nil
+	}
+	return errors.Wrap(w.report.SaveJUnit(w.junitPath), "while saving JUnit report")
+}
+
+func (w *reportWriter) flush() error {
+	w.lastFlush = w.now()
+	if w.path == "" {
+		return nil
+	}
+	return errors.Wrap(w.report.Save(w.path), "while saving report")
+}
+
+// tailBuffer keeps the last max bytes written to it
+type tailBuffer struct {
+	mu        sync.Mutex
+	data      []byte
+	max       int
+	truncated bool
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.data = append(b.data, p...)
+	if len(b.data) > b.max {
+		b.data = append([]byte(nil), b.data[len(b.data)-b.max:]...)
+		b.truncated = true
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.truncated {
+		return "[truncated]\n" + string(b.data)
+	}
+	return string(b.data)
+}
@@ -0,0 +1,117 @@
+package command
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskRunReport_Resume(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := NewTaskRunReport([]string{"kubectl", "get", "ns"}, []orchestration.Runtime{
+		{RuntimeID: "runtime-1", ShootName: "c-1"},
+		{RuntimeID: "runtime-2", ShootName: "c-2"},
+		{RuntimeID: "runtime-3", ShootName: "c-3"},
+	})
+	report.Runtimes[0].Status = TaskSucceeded
+	report.Runtimes[1].Status = TaskFailed
+	require.NoError(t, report.Save(path))
+
+	// when
+	loaded, err := LoadTaskRunReport(path)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"kubectl", "get", "ns"}, loaded.Command)
+	unfinished := loaded.Unfinished()
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "runtime-2", unfinished[0].RuntimeID)
+	assert.Equal(t, "runtime-3", unfinished[1].RuntimeID)
+}
+
+func TestTaskRunReport_SaveJUnit(t *testing.T) {
+	// given
+	path := filepath.Join(t.TempDir(), "junit.xml")
+	report := NewTaskRunReport([]string{"true"}, []orchestration.Runtime{
+		{RuntimeID: "runtime-1", ShootName: "c-1", GlobalAccountID: "ga"},
+		{RuntimeID: "runtime-2", ShootName: "c-2", GlobalAccountID: "ga"},
+		{RuntimeID: "runtime-3", ShootName: "c-3", GlobalAccountID: "ga"},
+	})
+	report.Runtimes[0].Status = TaskSucceeded
+	report.Runtimes[0].Duration = "1.5s"
+	report.Runtimes[1].Status = TaskFailed
+	report.Runtimes[1].Duration = "500ms"
+	report.Runtimes[1].Error = "exit status 1"
+	report.Runtimes[1].Stderr = "forbidden\n"
+
+	// when
+	err := report.SaveJUnit(path)
+
+	// then
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	var suites junitTestSuites
+	require.NoError(t, xml.Unmarshal(data, &suites))
+	require.Len(t, suites.Suites, 1)
+	suite := suites.Suites[0]
+	assert.Equal(t, 3, suite.Tests)
+	assert.Equal(t, 1, suite.Failures)
+	assert.Equal(t, 1, suite.Skipped)
+	assert.Equal(t, 2.0, suite.Time)
+	assert.Equal(t, "exit status 1", suite.TestCases[1].Failure.Message)
+	assert.Equal(t, "forbidden\n", suite.TestCases[1].SystemErr)
+	assert.NotNil(t, suite.TestCases[2].Skipped)
+}
+
+func TestReportWriter_Update(t *testing.T) {
+	// given
+	now := time.Date(2022, 10, 17, 10, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "report.json")
+	report := NewTaskRunReport([]string{"true"}, []orchestration.Runtime{{RuntimeID: "runtime-1"}, {RuntimeID: "runtime-2"}})
+	writer := newReportWriter(report, path, "")
+	writer.now = func() time.Time { return now }
+	require.NoError(t, writer.flush())
+
+	// when the report was written within the flush interval
+	require.NoError(t, writer.update(RuntimeTaskReport{Runtime: orchestration.Runtime{RuntimeID: "runtime-1"}, Status: TaskSucceeded}))
+
+	// then
+	saved, err := LoadTaskRunReport(path)
+	require.NoError(t, err)
+	assert.Equal(t, TaskPending, saved.Runtimes[0].Status)
+
+	// when the flush interval passed
+	writer.now = func() time.Time { return now.Add(reportFlushInterval) }
+	require.NoError(t, writer.update(RuntimeTaskReport{Runtime: orchestration.Runtime{RuntimeID: "runtime-2"}, Status: TaskFailed}))
+
+	// then
+	saved, err = LoadTaskRunReport(path)
+	require.NoError(t, err)
+	assert.Equal(t, TaskSucceeded, saved.Runtimes[0].Status)
+	assert.Equal(t, TaskFailed, saved.Runtimes[1].Status)
+
+	// when the runtime is not in the report
+	err = writer.update(RuntimeTaskReport{Runtime: orchestration.Runtime{RuntimeID: "runtime-3"}})
+
+	// then
+	assert.EqualError(t, err, "runtime runtime-3 is not in the report")
+}
+
+func TestTailBuffer(t *testing.T) {
+	buffer := &tailBuffer{max: 10}
+
+	_, err := buffer.Write([]byte("first line\n"))
+	require.NoError(t, err)
+	_, err = buffer.Write([]byte("last\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, "[truncated]\nline\nlast\n", buffer.String())
+}
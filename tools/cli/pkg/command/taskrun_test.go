@@ -0,0 +1,99 @@
+package command
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/kyma-environment-broker/common/orchestration"
+	"github.com/kyma-project/control-plane/tools/cli/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTaskManager(t *testing.T, timeout time.Duration, script string) (*RuntimeTaskMakager, *TaskRunReport) {
+	shell, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("sh is not available")
+	}
+	cmd := &TaskRunCommand{
+		log:            logger.New(),
+		noKubeconfig:   true,
+		noPrefixOutput: true,
+		timeout:        timeout,
+		taskCommand:    exec.Command(shell, "-c", script),
+	}
+	runtime := orchestration.Runtime{RuntimeID: "runtime-1", ShootName: "c-1"}
+	report := NewTaskRunReport([]string{script}, []orchestration.Runtime{runtime})
+	mgr := &RuntimeTaskMakager{
+		ctx:    context.Background(),
+		cmd:    cmd,
+		tasks:  map[string]*RuntimeTask{"op-1": {operation: orchestration.RuntimeOperation{Runtime: runtime, ID: "op-1"}}},
+		report: newReportWriter(report, "", ""),
+	}
+	return mgr, report
+}
+
+func TestRuntimeTaskMakager_Execute(t *testing.T) {
+	t.Run("should capture the result of the command", func(t *testing.T) {
+		// given
+		mgr, report := newTestTaskManager(t, 0, `echo "runtime $RUNTIME_ID"; echo failure >&2; exit 3`)
+
+		// when
+		_, err := mgr.Execute("op-1")
+
+		// then
+		assert.EqualError(t, err, "exit status 3")
+		assert.EqualError(t, mgr.exitStatus(), "1/1 execution(s) failed")
+		result := report.Runtimes[0]
+		assert.Equal(t, TaskFailed, result.Status)
+		require.NotNil(t, result.ExitCode)
+		assert.Equal(t, 3, *result.ExitCode)
+		assert.Equal(t, "runtime runtime-1\n", result.Stdout)
+		assert.Equal(t, "failure\n", result.Stderr)
+		assert.NotEmpty(t, result.Duration)
+	})
+
+	t.Run("should report the succeeded command", func(t *testing.T) {
+		// given
+		mgr, report := newTestTaskManager(t, 0, "true")
+
+		// when
+		_, err := mgr.Execute("op-1")
+
+		// then
+		require.NoError(t, err)
+		assert.NoError(t, mgr.exitStatus())
+		assert.Equal(t, TaskSucceeded, report.Runtimes[0].Status)
+		assert.Equal(t, 0, *report.Runtimes[0].ExitCode)
+	})
+
+	t.Run("should kill the command when the timeout expires", func(t *testing.T) {
+		// given
+		mgr, report := newTestTaskManager(t, 100*time.Millisecond, "exec sleep 10")
+
+		// when
+		_, err := mgr.Execute("op-1")
+
+		// then
+		assert.EqualError(t, err, "command timed out after 100ms")
+		assert.Equal(t, TaskFailed, report.Runtimes[0].Status)
+		assert.Nil(t, report.Runtimes[0].ExitCode)
+	})
+
+	t.Run("should kill the processes started by the command when the timeout expires", func(t *testing.T) {
+		// given
+		mgr, report := newTestTaskManager(t, 100*time.Millisecond, "sleep 10; echo done")
+		start := time.Now()
+
+		// when
+		_, err := mgr.Execute("op-1")
+
+		// then
+		assert.EqualError(t, err, "command timed out after 100ms")
+		assert.Less(t, time.Since(start), outputWaitDelay)
+		assert.Equal(t, TaskFailed, report.Runtimes[0].Status)
+		assert.Empty(t, report.Runtimes[0].Stdout)
+	})
+}
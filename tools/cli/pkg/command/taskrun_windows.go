@@ -0,0 +1,13 @@
+package command
+
+import (
+	"os/exec"
+)
+
+// setProcessGroup is not supported on Windows, only the command itself is killed
+func setProcessGroup(_ *exec.Cmd) {}
+
+// killProcessGroup kills the command, the processes started by the command are not killed on Windows
+func killProcessGroup(command *exec.Cmd) error {
+	return command.Process.Kill()
+}
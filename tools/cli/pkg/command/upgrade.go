@@ -16,6 +16,7 @@ type UpgradeCommand struct {
 	targetInputs        []string
 	targetExcludeInputs []string
 	strategy            string
+	canaryOnFailure     string
 	schedule            string
 	maintenancewindow   bool
 	orchestrationParams orchestration.Parameters
@@ -45,8 +46,12 @@ func NewUpgradeCmd() *cobra.Command {
 // SetUpgradeOpts configures the upgrade specific options on the given command
 func (cmd *UpgradeCommand) SetUpgradeOpts(cobraCmd *cobra.Command) {
 	SetRuntimeTargetOpts(cobraCmd, &cmd.targetInputs, &cmd.targetExcludeInputs)
-	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use.")
+	cobraCmd.Flags().StringVar(&cmd.strategy, "strategy", string(orchestration.ParallelStrategy), "Orchestration strategy to use. Possible values: \"parallel\", \"canary\".")
 	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Parallel.Workers, "parallel-workers", 1, "Number of parallel workers to use in parallel orchestration strategy. By default the amount of workers will be auto-selected on control plane server side.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Canary.FirstWaveSize, "canary-first-wave-size", 1, "Number of Runtimes upgraded in the first wave of the canary orchestration strategy.")
+	cobraCmd.Flags().IntVar(&cmd.orchestrationParams.Strategy.Canary.WaveSize, "canary-wave-size", 10, "Number of Runtimes upgraded in every next wave of the canary orchestration strategy.")
+	cobraCmd.Flags().Float64Var(&cmd.orchestrationParams.Strategy.Canary.FailureThreshold, "canary-failure-threshold", 0, "Ratio of failed operations in a wave (0-1) above which the canary orchestration strategy halts the orchestration.")
+	cobraCmd.Flags().StringVar(&cmd.canaryOnFailure, "canary-on-failure", string(orchestration.CanaryHaltCancel), "Action taken by the canary orchestration strategy when the failure threshold is exceeded. Possible values: \"cancel\", \"pause\".")
 	cobraCmd.Flags().BoolVarP(&cmd.maintenancewindow, "maintenancewindow", "", false, "Schedule the upgrade in the next possible maintenancewindow after 'schedule'. (default: false)")
 	cobraCmd.Flags().StringVar(&cmd.schedule, "schedule", "", "Orchestration schedule to use. Possible values: \"immediate\", \"now\" or a date (2006-01-01) . By default the schedule will be auto-selected on control plane server side.")
 	cobraCmd.Flags().BoolVar(&cmd.orchestrationParams.DryRun, "dry-run", false, "Perform the orchestration without executing the actual upgrade operations for the Runtimes. The details can be obtained using the \"kcp orchestrations\" command.")
@@ -83,6 +88,21 @@ func (cmd *UpgradeCommand) ValidateTransformUpgradeOpts() error {
 	switch cmd.strategy {
 	case string(orchestration.ParallelStrategy):
 		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
+	case string(orchestration.CanaryStrategy):
+		if cmd.orchestrationParams.Strategy.Canary.FirstWaveSize < 1 || cmd.orchestrationParams.Strategy.Canary.WaveSize < 1 {
+			return fmt.Errorf("canary wave sizes must be greater than 0")
+		}
+		if cmd.orchestrationParams.Strategy.Canary.FailureThreshold < 0 || cmd.orchestrationParams.Strategy.Canary.FailureThreshold > 1 {
+			return fmt.Errorf("invalid value for canary-failure-threshold: %v", cmd.orchestrationParams.Strategy.Canary.FailureThreshold)
+		}
+		cmd.orchestrationParams.Strategy.Type = orchestration.StrategyType(cmd.strategy)
+		cmd.orchestrationParams.Strategy.Canary.Workers = cmd.orchestrationParams.Strategy.Parallel.Workers
+		switch orchestration.CanaryHaltAction(cmd.canaryOnFailure) {
+		case orchestration.CanaryHaltCancel, orchestration.CanaryHaltPause:
+			cmd.orchestrationParams.Strategy.Canary.OnFailure = orchestration.CanaryHaltAction(cmd.canaryOnFailure)
+		default:
+			return fmt.Errorf("invalid value for canary-on-failure: %s", cmd.canaryOnFailure)
+		}
 	default:
 		return fmt.Errorf("invalid value for strategy: %s", cmd.strategy)
 	}